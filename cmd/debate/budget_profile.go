@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"debate/internal/openai"
+)
+
+// budgetProfileTurns are the turn counts shown by -budget-profile. They
+// straddle every compression threshold so each level change is visible.
+var budgetProfileTurns = []int{1, 6, 12, 18, 24, 32, 40, 60}
+
+// runBudgetProfile prints how the prompt budget shrinks as a debate with
+// personaCount personas grows, one row per budget field and one column per
+// turn count. It needs no API key and returns the process exit code.
+func runBudgetProfile(personaCount int, w io.Writer) int {
+	profiles := make([]map[string]int, 0, len(budgetProfileTurns))
+	for _, turns := range budgetProfileTurns {
+		profiles = append(profiles, openai.PromptBudgetProfile(personaCount, turns))
+	}
+	keys := make([]string, 0, len(profiles[0]))
+	for key := range profiles[0] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	_, _ = fmt.Fprintf(w, "prompt budget for %d personas\n", personaCount)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	header := []string{"turns"}
+	for _, turns := range budgetProfileTurns {
+		header = append(header, fmt.Sprint(turns))
+	}
+	_, _ = fmt.Fprintln(tw, strings.Join(header, "\t")+"\t")
+	for _, key := range keys {
+		row := []string{key}
+		for _, profile := range profiles {
+			row = append(row, fmt.Sprint(profile[key]))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(row, "\t")+"\t")
+	}
+	if err := tw.Flush(); err != nil {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunBudgetProfilePrintsEveryTurnColumn(t *testing.T) {
+	var stdout bytes.Buffer
+	if code := runBudgetProfile(4, &stdout); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	out := stdout.String()
+	for _, want := range []string{"prompt budget for 4 personas", "compression_level", "turn_recent_log_limit", "60"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestParseRuntimeOptionsRejectsNegativeBudgetProfile(t *testing.T) {
+	if _, err := parseRuntimeOptions([]string{"-budget-profile", "-1"}); err == nil {
+		t.Fatal("expected negative -budget-profile to be rejected")
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"debate/internal/config"
+	"debate/internal/openai"
+	"debate/internal/persona"
+)
+
+// runCheck validates everything a run needs without calling the API and
+// returns the process exit code. It stops at the first failure.
+func runCheck(opts runtimeOptions, outputDir string, stdout io.Writer, stderr io.Writer) int {
+	fail := func(step string, err error) int {
+		_, _ = fmt.Fprintf(stderr, "check failed: %s: %v\n", step, err)
+		return 1
+	}
+
+	settings, err := config.FromEnv()
+	if err != nil {
+		return fail("config", err)
+	}
+	settings = opts.applySettingsOverrides(settings)
+	if _, err := openai.NewClient(openaiConfigFromSettings(settings)); err != nil {
+		return fail("openai client", err)
+	}
+	personas, err := opts.personaLoader()(opts.personaPath)
+	if err != nil {
+		return fail("personas", err)
+	}
+	if err := checkDirWritable(outputDir); err != nil {
+		return fail("output dir", err)
+	}
+
+	_, _ = fmt.Fprintln(stdout, "check ok")
+	_, _ = fmt.Fprintf(stdout, "- model: %s\n", settings.Model)
+	source := opts.personaPath
+	if opts.inlinePersonas != nil {
+		source = "-personas-inline"
+	}
+	_, _ = fmt.Fprintf(stdout, "- personas: %s (%d)\n", source, len(personas))
+	_, _ = fmt.Fprintf(stdout, "- output dir: %s\n", outputDir)
+	for _, warning := range persona.Warnings(personas) {
+		_, _ = fmt.Fprintf(stdout, "- warning: %s\n", warning)
+	}
+	return 0
+}
+
+func checkDirWritable(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	probe, err := os.CreateTemp(dir, ".debate-check-*")
+	if err != nil {
+		return err
+	}
+	name := probe.Name()
+	_ = probe.Close()
+	return os.Remove(name)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeCheckPersonaFile(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "personas.json")
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("write persona file: %v", err)
+	}
+	return path
+}
+
+func TestRunCheckSucceedsWithValidSetup(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	path := writeCheckPersonaFile(t, `[{"id":"a","name":"A","role":"r1"},{"id":"b","name":"B","role":"r2"}]`)
+	outputDir := filepath.Join(t.TempDir(), "outputs")
+
+	var stdout, stderr bytes.Buffer
+	code := runCheck(runtimeOptions{personaPath: path}, outputDir, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "check ok") || !strings.Contains(stdout.String(), "(2)") {
+		t.Fatalf("unexpected summary: %q", stdout.String())
+	}
+	entries, err := os.ReadDir(outputDir)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("expected empty output dir after probe, entries=%v err=%v", entries, err)
+	}
+}
+
+func TestRunCheckFailsWithInvalidPersonas(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	path := writeCheckPersonaFile(t, `[{"id":"a","name":"A","role":"r1"}]`)
+
+	var stdout, stderr bytes.Buffer
+	code := runCheck(runtimeOptions{personaPath: path}, t.TempDir(), &stdout, &stderr)
+	if code == 0 {
+		t.Fatal("expected non-zero exit code for invalid personas")
+	}
+	if !strings.Contains(stderr.String(), "check failed: personas") {
+		t.Fatalf("expected persona failure, got %q", stderr.String())
+	}
+}
+
+func TestParseRuntimeOptionsCheck(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--check"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.check {
+		t.Fatal("expected check flag to be set")
+	}
+	if _, err := parseRuntimeOptions([]string{"--check", "--problem", "x"}); err == nil {
+		t.Fatal("expected error combining -check and -problem")
+	}
+}
+
+func TestRunCheckPrintsPersonaWarnings(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	path := writeCheckPersonaFile(t, `[{"id":"a","name":"A","role":""},{"id":"b","name":"B","role":"r2"}]`)
+
+	var stdout, stderr bytes.Buffer
+	if code := runCheck(runtimeOptions{personaPath: path}, t.TempDir(), &stdout, &stderr); code != 0 {
+		t.Fatalf("expected warnings not to fail the check, got %d; stderr=%s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "- warning: persona[0] a: role is empty") {
+		t.Fatalf("expected empty-role warning in summary: %q", stdout.String())
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import "debate/internal/orchestrator"
+
+// Exit codes for one-shot runs. Only consensus exits 0 so scripts can branch
+// on `debate -problem ... && ...`.
+const (
+	exitConsensusReached = 0
+	exitError            = 1
+	exitMaxTurns         = 2
+	exitDurationLimit    = 3
+	exitTokenLimit       = 4
+	exitNoProgress       = 5
+	exitIrreconcilable   = 6
+	exitCostLimit        = 7
+	exitCallLimit        = 8
+)
+
+func exitCodeForStatus(status string) int {
+	switch status {
+	case orchestrator.StatusConsensusReached:
+		return exitConsensusReached
+	case orchestrator.StatusMaxTurnsReached:
+		return exitMaxTurns
+	case orchestrator.StatusDurationReached:
+		return exitDurationLimit
+	case orchestrator.StatusTokenLimitReached:
+		return exitTokenLimit
+	case orchestrator.StatusNoProgressReached:
+		return exitNoProgress
+	case orchestrator.StatusIrreconcilable:
+		return exitIrreconcilable
+	case orchestrator.StatusCostLimitReached:
+		return exitCostLimit
+	case orchestrator.StatusCallLimitReached:
+		return exitCallLimit
+	default:
+		return exitError
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"debate/internal/orchestrator"
+)
+
+func TestExitCodeForStatus(t *testing.T) {
+	cases := map[string]int{
+		orchestrator.StatusConsensusReached:  0,
+		orchestrator.StatusError:             1,
+		orchestrator.StatusMaxTurnsReached:   2,
+		orchestrator.StatusDurationReached:   3,
+		orchestrator.StatusTokenLimitReached: 4,
+		orchestrator.StatusNoProgressReached: 5,
+		orchestrator.StatusIrreconcilable:    6,
+		orchestrator.StatusCostLimitReached:  7,
+		orchestrator.StatusCallLimitReached:  8,
+		"":                                   1,
+		"unknown_status":                     1,
+	}
+	for status, want := range cases {
+		if got := exitCodeForStatus(status); got != want {
+			t.Fatalf("exitCodeForStatus(%q) = %d, want %d", status, got, want)
+		}
+	}
+}
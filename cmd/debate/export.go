@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"debate/internal/output"
+)
+
+// exportCommand is the subcommand that regenerates the Markdown report of a
+// saved JSON result.
+const exportCommand = "export"
+
+// runExport implements `debate export <result.json> [out.md]`: it rebuilds the
+// Markdown report next to the JSON result, or at out.md, and prints the
+// written path. The source must be a readable JSON result; nothing is written
+// otherwise. It needs no API key and returns the process exit code.
+func runExport(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 || len(args) > 2 {
+		_, _ = fmt.Fprintln(stderr, "export: usage: debate export <result.json> [out.md]")
+		return exitError
+	}
+	jsonPath := args[0]
+	mdPath := output.MarkdownPath(jsonPath)
+	if len(args) == 2 {
+		mdPath = args[1]
+	}
+	if sameFile(jsonPath, mdPath) {
+		_, _ = fmt.Fprintf(stderr, "export: refusing to overwrite the source result %s\n", jsonPath)
+		return exitError
+	}
+
+	result, err := output.LoadResult(jsonPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			_, _ = fmt.Fprintf(stderr, "export: result file not found: %s\n", jsonPath)
+		} else {
+			_, _ = fmt.Fprintf(stderr, "export: %v\n", err)
+		}
+		return exitError
+	}
+	if err := os.WriteFile(mdPath, []byte(output.RenderMarkdown(result)), 0o644); err != nil {
+		_, _ = fmt.Fprintf(stderr, "export: write %s: %v\n", mdPath, err)
+		return exitError
+	}
+	_, _ = fmt.Fprintln(stdout, mdPath)
+	return 0
+}
+
+// sameFile reports whether a and b name the same path once cleaned and made
+// absolute.
+func sameFile(a string, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return absA == absB
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunExportWritesMarkdownNextToResult(t *testing.T) {
+	path := writeRenderResultFile(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := runExport([]string{path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	mdPath := strings.TrimSuffix(path, ".json") + ".md"
+	if got := strings.TrimSpace(stdout.String()); got != mdPath {
+		t.Fatalf("expected written path %q, got %q", mdPath, got)
+	}
+	data, err := os.ReadFile(mdPath)
+	if err != nil {
+		t.Fatalf("read markdown: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "# Debate Result\n") || !strings.Contains(string(data), "배포 파이프라인부터 고칩시다.") {
+		t.Fatalf("unexpected markdown: %q", data)
+	}
+}
+
+func TestRunExportWritesToGivenPath(t *testing.T) {
+	path := writeRenderResultFile(t)
+	outPath := filepath.Join(t.TempDir(), "report.md")
+
+	var stdout, stderr bytes.Buffer
+	if code := runExport([]string{path, outPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	if _, err := os.Stat(outPath); err != nil {
+		t.Fatalf("expected markdown at %s: %v", outPath, err)
+	}
+}
+
+func TestRunExportValidatesSourceBeforeWriting(t *testing.T) {
+	dir := t.TempDir()
+	var stdout, stderr bytes.Buffer
+
+	missing := filepath.Join(dir, "missing.json")
+	if code := runExport([]string{missing}, &stdout, &stderr); code != exitError {
+		t.Fatalf("expected exit code %d for missing file, got %d", exitError, code)
+	}
+	if !strings.Contains(stderr.String(), "result file not found") {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+
+	broken := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(broken, []byte("{not json"), 0o644); err != nil {
+		t.Fatalf("write broken result: %v", err)
+	}
+	stderr.Reset()
+	if code := runExport([]string{broken}, &stdout, &stderr); code != exitError {
+		t.Fatalf("expected exit code %d for invalid JSON, got %d", exitError, code)
+	}
+	if !strings.Contains(stderr.String(), "decode result") {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+	if _, err := os.Stat(filepath.Join(dir, "broken.md")); !os.IsNotExist(err) {
+		t.Fatalf("expected no markdown for invalid source, stat err=%v", err)
+	}
+
+	stderr.Reset()
+	if code := runExport([]string{broken, broken}, &stdout, &stderr); code != exitError {
+		t.Fatalf("expected exit code %d when output is the source, got %d", exitError, code)
+	}
+}
@@ -2,93 +2,321 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
-	"os/signal"
+	"regexp"
 	"strings"
-	"syscall"
 	"time"
 
 	"debate/internal/config"
 	"debate/internal/openai"
 	"debate/internal/orchestrator"
+	"debate/internal/output"
 	"debate/internal/persona"
 	"debate/internal/web"
 )
 
 type runtimeOptions struct {
+	// personaPath is one persona file or a comma-separated list to merge.
 	personaPath string
-	addr        string
+	// inlinePersonas, when non-nil, were given as a JSON array with
+	// -personas-inline and replace loading personaPath.
+	inlinePersonas []persona.Persona
+	// strictPersonas rejects an ID declared in more than one merged file
+	// instead of letting the later file win.
+	strictPersonas bool
+	addr           string
+	formats        []output.Format
+	// problem runs a single debate and exits instead of serving the web UI.
+	problem string
+	// resumePath continues a saved result (limited by -max-turns) and exits.
+	resumePath    string
+	moderatorName string
+	language      string
+	// check validates config, personas and the output dir, then exits.
+	check bool
+	// savePrompts writes a .prompts.json archive next to each saved result.
+	savePrompts bool
+	// dryRun prints every prompt to stderr and answers with canned replies
+	// instead of calling the API.
+	dryRun bool
+	// budgetProfile prints the prompt budget for this many personas, then exits.
+	budgetProfile int
+	// recommend prints a suggested panel of this many personas for problem,
+	// then exits.
+	recommend int
+	// Limit overrides are nil unless the flag was given; they win over env values.
+	maxTurns           *int
+	consensusThreshold *float64
+	maxDuration        *time.Duration
+	maxTotalTokens     *int
 }
 
+// languageTagPattern accepts simple BCP 47-style tags such as "en", "ko" or "pt-BR".
+var languageTagPattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == renderCommand {
+		os.Exit(runRender(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == exportCommand {
+		os.Exit(runExport(os.Args[2:], os.Stdout, os.Stderr))
+	}
 	opts, err := parseRuntimeOptions(os.Args[1:])
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, "argument error:", err)
 		os.Exit(1)
 	}
+	if opts.budgetProfile > 0 {
+		os.Exit(runBudgetProfile(opts.budgetProfile, os.Stdout))
+	}
+	if opts.recommend > 0 {
+		os.Exit(runRecommend(opts, os.Stdout, os.Stderr))
+	}
+	if opts.check {
+		os.Exit(runCheck(opts, config.DefaultOutputDir, os.Stdout, os.Stderr))
+	}
 
-	settings, err := config.FromEnv()
+	loadSettings := config.FromEnv
+	if opts.dryRun {
+		loadSettings = config.FromEnvWithoutAPIKey
+	}
+	settings, err := loadSettings()
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, "config error:", err)
 		os.Exit(1)
 	}
+	settings = opts.applySettingsOverrides(settings)
 
-	client, err := openai.NewClient(openai.Config{
-		APIKey:     settings.APIKey,
-		BaseURL:    settings.BaseURL,
-		Model:      settings.Model,
-		Timeout:    settings.RequestTimeout,
-		MaxRetries: settings.APIMaxRetries,
-	})
+	client, err := newLLMClient(opts, settings)
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, "openai client error:", err)
 		os.Exit(1)
 	}
 
 	orchCfg := orchestratorConfigFromSettings(settings)
+	orchCfg.ModeratorName = opts.moderatorName
+	orchCfg.ResponseLanguage = opts.language
 	runner := orchestrator.New(client, orchCfg)
+	var systemPrompts map[string]string
+	if opts.savePrompts {
+		systemPrompts = client.SystemPrompts()
+	}
 
-	app := web.NewApp(web.Config{
-		PersonaPath:    opts.personaPath,
-		BaseDir:        ".",
-		OutputDir:      config.DefaultOutputDir,
-		Runner:         runner,
-		RunnerDefaults: orchCfg,
-		Loader:         persona.LoadFromFile,
-		Now:            time.Now,
-		RunTimeout:     settings.RunTimeout,
-		TurnBuffer:     settings.StreamTurnBuffer,
-	})
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := shutdownContext(context.Background())
 	defer stop()
 
+	if opts.problem != "" || opts.resumePath != "" {
+		code := runOneShot(ctx, oneShotRun{
+			problem:     opts.problem,
+			resumePath:  opts.resumePath,
+			personaPath: opts.personaPath,
+			formats:     opts.formats,
+			outputDir:   config.DefaultOutputDir,
+			runner:      runner,
+			runnerCfg:   orchCfg,
+			prompts:     systemPrompts,
+			fallbackDir: saveFallbackDir(settings),
+			metricsCSV:  settings.MetricsCSVPath,
+			loader:      opts.personaLoader(),
+			now:         time.Now,
+			stdout:      os.Stdout,
+			stderr:      os.Stderr,
+		})
+		stop()
+		os.Exit(code)
+	}
+
+	app := web.NewApp(web.Config{
+		PersonaPath:     opts.personaPath,
+		Personas:        opts.inlinePersonas,
+		BaseDir:         ".",
+		OutputDir:       config.DefaultOutputDir,
+		Runner:          runner,
+		RunnerDefaults:  orchCfg,
+		Loader:          opts.fileLoader(),
+		Now:             time.Now,
+		RunTimeout:      settings.RunTimeout,
+		TurnBuffer:      settings.StreamTurnBuffer,
+		OutputFormats:   opts.formats,
+		MaxPersonas:     settings.WebMaxPersonas,
+		PersonaWarnAt:   settings.WebPersonaWarnAt,
+		SystemPrompts:   systemPrompts,
+		SaveFallbackDir: saveFallbackDir(settings),
+		MetricsCSVPath:  settings.MetricsCSVPath,
+		AdminToken:      settings.AdminToken,
+		Retention: output.RetentionOptions{
+			MaxAge:   settings.OutputMaxAge,
+			MaxCount: settings.OutputMaxCount,
+		},
+	})
 	if err := app.Start(ctx, opts.addr); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, "runtime error:", err)
 		os.Exit(1)
 	}
 }
 
+// llmClient is what main needs from either the API client or the dry-run
+// client.
+type llmClient interface {
+	orchestrator.LLMClient
+	SystemPrompts() map[string]string
+}
+
+// newLLMClient picks the dry-run client, which writes prompts to stderr,
+// when -dry-run is set, and the API client otherwise.
+func newLLMClient(opts runtimeOptions, settings config.Settings) (llmClient, error) {
+	if opts.dryRun {
+		return openai.NewDryRunClient(openaiConfigFromSettings(settings), os.Stderr), nil
+	}
+	return openai.NewClient(openaiConfigFromSettings(settings))
+}
+
+// saveFallbackDir is where finished results go when the output dir cannot
+// be written; empty when DEBATE_DISABLE_SAVE_FALLBACK is set.
+func saveFallbackDir(settings config.Settings) string {
+	if settings.DisableSaveFallback {
+		return ""
+	}
+	return os.TempDir()
+}
+
+// applySettingsOverrides replaces env-derived limits with any values given on the command line.
+func (o runtimeOptions) applySettingsOverrides(settings config.Settings) config.Settings {
+	if o.maxTurns != nil {
+		settings.MaxTurns = *o.maxTurns
+	}
+	if o.consensusThreshold != nil {
+		settings.ConsensusThreshold = *o.consensusThreshold
+	}
+	if o.maxDuration != nil {
+		settings.MaxDuration = *o.maxDuration
+	}
+	if o.maxTotalTokens != nil {
+		settings.MaxTotalTokens = *o.maxTotalTokens
+	}
+	return settings
+}
+
+func openaiConfigFromSettings(settings config.Settings) openai.Config {
+	return openai.Config{
+		APIKey:                  settings.APIKey,
+		BaseURL:                 settings.BaseURL,
+		Model:                   settings.Model,
+		JudgeModel:              settings.JudgeModel,
+		ModeratorModel:          settings.ModeratorModel,
+		Timeout:                 settings.RequestTimeout,
+		MaxRetries:              settings.APIMaxRetries,
+		SystemPromptPrefix:      settings.SystemPromptPrefix,
+		SystemPromptSuffix:      settings.SystemPromptSuffix,
+		MaxInFlight:             settings.APIMaxInFlight,
+		DisableTruncationRetry:  settings.DisableTruncationRetry,
+		SummaryTruncationMarker: settings.SummaryMarker,
+		SummaryWordBoundary:     settings.SummaryWordBoundary,
+	}
+}
+
 func orchestratorConfigFromSettings(settings config.Settings) orchestrator.Config {
 	return orchestrator.Config{
-		MaxTurns:                settings.MaxTurns,
-		ConsensusThreshold:      settings.ConsensusThreshold,
-		MaxDuration:             settings.MaxDuration,
-		MaxTotalTokens:          settings.MaxTotalTokens,
-		MaxNoProgressJudges:     settings.MaxNoProgressJudge,
-		UnlimitedHardMaxTurns:   settings.HardMaxTurns,
-		DirectHandoffJudgeEvery: settings.DirectJudgeEvery,
-		LLMHistoryTurnWindow:    settings.LLMHistoryWindow,
-		AudienceMode:            settings.AudienceMode,
+		MaxTurns:                        settings.MaxTurns,
+		ConsensusThreshold:              settings.ConsensusThreshold,
+		MaxDuration:                     settings.MaxDuration,
+		MaxTotalTokens:                  settings.MaxTotalTokens,
+		MaxNoProgressJudges:             settings.MaxNoProgressJudge,
+		UnlimitedHardMaxTurns:           settings.HardMaxTurns,
+		DirectHandoffJudgeEvery:         settings.DirectJudgeEvery,
+		LLMHistoryTurnWindow:            settings.LLMHistoryWindow,
+		AudienceMode:                    settings.AudienceMode,
+		JudgeRecencyWeighting:           settings.JudgeRecencyWeighting,
+		RequireActionOwner:              settings.RequireActionOwner,
+		MinDistinctSpeakersForConsensus: settings.MinDistinctSpeakers,
+		AmbiguousHandoffPolicy:          settings.AmbiguousHandoffPolicy,
+		ModeratorMode:                   settings.ModeratorMode,
+		ThresholdSchedule:               thresholdScheduleFromSettings(settings),
+		ModeratorIntro:                  settings.ModeratorIntro,
+		DisableFinalJudgePass:           settings.DisableFinalJudgePass,
+		JudgePreScreenThreshold:         settings.JudgePreScreenThreshold,
+		RedTeam:                         settings.RedTeam,
+		RedTeamSeed:                     int64(settings.RedTeamSeed),
+		MaxEstimatedCostUSD:             settings.MaxEstimatedCostUSD,
+		MaxLLMCalls:                     settings.MaxLLMCalls,
+		CostModel:                       costModelFromSettings(settings.ModelPrices),
+		DurationGrace:                   settings.DurationGrace,
+		SlowTurnNudge:                   settings.SlowTurnNudge,
+	}
+}
+
+func costModelFromSettings(prices map[string]config.ModelPrice) orchestrator.CostModel {
+	if len(prices) == 0 {
+		return nil
+	}
+	model := make(orchestrator.CostModel, len(prices))
+	for name, price := range prices {
+		model[name] = orchestrator.ModelPrice{PromptPer1K: price.PromptPer1K, CompletionPer1K: price.CompletionPer1K}
+	}
+	return model
+}
+
+// thresholdScheduleFromSettings ramps from the (possibly per-request)
+// consensus threshold to DEBATE_CONSENSUS_THRESHOLD_END; nil when unset.
+func thresholdScheduleFromSettings(settings config.Settings) *orchestrator.ThresholdSchedule {
+	if settings.ConsensusThresholdEnd <= 0 {
+		return nil
+	}
+	return &orchestrator.ThresholdSchedule{End: settings.ConsensusThresholdEnd}
+}
+
+// personaLoader returns the -personas-inline roster when set, ignoring the
+// path, and otherwise loads it like fileLoader.
+func (opts runtimeOptions) personaLoader() web.LoaderFunc {
+	if opts.inlinePersonas != nil {
+		inline := opts.inlinePersonas
+		return func(string) ([]persona.Persona, error) {
+			return append([]persona.Persona(nil), inline...), nil
+		}
+	}
+	return opts.fileLoader()
+}
+
+// fileLoader loads opts.personaPath style values: a single file, or a
+// comma-separated list merged according to -strict-personas. The web server
+// uses it for explicit paths even with -personas-inline.
+func (opts runtimeOptions) fileLoader() web.LoaderFunc {
+	mode := persona.MergeOverride
+	if opts.strictPersonas {
+		mode = persona.MergeRejectConflicts
+	}
+	return func(path string) ([]persona.Persona, error) {
+		return persona.LoadFromPathList(path, mode)
 	}
 }
 
 func parseRuntimeOptions(args []string) (runtimeOptions, error) {
 	fs := flag.NewFlagSet("debate", flag.ContinueOnError)
-	personaPath := fs.String("personas", config.DefaultPersonaPath, "path to personas json file")
+	personaPath := fs.String("personas", config.DefaultPersonaPath, "path to personas json file, or a comma-separated list of files to merge")
+	strictPersonas := fs.Bool("strict-personas", false, "fail when merged persona files declare the same id instead of letting the later file win")
 	fs.StringVar(personaPath, "persona", config.DefaultPersonaPath, "alias of -personas")
+	personasInline := fs.String("personas-inline", "", "JSON array of personas to use instead of a persona file (cannot be combined with -personas)")
 	addr := fs.String("addr", "", "web server listen address (e.g. :8080)")
+	formats := fs.String("formats", "json,md", "comma-separated output formats: json,md,html,txt,jsonl,script,ssml")
+	fs.StringVar(formats, "format", "json,md", "alias of -formats")
+	fs.StringVar(formats, "output-format", "json,md", "alias of -formats")
+	problem := fs.String("problem", "", "run one debate on this problem, print the saved paths, and exit")
+	resume := fs.String("resume", "", "continue the saved .json result at this path for up to -max-turns more turns, save it, and exit")
+	moderatorName := fs.String("moderator-name", "", "display name for moderator turns (default 사회자)")
+	check := fs.Bool("check", false, "validate config, personas and output dir without calling the API, then exit")
+	savePrompts := fs.Bool("save-prompts", false, "also save the system prompts and config snapshot as <result>.prompts.json")
+	dryRun := fs.Bool("dry-run", false, "print every system/user prompt to stderr and use canned replies instead of calling the API")
+	budgetProfile := fs.Int("budget-profile", 0, "print the prompt budget per turn count for this many personas, then exit")
+	recommend := fs.Int("recommend", 0, "print a suggested panel of this many personas for -problem without calling the API, then exit")
+	lang := fs.String("lang", "", "force the response language with a simple tag such as en or ko (default: problem language)")
+	maxTurns := fs.Int("max-turns", 0, "override DEBATE_MAX_TURNS (0 = unlimited)")
+	threshold := fs.Float64("threshold", 0, "override DEBATE_CONSENSUS_THRESHOLD (0..1)")
+	maxDuration := fs.Duration("max-duration", 0, "override DEBATE_MAX_DURATION (e.g. 10m)")
+	maxTokens := fs.Int("max-tokens", 0, "override DEBATE_MAX_TOTAL_TOKENS")
 	fs.SetOutput(os.Stderr)
 
 	if err := fs.Parse(args); err != nil {
@@ -102,8 +330,122 @@ func parseRuntimeOptions(args []string) (runtimeOptions, error) {
 	if path == "" {
 		path = config.DefaultPersonaPath
 	}
-	return runtimeOptions{
-		personaPath: path,
-		addr:        strings.TrimSpace(*addr),
-	}, nil
+	outputFormats, err := output.ParseFormats(*formats)
+	if err != nil {
+		return runtimeOptions{}, fmt.Errorf("-formats: %w", err)
+	}
+	language := strings.TrimSpace(*lang)
+	if language != "" && !languageTagPattern.MatchString(language) {
+		return runtimeOptions{}, fmt.Errorf("-lang must be a simple language tag such as en or ko, got %q", language)
+	}
+	opts := runtimeOptions{
+		personaPath:    path,
+		strictPersonas: *strictPersonas,
+		addr:           strings.TrimSpace(*addr),
+		formats:        outputFormats,
+		problem:        strings.TrimSpace(*problem),
+		resumePath:     strings.TrimSpace(*resume),
+		moderatorName:  strings.TrimSpace(*moderatorName),
+		language:       language,
+		check:          *check,
+		savePrompts:    *savePrompts,
+		dryRun:         *dryRun,
+		budgetProfile:  *budgetProfile,
+		recommend:      *recommend,
+	}
+	var limitErr error
+	personaPathSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if limitErr != nil {
+			return
+		}
+		switch f.Name {
+		case "personas", "persona":
+			personaPathSet = true
+		case "max-turns":
+			if !config.ValidMaxTurns(*maxTurns) {
+				limitErr = fmt.Errorf("-max-turns has invalid value: %d", *maxTurns)
+			}
+			opts.maxTurns = maxTurns
+		case "threshold":
+			if !config.ValidConsensusThreshold(*threshold) {
+				limitErr = fmt.Errorf("-threshold has invalid value: %v", *threshold)
+			}
+			opts.consensusThreshold = threshold
+		case "max-duration":
+			if !config.ValidMaxDuration(*maxDuration) {
+				limitErr = fmt.Errorf("-max-duration has invalid value: %s", *maxDuration)
+			}
+			opts.maxDuration = maxDuration
+		case "max-tokens":
+			if !config.ValidMaxTotalTokens(*maxTokens) {
+				limitErr = fmt.Errorf("-max-tokens has invalid value: %d", *maxTokens)
+			}
+			opts.maxTotalTokens = maxTokens
+		}
+	})
+	if limitErr != nil {
+		return runtimeOptions{}, limitErr
+	}
+	if strings.TrimSpace(*personasInline) != "" {
+		switch {
+		case personaPathSet:
+			return runtimeOptions{}, errors.New("-personas and -personas-inline cannot be used together")
+		case opts.resumePath != "":
+			return runtimeOptions{}, errors.New("-resume cannot be combined with -personas-inline")
+		}
+		inline, err := parseInlinePersonas(*personasInline)
+		if err != nil {
+			return runtimeOptions{}, fmt.Errorf("-personas-inline: %w", err)
+		}
+		opts.inlinePersonas = inline
+	}
+	if opts.problem != "" && opts.addr != "" {
+		return runtimeOptions{}, errors.New("-addr cannot be combined with -problem")
+	}
+	if opts.resumePath != "" {
+		switch {
+		case opts.problem != "":
+			return runtimeOptions{}, errors.New("-resume cannot be combined with -problem")
+		case opts.addr != "":
+			return runtimeOptions{}, errors.New("-addr cannot be combined with -resume")
+		case opts.check:
+			return runtimeOptions{}, errors.New("-check cannot be combined with -resume")
+		}
+	}
+	if opts.budgetProfile < 0 {
+		return runtimeOptions{}, fmt.Errorf("-budget-profile must be a positive persona count, got %d", opts.budgetProfile)
+	}
+	if opts.check && opts.problem != "" {
+		return runtimeOptions{}, errors.New("-check cannot be combined with -problem")
+	}
+	if opts.dryRun && opts.check {
+		return runtimeOptions{}, errors.New("-check cannot be combined with -dry-run")
+	}
+	if opts.recommend < 0 {
+		return runtimeOptions{}, fmt.Errorf("-recommend must be a positive panel size, got %d", opts.recommend)
+	}
+	if opts.recommend > 0 {
+		switch {
+		case opts.problem == "":
+			return runtimeOptions{}, errors.New("-recommend requires -problem")
+		case opts.addr != "":
+			return runtimeOptions{}, errors.New("-addr cannot be combined with -recommend")
+		case opts.resumePath != "":
+			return runtimeOptions{}, errors.New("-resume cannot be combined with -recommend")
+		case opts.check:
+			return runtimeOptions{}, errors.New("-check cannot be combined with -recommend")
+		}
+	}
+	return opts, nil
+}
+
+// parseInlinePersonas decodes a -personas-inline JSON array and applies the
+// same normalization and validation as a persona file.
+func parseInlinePersonas(raw string) ([]persona.Persona, error) {
+	var personas []persona.Persona
+	if err := json.Unmarshal([]byte(raw), &personas); err != nil {
+		return nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+	return persona.NormalizeAndValidate(personas)
 }
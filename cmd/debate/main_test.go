@@ -1,10 +1,15 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"debate/internal/config"
+	"debate/internal/output"
 )
 
 func TestParseRuntimeOptionsDefaults(t *testing.T) {
@@ -30,6 +35,35 @@ func TestParseRuntimeOptionsPersonasFlag(t *testing.T) {
 	}
 }
 
+func TestParseRuntimeOptionsPersonaListAndStrictMerge(t *testing.T) {
+	dir := t.TempDir()
+	teamA := filepath.Join(dir, "a.json")
+	teamB := filepath.Join(dir, "b.json")
+	if err := os.WriteFile(teamA, []byte(`[{"id":"pm","name":"PM","role":"product"},{"id":"ops","name":"Ops","role":"ops"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(teamB, []byte(`[{"id":"ops","name":"Ops 2","role":"sre"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts, err := parseRuntimeOptions([]string{"--personas", teamA + "," + teamB})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	personas, err := opts.personaLoader()(opts.personaPath)
+	if err != nil || len(personas) != 2 || personas[1].Role != "sre" {
+		t.Fatalf("expected later file to override ops, got %+v err=%v", personas, err)
+	}
+
+	opts, err = parseRuntimeOptions([]string{"--personas", teamA + "," + teamB, "--strict-personas"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := opts.personaLoader()(opts.personaPath); err == nil {
+		t.Fatal("expected conflict error with -strict-personas")
+	}
+}
+
 func TestParseRuntimeOptionsPersonaAlias(t *testing.T) {
 	opts, err := parseRuntimeOptions([]string{"--persona", "./custom.json"})
 	if err != nil {
@@ -64,6 +98,75 @@ func TestParseRuntimeOptionsRejectsUnknownFlag(t *testing.T) {
 	}
 }
 
+func TestParseRuntimeOptionsFormatsDefault(t *testing.T) {
+	opts, err := parseRuntimeOptions(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(opts.formats, []output.Format{output.FormatJSON, output.FormatMarkdown}) {
+		t.Fatalf("unexpected default formats: %v", opts.formats)
+	}
+}
+
+func TestParseRuntimeOptionsFormatsFlag(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--formats", " JSON, txt,jsonl,html,txt "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []output.Format{output.FormatJSON, output.FormatText, output.FormatJSONL, output.FormatHTML}
+	if !reflect.DeepEqual(opts.formats, want) {
+		t.Fatalf("unexpected formats: got %v, want %v", opts.formats, want)
+	}
+}
+
+func TestParseRuntimeOptionsOutputFormatFlag(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--output-format", "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(opts.formats, []output.Format{output.FormatJSON}) {
+		t.Fatalf("unexpected formats: %v", opts.formats)
+	}
+}
+
+func TestParseRuntimeOptionsRejectsUnknownFormat(t *testing.T) {
+	_, err := parseRuntimeOptions([]string{"--formats", "json,pdf"})
+	if err == nil || !strings.Contains(err.Error(), `unknown output format "pdf"`) {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+}
+
+func TestParseRuntimeOptionsModeratorNameAndLang(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--moderator-name", " Moderator ", "--lang", "pt-BR"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.moderatorName != "Moderator" {
+		t.Fatalf("unexpected moderator name: %q", opts.moderatorName)
+	}
+	if opts.language != "pt-BR" {
+		t.Fatalf("unexpected language: %q", opts.language)
+	}
+}
+
+func TestParseRuntimeOptionsModeratorNameAndLangDefaults(t *testing.T) {
+	opts, err := parseRuntimeOptions(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.moderatorName != "" || opts.language != "" {
+		t.Fatalf("expected empty defaults, got name=%q lang=%q", opts.moderatorName, opts.language)
+	}
+}
+
+func TestParseRuntimeOptionsRejectsInvalidLang(t *testing.T) {
+	for _, lang := range []string{"english please", "e", "en_US", "ko-"} {
+		if _, err := parseRuntimeOptions([]string{"--lang", lang}); err == nil {
+			t.Fatalf("expected invalid -lang error for %q", lang)
+		}
+	}
+}
+
 func TestOrchestratorConfigFromSettings(t *testing.T) {
 	settings := config.Settings{
 		MaxTurns:           11,
@@ -106,3 +209,152 @@ func TestOrchestratorConfigFromSettings(t *testing.T) {
 		t.Fatalf("unexpected audience mode: %s", got.AudienceMode)
 	}
 }
+
+func TestRuntimeOptionsLimitFlagsOverrideEnv(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	t.Setenv("DEBATE_MAX_TURNS", "10")
+	t.Setenv("DEBATE_CONSENSUS_THRESHOLD", "0.7")
+	t.Setenv("DEBATE_MAX_DURATION", "5m")
+	t.Setenv("DEBATE_MAX_TOTAL_TOKENS", "5000")
+
+	cases := []struct {
+		name  string
+		args  []string
+		check func(config.Settings) bool
+	}{
+		{"max-turns", []string{"--max-turns", "3"}, func(s config.Settings) bool { return s.MaxTurns == 3 }},
+		{"threshold", []string{"--threshold", "0.95"}, func(s config.Settings) bool { return s.ConsensusThreshold == 0.95 }},
+		{"max-duration", []string{"--max-duration", "90s"}, func(s config.Settings) bool { return s.MaxDuration == 90*time.Second }},
+		{"max-tokens", []string{"--max-tokens", "777"}, func(s config.Settings) bool { return s.MaxTotalTokens == 777 }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts, err := parseRuntimeOptions(tc.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			settings, err := config.FromEnv()
+			if err != nil {
+				t.Fatalf("unexpected config error: %v", err)
+			}
+			got := opts.applySettingsOverrides(settings)
+			if !tc.check(got) {
+				t.Fatalf("flag did not override env value: %+v", got)
+			}
+		})
+	}
+}
+
+func TestRuntimeOptionsWithoutLimitFlagsKeepEnv(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	t.Setenv("DEBATE_MAX_TURNS", "10")
+
+	opts, err := parseRuntimeOptions(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	settings, err := config.FromEnv()
+	if err != nil {
+		t.Fatalf("unexpected config error: %v", err)
+	}
+	if got := opts.applySettingsOverrides(settings); !reflect.DeepEqual(got, settings) {
+		t.Fatalf("expected env settings unchanged, got %+v", got)
+	}
+}
+
+func TestParseRuntimeOptionsRejectsInvalidLimitFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--max-turns", "-1"},
+		{"--threshold", "1.5"},
+		{"--threshold", "-0.1"},
+		{"--max-duration", "0s"},
+		{"--max-tokens", "0"},
+		{"--max-tokens", "abc"},
+	} {
+		if _, err := parseRuntimeOptions(args); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseRuntimeOptionsDryRun(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"-dry-run", "-problem", "x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.dryRun {
+		t.Fatal("expected dry-run flag to be set")
+	}
+	if _, err := parseRuntimeOptions([]string{"-dry-run", "-check"}); err == nil {
+		t.Fatal("expected error combining -dry-run and -check")
+	}
+}
+
+func TestParseRuntimeOptionsPersonasInline(t *testing.T) {
+	inline := `[{"name":"Architect","role":"architecture"},{"id":"ops","name":"Operator","role":"operations"}]`
+	opts, err := parseRuntimeOptions([]string{"-personas-inline", inline, "-problem", "x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(opts.inlinePersonas) != 2 || opts.inlinePersonas[1].ID != "ops" {
+		t.Fatalf("unexpected inline personas: %+v", opts.inlinePersonas)
+	}
+	if opts.inlinePersonas[0].ID == "" {
+		t.Fatal("expected inline personas to be normalized with generated ids")
+	}
+	loaded, err := opts.personaLoader()("does-not-exist.json")
+	if err != nil {
+		t.Fatalf("expected inline personas to bypass the file loader: %v", err)
+	}
+	if len(loaded) != 2 {
+		t.Fatalf("expected 2 personas from loader, got %d", len(loaded))
+	}
+}
+
+func TestParseRuntimeOptionsRejectsPersonasInlineWithPath(t *testing.T) {
+	inline := `[{"name":"A","role":"a"},{"name":"B","role":"b"}]`
+	for _, flagName := range []string{"-personas", "-persona"} {
+		_, err := parseRuntimeOptions([]string{flagName, "p.json", "-personas-inline", inline})
+		if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+			t.Fatalf("%s: expected mutual exclusion error, got %v", flagName, err)
+		}
+	}
+}
+
+func TestParseRuntimeOptionsAllowsPersonasInlineWithAddr(t *testing.T) {
+	inline := `[{"name":"A","role":"a"},{"name":"B","role":"b"}]`
+	opts, err := parseRuntimeOptions([]string{"-personas-inline", inline, "-addr", ":9090"})
+	if err != nil {
+		t.Fatalf("expected -addr to work with -personas-inline, got %v", err)
+	}
+	if opts.addr != ":9090" {
+		t.Fatalf("unexpected addr: %q", opts.addr)
+	}
+	loaded, err := opts.personaLoader()(opts.personaPath)
+	if err != nil {
+		t.Fatalf("load inline personas: %v", err)
+	}
+	if len(loaded) != 2 || loaded[0].Name != "A" {
+		t.Fatalf("expected inline personas, got %+v", loaded)
+	}
+
+	path := filepath.Join(t.TempDir(), "other.json")
+	if err := os.WriteFile(path, []byte(`[{"name":"C","role":"c"},{"name":"D","role":"d"}]`), 0o644); err != nil {
+		t.Fatalf("write personas: %v", err)
+	}
+	fromFile, err := opts.fileLoader()(path)
+	if err != nil {
+		t.Fatalf("load explicit path: %v", err)
+	}
+	if len(fromFile) != 2 || fromFile[0].Name != "C" {
+		t.Fatalf("expected the web file loader to read explicit paths, got %+v", fromFile)
+	}
+}
+
+func TestParseRuntimeOptionsRejectsInvalidPersonasInline(t *testing.T) {
+	for _, inline := range []string{`{"name":"A"}`, `[{"name":"A","role":"a"}]`} {
+		if _, err := parseRuntimeOptions([]string{"-personas-inline", inline}); err == nil {
+			t.Fatalf("expected error for %s", inline)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"debate/internal/orchestrator"
+	"debate/internal/output"
+	"debate/internal/persona"
+	"debate/internal/web"
+)
+
+// oneShotRun holds what a single non-interactive debate needs, so the flow
+// can be exercised without an API key or the real clock.
+type oneShotRun struct {
+	problem string
+	// resumePath, when set, continues the saved result at this path instead
+	// of starting a new debate on problem.
+	resumePath  string
+	personaPath string
+	formats     []output.Format
+	outputDir   string
+	runner      web.Runner
+	runnerCfg   orchestrator.Config
+	// prompts, when non-nil, are archived next to the result.
+	prompts map[string]string
+	// fallbackDir receives the result when outputDir cannot be written;
+	// empty disables the fallback.
+	fallbackDir string
+	// metricsCSV, when set, gets one appended row for the saved result.
+	metricsCSV string
+	loader     web.LoaderFunc
+	now        func() time.Time
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+// resumeRunner is implemented by runners that can continue a saved debate.
+type resumeRunner interface {
+	ResumeFromResult(ctx context.Context, prior orchestrator.Result, additionalTurns int, onTurn func(orchestrator.Turn)) (orchestrator.Result, error)
+}
+
+// runOneShot runs one debate, saves it, prints the saved paths, and returns
+// the process exit code from exitCodeForStatus.
+func runOneShot(ctx context.Context, run oneShotRun) int {
+	var result orchestrator.Result
+	var runErr error
+	if run.resumePath != "" {
+		resumer, ok := run.runner.(resumeRunner)
+		if !ok {
+			_, _ = fmt.Fprintln(run.stderr, "resume error: runner cannot resume saved results")
+			return exitError
+		}
+		prior, err := output.LoadResult(run.resumePath)
+		if err != nil {
+			_, _ = fmt.Fprintln(run.stderr, "resume error:", err)
+			return exitError
+		}
+		result, runErr = resumer.ResumeFromResult(ctx, prior, 0, nil)
+	} else {
+		personas, err := run.loader(run.personaPath)
+		if err != nil {
+			_, _ = fmt.Fprintln(run.stderr, "persona error:", err)
+			return exitError
+		}
+		for _, warning := range persona.Warnings(personas) {
+			_, _ = fmt.Fprintln(run.stderr, "persona warning:", warning)
+		}
+		result, runErr = run.runner.Run(ctx, run.problem, personas, nil)
+	}
+	if runErr != nil {
+		_, _ = fmt.Fprintln(run.stderr, "debate error:", runErr)
+	}
+	if len(result.Turns) > 0 {
+		path := output.NewTimestampPath(run.outputDir, run.now())
+		if err := output.SaveResultFormats(path, result, run.formats); err != nil {
+			if run.fallbackDir == "" {
+				_, _ = fmt.Fprintln(run.stderr, "save error:", err)
+				return exitError
+			}
+			fallbackPath, fallbackErr := output.SaveResultFallback(run.fallbackDir, result, run.formats, run.now())
+			if fallbackErr != nil {
+				_, _ = fmt.Fprintf(run.stderr, "save error: %v; fallback save also failed: %v\n", err, fallbackErr)
+				return exitError
+			}
+			_, _ = fmt.Fprintf(run.stderr, "save warning: %v; saved to fallback path %s instead\n", err, fallbackPath)
+			path = fallbackPath
+		}
+		for _, format := range run.formats {
+			_, _ = fmt.Fprintln(run.stdout, output.FormatPath(path, format))
+		}
+		if run.metricsCSV != "" {
+			if err := output.AppendMetricsCSV(run.metricsCSV, result); err != nil {
+				_, _ = fmt.Fprintln(run.stderr, "metrics warning:", err)
+			}
+		}
+		if run.prompts != nil {
+			if err := output.SavePrompts(path, output.PromptArchive{
+				SystemPrompts: run.prompts,
+				Personas:      result.Personas,
+				Config:        run.runnerCfg,
+			}); err != nil {
+				_, _ = fmt.Fprintln(run.stderr, "prompts warning:", err)
+			} else {
+				_, _ = fmt.Fprintln(run.stdout, output.PromptsPath(path))
+			}
+		}
+	}
+	_, _ = fmt.Fprintln(run.stdout, "status:", result.Status)
+
+	if runErr != nil {
+		return exitError
+	}
+	return exitCodeForStatus(result.Status)
+}
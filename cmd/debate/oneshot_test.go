@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/openai"
+	"debate/internal/orchestrator"
+	"debate/internal/output"
+	"debate/internal/persona"
+)
+
+type stubRunner struct {
+	result orchestrator.Result
+	err    error
+}
+
+func (r stubRunner) Run(_ context.Context, problem string, _ []persona.Persona, _ func(orchestrator.Turn)) (orchestrator.Result, error) {
+	r.result.Problem = problem
+	return r.result, r.err
+}
+
+func stubPersonaLoader(string) ([]persona.Persona, error) {
+	return []persona.Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "b", Name: "B", Role: "r2"},
+	}, nil
+}
+
+func newOneShotRun(t *testing.T, runner stubRunner) (oneShotRun, *bytes.Buffer) {
+	t.Helper()
+	var stdout bytes.Buffer
+	return oneShotRun{
+		problem:     "Ship now?",
+		personaPath: "personas.json",
+		formats:     output.DefaultFormats,
+		outputDir:   t.TempDir(),
+		runner:      runner,
+		loader:      stubPersonaLoader,
+		now:         func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+		stdout:      &stdout,
+		stderr:      &bytes.Buffer{},
+	}, &stdout
+}
+
+func TestParseRuntimeOptionsProblem(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--problem", "  Ship now?  ", "--format", "txt"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.problem != "Ship now?" {
+		t.Fatalf("unexpected problem: %q", opts.problem)
+	}
+	if len(opts.formats) != 1 || opts.formats[0] != output.FormatText {
+		t.Fatalf("expected -format alias to set formats, got %v", opts.formats)
+	}
+}
+
+func TestParseRuntimeOptionsRejectsProblemWithAddr(t *testing.T) {
+	_, err := parseRuntimeOptions([]string{"--problem", "x", "--addr", ":8090"})
+	if err == nil {
+		t.Fatal("expected error combining -problem and -addr")
+	}
+}
+
+func TestRunOneShotSavesAndSucceeds(t *testing.T) {
+	run, stdout := newOneShotRun(t, stubRunner{result: orchestrator.Result{
+		Status: orchestrator.StatusConsensusReached,
+		Turns:  []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "ok"}},
+	}})
+
+	if code := runOneShot(context.Background(), run); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 3 || !strings.HasSuffix(lines[0], ".json") || !strings.HasSuffix(lines[1], ".md") {
+		t.Fatalf("expected saved paths then status, got %q", stdout.String())
+	}
+	if _, err := os.Stat(lines[0]); err != nil {
+		t.Fatalf("expected saved json file: %v", err)
+	}
+}
+
+func TestRunOneShotSavesPromptArchive(t *testing.T) {
+	client, err := openai.NewClient(openai.Config{APIKey: "test-key", Model: "gpt-test", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	run, stdout := newOneShotRun(t, stubRunner{result: orchestrator.Result{
+		Status:   orchestrator.StatusConsensusReached,
+		Personas: []persona.Persona{{ID: "a", Name: "A", Role: "r1"}},
+		Turns:    []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "ok"}},
+	}})
+	run.prompts = client.SystemPrompts()
+	run.runnerCfg = orchestrator.Config{MaxTurns: 7}
+
+	if code := runOneShot(context.Background(), run); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 4 || !strings.HasSuffix(lines[2], ".prompts.json") {
+		t.Fatalf("expected prompt archive path after result paths, got %q", stdout.String())
+	}
+	data, err := os.ReadFile(lines[2])
+	if err != nil {
+		t.Fatalf("read prompt archive: %v", err)
+	}
+	var archive output.PromptArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		t.Fatalf("decode prompt archive: %v", err)
+	}
+	if judge := run.prompts["judge"]; judge == "" || archive.SystemPrompts["judge"] != judge {
+		t.Fatalf("expected archive to contain the judge system prompt, got %q", archive.SystemPrompts["judge"])
+	}
+	if archive.Config.MaxTurns != 7 || len(archive.Personas) != 1 {
+		t.Fatalf("unexpected config/persona snapshot: %+v %+v", archive.Config, archive.Personas)
+	}
+}
+
+func TestRunOneShotTreatsPromptArchiveFailureAsWarning(t *testing.T) {
+	run, stdout := newOneShotRun(t, stubRunner{result: orchestrator.Result{
+		Status: orchestrator.StatusConsensusReached,
+		Turns:  []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "ok"}},
+	}})
+	var stderr bytes.Buffer
+	run.stderr = &stderr
+	run.prompts = map[string]string{"turn": "system"}
+	// A non-empty directory where the archive goes makes only that write fail.
+	blocker := output.PromptsPath(output.NewTimestampPath(run.outputDir, run.now()))
+	if err := os.MkdirAll(filepath.Join(blocker, "x"), 0o755); err != nil {
+		t.Fatalf("create blocker: %v", err)
+	}
+
+	if code := runOneShot(context.Background(), run); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr.String())
+	}
+	if !strings.Contains(stderr.String(), "prompts warning:") {
+		t.Fatalf("expected a prompts warning, got %q", stderr.String())
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 3 || strings.HasSuffix(lines[1], ".prompts.json") {
+		t.Fatalf("expected result paths without an archive path, got %q", stdout.String())
+	}
+	if _, err := os.Stat(lines[0]); err != nil {
+		t.Fatalf("expected saved json file: %v", err)
+	}
+}
+
+func TestRunOneShotFallsBackWhenOutputDirUnwritable(t *testing.T) {
+	run, stdout := newOneShotRun(t, stubRunner{result: orchestrator.Result{
+		Status: orchestrator.StatusConsensusReached,
+		Turns:  []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "ok"}},
+	}})
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	if err := os.WriteFile(blocker, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write blocker: %v", err)
+	}
+	var stderr bytes.Buffer
+	run.outputDir = filepath.Join(blocker, "outputs")
+	run.fallbackDir = t.TempDir()
+	run.stderr = &stderr
+
+	if code := runOneShot(context.Background(), run); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	jsonPath := strings.Split(stdout.String(), "\n")[0]
+	if !strings.HasPrefix(jsonPath, run.fallbackDir) {
+		t.Fatalf("expected the result under the fallback dir, got %q", jsonPath)
+	}
+	if _, err := os.Stat(jsonPath); err != nil {
+		t.Fatalf("expected fallback json file: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "save warning:") || !strings.Contains(stderr.String(), jsonPath) {
+		t.Fatalf("expected a warning naming the fallback path, got %q", stderr.String())
+	}
+}
+
+func TestRunOneShotMapsNonConsensusStatusToExitCode(t *testing.T) {
+	run, _ := newOneShotRun(t, stubRunner{result: orchestrator.Result{
+		Status: orchestrator.StatusNoProgressReached,
+		Turns:  []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "ok"}},
+	}})
+
+	if code := runOneShot(context.Background(), run); code != exitNoProgress {
+		t.Fatalf("expected exit code %d, got %d", exitNoProgress, code)
+	}
+}
+
+func TestRunOneShotExitsNonZeroOnErrorStatus(t *testing.T) {
+	run, stdout := newOneShotRun(t, stubRunner{
+		result: orchestrator.Result{Status: orchestrator.StatusError},
+		err:    errors.New("llm unavailable"),
+	})
+
+	if code := runOneShot(context.Background(), run); code == 0 {
+		t.Fatal("expected non-zero exit code on error status")
+	}
+	if !strings.Contains(stdout.String(), "status: error") {
+		t.Fatalf("expected status line, got %q", stdout.String())
+	}
+}
+
+type stubResumeRunner struct {
+	stubRunner
+	prior orchestrator.Result
+}
+
+func (r *stubResumeRunner) ResumeFromResult(_ context.Context, prior orchestrator.Result, _ int, _ func(orchestrator.Turn)) (orchestrator.Result, error) {
+	r.prior = prior
+	prior.Turns = append(prior.Turns, orchestrator.Turn{Index: len(prior.Turns) + 1, SpeakerID: "b", Type: orchestrator.TurnTypePersona, Content: "more"})
+	prior.Status = orchestrator.StatusConsensusReached
+	return prior, nil
+}
+
+func TestParseRuntimeOptionsResume(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"--resume", " out/run.json ", "--max-turns", "4"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.resumePath != "out/run.json" {
+		t.Fatalf("unexpected resume path: %q", opts.resumePath)
+	}
+	for _, args := range [][]string{
+		{"--resume", "a.json", "--problem", "x"},
+		{"--resume", "a.json", "--addr", ":8090"},
+		{"--resume", "a.json", "--check"},
+	} {
+		if _, err := parseRuntimeOptions(args); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
+
+func TestRunOneShotResumesSavedResult(t *testing.T) {
+	priorPath := filepath.Join(t.TempDir(), "prior")
+	prior := orchestrator.Result{
+		Problem: "Ship now?",
+		Status:  orchestrator.StatusMaxTurnsReached,
+		Turns:   []orchestrator.Turn{{Index: 1, SpeakerID: "a", Type: orchestrator.TurnTypePersona, Content: "first"}},
+	}
+	if err := output.SaveResultFormats(priorPath, prior, []output.Format{output.FormatJSON}); err != nil {
+		t.Fatalf("save prior: %v", err)
+	}
+	runner := &stubResumeRunner{}
+	run, stdout := newOneShotRun(t, stubRunner{})
+	run.problem = ""
+	run.resumePath = output.FormatPath(priorPath, output.FormatJSON)
+	run.runner = runner
+
+	if code := runOneShot(context.Background(), run); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if runner.prior.Problem != "Ship now?" || len(runner.prior.Turns) != 1 {
+		t.Fatalf("expected saved result to be passed to the runner, got %+v", runner.prior)
+	}
+	if !strings.Contains(stdout.String(), "status: consensus_reached") {
+		t.Fatalf("expected status line, got %q", stdout.String())
+	}
+}
+
+func TestRunOneShotResumeRequiresResumableRunner(t *testing.T) {
+	run, _ := newOneShotRun(t, stubRunner{})
+	run.resumePath = "missing.json"
+	if code := runOneShot(context.Background(), run); code != exitError {
+		t.Fatalf("expected exit code %d, got %d", exitError, code)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"debate/internal/orchestrator"
+)
+
+// runRecommend prints the panel orchestrator.RecommendPanel suggests for
+// opts.problem from the configured roster. It never calls the API.
+func runRecommend(opts runtimeOptions, stdout io.Writer, stderr io.Writer) int {
+	personas, err := opts.personaLoader()(opts.personaPath)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "persona load error:", err)
+		return 1
+	}
+	panel, err := orchestrator.RecommendPanel(opts.problem, personas, opts.recommend)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "recommend error:", err)
+		return 1
+	}
+
+	_, _ = fmt.Fprintf(stdout, "recommended panel (%d of %d personas)\n", len(panel), len(personas))
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	for _, p := range panel {
+		_, _ = fmt.Fprintln(tw, strings.Join([]string{p.ID, p.Name, p.Role}, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return 1
+	}
+	return 0
+}
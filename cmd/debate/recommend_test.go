@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunRecommendPrintsPanel(t *testing.T) {
+	path := writeCheckPersonaFile(t, `[
+		{"id":"pm","name":"PM","role":"product"},
+		{"id":"db","name":"DB","role":"database","expertise":["postgres"]},
+		{"id":"sec","name":"Sec","role":"security"}
+	]`)
+
+	var stdout, stderr bytes.Buffer
+	code := runRecommend(runtimeOptions{personaPath: path, problem: "postgres vacuum tuning", recommend: 2}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[0], "2 of 3") || !strings.HasPrefix(lines[1], "db ") {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+}
+
+func TestRunRecommendRejectsOversizedPanel(t *testing.T) {
+	path := writeCheckPersonaFile(t, `[{"id":"a","name":"A","role":"r1"},{"id":"b","name":"B","role":"r2"}]`)
+
+	var stdout, stderr bytes.Buffer
+	if code := runRecommend(runtimeOptions{personaPath: path, problem: "p", recommend: 3}, &stdout, &stderr); code != 1 {
+		t.Fatalf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "recommend error") {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+}
+
+func TestParseRuntimeOptionsRecommend(t *testing.T) {
+	opts, err := parseRuntimeOptions([]string{"-recommend", "3", "-problem", "x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.recommend != 3 || opts.problem != "x" {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+	for _, args := range [][]string{
+		{"-recommend", "3"},
+		{"-recommend", "-1", "-problem", "x"},
+		{"-recommend", "3", "-problem", "x", "-addr", ":8080"},
+	} {
+		if _, err := parseRuntimeOptions(args); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
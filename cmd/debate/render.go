@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"debate/internal/orchestrator"
+	"debate/internal/output"
+)
+
+// renderCommand is the subcommand that re-exports a saved result offline.
+const renderCommand = "render"
+
+// runRender implements `debate render <result.json> [-format md] [-o path]`:
+// it loads a saved JSON result and writes it in another format to stdout or
+// the -o file. -from, -to and -speakers export only an excerpt of the turns.
+// It needs no API key and returns the process exit code.
+func runRender(args []string, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("debate render", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", string(output.FormatMarkdown), "output format: json,md,html,txt,jsonl,script,ssml")
+	outPath := fs.String("o", "", "write to this file instead of stdout")
+	from := fs.Int("from", 0, "excerpt: first turn index to keep (0 = from the start)")
+	to := fs.Int("to", 0, "excerpt: last turn index to keep (0 = to the end)")
+	speakers := fs.String("speakers", "", "excerpt: comma-separated speaker IDs to keep")
+
+	// Accept the result path before or after the flags.
+	var path string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		path, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if path == "" && fs.NArg() > 0 {
+		path = fs.Arg(0)
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return exitError
+		}
+	}
+	if fs.NArg() > 0 {
+		_, _ = fmt.Fprintf(stderr, "render: unexpected arguments: %s\n", strings.Join(fs.Args(), " "))
+		return exitError
+	}
+	if path == "" {
+		_, _ = fmt.Fprintln(stderr, "render: usage: debate render <result.json> [-format md] [-o path] [-from N] [-to N] [-speakers id,...]")
+		return exitError
+	}
+
+	result, err := output.LoadResult(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			_, _ = fmt.Fprintf(stderr, "render: result file not found: %s\n", path)
+		} else {
+			_, _ = fmt.Fprintf(stderr, "render: %v\n", err)
+		}
+		return exitError
+	}
+	filter := output.TurnFilter{FromIndex: *from, ToIndex: *to, SpeakerIDs: strings.Split(*speakers, ",")}
+	data, err := renderExcerpt(result, output.Format(*format), filter)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "render: %v\n", err)
+		return exitError
+	}
+
+	if *outPath == "" {
+		if _, err := stdout.Write(data); err != nil {
+			_, _ = fmt.Fprintf(stderr, "render: write stdout: %v\n", err)
+			return exitError
+		}
+		return 0
+	}
+	if err := os.WriteFile(*outPath, data, 0o644); err != nil {
+		_, _ = fmt.Fprintf(stderr, "render: write %s: %v\n", *outPath, err)
+		return exitError
+	}
+	_, _ = fmt.Fprintln(stdout, *outPath)
+	return 0
+}
+
+// renderExcerpt renders only the turns matching filter. Markdown keeps the
+// full header and consensus via FormatResultFiltered; other formats render
+// the result with its turns trimmed.
+func renderExcerpt(result orchestrator.Result, format output.Format, filter output.TurnFilter) ([]byte, error) {
+	if filter.Empty() {
+		return output.Render(result, format)
+	}
+	if output.Format(strings.ToLower(strings.TrimSpace(string(format)))) == output.FormatMarkdown {
+		return []byte(output.FormatResultFiltered(result, filter)), nil
+	}
+	result.Turns = output.FilterTurns(result.Turns, filter)
+	return output.Render(result, format)
+}
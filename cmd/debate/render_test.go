@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"debate/internal/orchestrator"
+)
+
+func writeRenderResultFile(t *testing.T) string {
+	t.Helper()
+	data, err := json.Marshal(orchestrator.Result{
+		Problem: "모놀리스를 유지할까?",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "Architect", Type: orchestrator.TurnTypePersona, Content: "배포 파이프라인부터 고칩시다."},
+		},
+	})
+	if err != nil {
+		t.Fatalf("marshal result: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "run-debate.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write result: %v", err)
+	}
+	return path
+}
+
+func TestRunRenderWritesMarkdownToStdout(t *testing.T) {
+	path := writeRenderResultFile(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := runRender([]string{path, "-format", "md"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	md := stdout.String()
+	if !strings.HasPrefix(md, "# Debate Result\n") || !strings.Contains(md, "배포 파이프라인부터 고칩시다.") {
+		t.Fatalf("unexpected markdown: %q", md)
+	}
+}
+
+func TestRunRenderWritesToFile(t *testing.T) {
+	path := writeRenderResultFile(t)
+	outPath := filepath.Join(t.TempDir(), "out.txt")
+
+	var stdout, stderr bytes.Buffer
+	if code := runRender([]string{"-format", "txt", "-o", outPath, path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read rendered file: %v", err)
+	}
+	if !strings.Contains(string(data), "consensus_reached") {
+		t.Fatalf("unexpected text output: %q", data)
+	}
+}
+
+func TestRunRenderRejectsUnknownFormatAndMissingFile(t *testing.T) {
+	path := writeRenderResultFile(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := runRender([]string{path, "-format", "pdf"}, &stdout, &stderr); code != exitError {
+		t.Fatalf("expected exit code %d for unknown format, got %d", exitError, code)
+	}
+	if !strings.Contains(stderr.String(), `unknown output format "pdf"`) {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+
+	stderr.Reset()
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	if code := runRender([]string{missing}, &stdout, &stderr); code != exitError {
+		t.Fatalf("expected exit code %d for missing file, got %d", exitError, code)
+	}
+	if !strings.Contains(stderr.String(), "result file not found") {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+}
+
+func TestRunRenderExcerptFlags(t *testing.T) {
+	data, err := json.Marshal(orchestrator.Result{
+		Problem: "모놀리스를 유지할까?",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "Architect", Type: orchestrator.TurnTypePersona, Content: "첫 발언"},
+			{Index: 2, SpeakerID: "o", SpeakerName: "Operator", Type: orchestrator.TurnTypePersona, Content: "둘째 발언"},
+			{Index: 3, SpeakerID: "a", SpeakerName: "Architect", Type: orchestrator.TurnTypePersona, Content: "셋째 발언"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("marshal result: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "run-debate.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write result: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runRender([]string{path, "-from", "2", "-speakers", "a"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d; stderr=%s", code, stderr.String())
+	}
+	md := stdout.String()
+	if !strings.Contains(md, "셋째 발언") || strings.Contains(md, "첫 발언") || strings.Contains(md, "둘째 발언") {
+		t.Fatalf("unexpected excerpt: %q", md)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// shutdownContext returns a context canceled on SIGINT/SIGTERM so the web
+// server can drain. A second signal calls os.Exit(1) immediately.
+func shutdownContext(parent context.Context) (context.Context, context.CancelFunc) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	ctx, cancel := watchShutdownSignals(parent, signals, func() { os.Exit(1) })
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
+
+// watchShutdownSignals cancels the returned context on the first signal and
+// calls forceExit on the second.
+func watchShutdownSignals(parent context.Context, signals <-chan os.Signal, forceExit func()) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+			return
+		}
+		<-signals
+		forceExit()
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchShutdownSignalsCancelsThenForcesExit(t *testing.T) {
+	signals := make(chan os.Signal, 2)
+	forced := make(chan struct{})
+	ctx, cancel := watchShutdownSignals(context.Background(), signals, func() { close(forced) })
+	defer cancel()
+
+	signals <- syscall.SIGINT
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected first signal to cancel the context")
+	}
+	select {
+	case <-forced:
+		t.Fatal("expected first signal not to force exit")
+	default:
+	}
+
+	signals <- syscall.SIGTERM
+	select {
+	case <-forced:
+	case <-time.After(time.Second):
+		t.Fatal("expected second signal to force exit")
+	}
+}
+
+func TestWatchShutdownSignalsStopsWithParent(t *testing.T) {
+	parent, cancelParent := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	ctx, cancel := watchShutdownSignals(parent, signals, func() { t.Error("unexpected force exit") })
+	defer cancel()
+
+	cancelParent()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected parent cancellation to propagate")
+	}
+}
@@ -16,6 +16,8 @@ const (
 	DefaultMaxTurns           = 0
 	DefaultConsensusThreshold = 0.80
 	DefaultMaxDuration        = 20 * time.Minute
+	// MaxDurationGrace bounds DEBATE_DURATION_GRACE.
+	MaxDurationGrace          = 5 * time.Minute
 	DefaultMaxTotalTokens     = 120000
 	DefaultMaxNoProgressJudge = 6
 	DefaultHardMaxTurns       = 400
@@ -32,6 +34,8 @@ type Settings struct {
 	APIKey             string
 	BaseURL            string
 	Model              string
+	JudgeModel         string
+	ModeratorModel     string
 	MaxTurns           int
 	ConsensusThreshold float64
 	MaxDuration        time.Duration
@@ -44,18 +48,95 @@ type Settings struct {
 	StreamTurnBuffer   int
 	RequestTimeout     time.Duration
 	APIMaxRetries      int
-	AudienceMode       string
+	APIMaxInFlight     int
+	// DisableTruncationRetry skips the second call made for cut-off replies.
+	DisableTruncationRetry bool
+	SummaryMarker          string
+	JudgeRecencyWeighting  bool
+	RequireActionOwner     bool
+	SummaryWordBoundary    bool
+	AudienceMode           string
+	OutputMaxAge           time.Duration
+	OutputMaxCount         int
+	SystemPromptPrefix     string
+	SystemPromptSuffix     string
+	// WebMaxPersonas and WebPersonaWarnAt bound persona rosters in the web
+	// API; 0 keeps the web package defaults.
+	WebMaxPersonas   int
+	WebPersonaWarnAt int
+	// MinDistinctSpeakers withholds consensus until that many personas have
+	// spoken; 0 disables the gate.
+	MinDistinctSpeakers int
+	// AmbiguousHandoffPolicy is fallback|first_mentioned|priority.
+	AmbiguousHandoffPolicy string
+	// ModeratorMode is auto|always|never.
+	ModeratorMode string
+	// DisableSaveFallback turns off saving finished results to the temp dir
+	// when the output dir cannot be written.
+	DisableSaveFallback bool
+	// ConsensusThresholdEnd, when > 0, ramps the consensus threshold from
+	// ConsensusThreshold down (or up) to this value at the turn limit.
+	ConsensusThresholdEnd float64
+	// JudgePreScreenThreshold, when > 0, skips scheduled judge calls until
+	// the heuristic consensus score reaches it.
+	JudgePreScreenThreshold float64
+	// MetricsCSVPath, when set, gets one appended CSV row per saved debate.
+	MetricsCSVPath string
+	// AdminToken enables the web PUT /api/config endpoint as its bearer
+	// token; empty leaves live config updates disabled.
+	AdminToken string
+	// ModeratorIntro has the moderator introduce the problem and panel
+	// before the first persona turn.
+	ModeratorIntro bool
+	// DisableFinalJudgePass skips re-judging the full transcript when a
+	// debate hits the turn cap after its last verdict.
+	DisableFinalJudgePass bool
+	// RedTeam assigns opposing stances to the personas for stress-testing;
+	// RedTeamSeed fixes the split (0 means random).
+	RedTeam     bool
+	RedTeamSeed int
+	// MaxEstimatedCostUSD stops a debate once its usage priced with
+	// ModelPrices reaches it; 0 disables the ceiling.
+	MaxEstimatedCostUSD float64
+	// MaxLLMCalls stops a debate after this many model calls; 0 disables.
+	MaxLLMCalls int
+	// ModelPrices maps model names ("*" for any other model) to prices.
+	ModelPrices map[string]ModelPrice
+	// DurationGrace allows one more judge call past MaxDuration so consensus
+	// reached on the last turns is still reported; 0 disables it.
+	DurationGrace time.Duration
+	// SlowTurnNudge reports a persona or moderator call that has run this
+	// long as a slow_turn event; 0 disables it.
+	SlowTurnNudge time.Duration
+}
+
+// ModelPrice is the USD price per 1,000 prompt and completion tokens.
+type ModelPrice struct {
+	PromptPer1K     float64
+	CompletionPer1K float64
 }
 
 func FromEnv() (Settings, error) {
+	return fromEnv(true)
+}
+
+// FromEnvWithoutAPIKey is FromEnv for runs that never call the API, such as
+// dry runs; OPENAI_API_KEY may be unset.
+func FromEnvWithoutAPIKey() (Settings, error) {
+	return fromEnv(false)
+}
+
+func fromEnv(requireAPIKey bool) (Settings, error) {
 	apiKey := strings.TrimSpace(os.Getenv("OPENAI_API_KEY"))
-	if apiKey == "" {
+	if apiKey == "" && requireAPIKey {
 		return Settings{}, errors.New("OPENAI_API_KEY is required")
 	}
 
 	settings := Settings{
 		APIKey:             apiKey,
 		BaseURL:            strings.TrimSpace(os.Getenv("OPENAI_BASE_URL")),
+		SystemPromptPrefix: strings.TrimSpace(os.Getenv("OPENAI_SYSTEM_PROMPT_PREFIX")),
+		SystemPromptSuffix: strings.TrimSpace(os.Getenv("OPENAI_SYSTEM_PROMPT_SUFFIX")),
 		Model:              DefaultModel,
 		MaxTurns:           DefaultMaxTurns,
 		ConsensusThreshold: DefaultConsensusThreshold,
@@ -75,21 +156,23 @@ func FromEnv() (Settings, error) {
 	if v := strings.TrimSpace(os.Getenv("OPENAI_MODEL")); v != "" {
 		settings.Model = v
 	}
+	settings.JudgeModel = strings.TrimSpace(os.Getenv("OPENAI_JUDGE_MODEL"))
+	settings.ModeratorModel = strings.TrimSpace(os.Getenv("OPENAI_MODERATOR_MODEL"))
 
 	var err error
-	settings.MaxTurns, err = parseOptionalInt("DEBATE_MAX_TURNS", settings.MaxTurns, func(v int) bool { return v >= 0 })
+	settings.MaxTurns, err = parseOptionalInt("DEBATE_MAX_TURNS", settings.MaxTurns, ValidMaxTurns)
 	if err != nil {
 		return Settings{}, err
 	}
-	settings.ConsensusThreshold, err = parseOptionalFloat64("DEBATE_CONSENSUS_THRESHOLD", settings.ConsensusThreshold, func(v float64) bool { return v >= 0 && v <= 1 })
+	settings.ConsensusThreshold, err = parseOptionalFloat64("DEBATE_CONSENSUS_THRESHOLD", settings.ConsensusThreshold, ValidConsensusThreshold)
 	if err != nil {
 		return Settings{}, err
 	}
-	settings.MaxDuration, err = parseOptionalDuration("DEBATE_MAX_DURATION", settings.MaxDuration, func(v time.Duration) bool { return v > 0 })
+	settings.MaxDuration, err = parseOptionalDuration("DEBATE_MAX_DURATION", settings.MaxDuration, ValidMaxDuration)
 	if err != nil {
 		return Settings{}, err
 	}
-	settings.MaxTotalTokens, err = parseOptionalInt("DEBATE_MAX_TOTAL_TOKENS", settings.MaxTotalTokens, func(v int) bool { return v > 0 })
+	settings.MaxTotalTokens, err = parseOptionalInt("DEBATE_MAX_TOTAL_TOKENS", settings.MaxTotalTokens, ValidMaxTotalTokens)
 	if err != nil {
 		return Settings{}, err
 	}
@@ -117,6 +200,14 @@ func FromEnv() (Settings, error) {
 	if err != nil {
 		return Settings{}, err
 	}
+	settings.WebMaxPersonas, err = parseOptionalInt("DEBATE_WEB_MAX_PERSONAS", settings.WebMaxPersonas, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.WebPersonaWarnAt, err = parseOptionalInt("DEBATE_WEB_PERSONA_WARN_AT", settings.WebPersonaWarnAt, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
 	settings.RequestTimeout, err = parseOptionalDuration("OPENAI_REQUEST_TIMEOUT", settings.RequestTimeout, func(v time.Duration) bool { return v > 0 })
 	if err != nil {
 		return Settings{}, err
@@ -125,14 +216,111 @@ func FromEnv() (Settings, error) {
 	if err != nil {
 		return Settings{}, err
 	}
+	settings.APIMaxInFlight, err = parseOptionalInt("OPENAI_MAX_IN_FLIGHT", settings.APIMaxInFlight, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.DisableTruncationRetry, err = parseOptionalBool("OPENAI_DISABLE_TRUNCATION_RETRY", settings.DisableTruncationRetry)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.SummaryMarker = os.Getenv("DEBATE_SUMMARY_MARKER")
+	settings.MetricsCSVPath = strings.TrimSpace(os.Getenv("DEBATE_METRICS_CSV"))
+	settings.AdminToken = strings.TrimSpace(os.Getenv("DEBATE_ADMIN_TOKEN"))
+	settings.SummaryWordBoundary, err = parseOptionalBool("DEBATE_SUMMARY_WORD_BOUNDARY", settings.SummaryWordBoundary)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.JudgeRecencyWeighting, err = parseOptionalBool("DEBATE_JUDGE_RECENCY_WEIGHTING", settings.JudgeRecencyWeighting)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.RequireActionOwner, err = parseOptionalBool("DEBATE_REQUIRE_ACTION_OWNER", settings.RequireActionOwner)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.MinDistinctSpeakers, err = parseOptionalInt("DEBATE_MIN_DISTINCT_SPEAKERS", settings.MinDistinctSpeakers, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.AmbiguousHandoffPolicy, err = parseOptionalChoice("DEBATE_AMBIGUOUS_HANDOFF_POLICY", settings.AmbiguousHandoffPolicy, []string{"fallback", "first_mentioned", "priority"})
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.ModeratorMode, err = parseOptionalChoice("DEBATE_MODERATOR_MODE", settings.ModeratorMode, []string{"auto", "always", "never"})
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.DisableSaveFallback, err = parseOptionalBool("DEBATE_DISABLE_SAVE_FALLBACK", settings.DisableSaveFallback)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.ConsensusThresholdEnd, err = parseOptionalFloat64("DEBATE_CONSENSUS_THRESHOLD_END", settings.ConsensusThresholdEnd, ValidConsensusThreshold)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.JudgePreScreenThreshold, err = parseOptionalFloat64("DEBATE_JUDGE_PRESCREEN_THRESHOLD", settings.JudgePreScreenThreshold, ValidConsensusThreshold)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.ModeratorIntro, err = parseOptionalBool("DEBATE_MODERATOR_INTRO", settings.ModeratorIntro)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.DisableFinalJudgePass, err = parseOptionalBool("DEBATE_DISABLE_FINAL_JUDGE_PASS", settings.DisableFinalJudgePass)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.RedTeam, err = parseOptionalBool("DEBATE_RED_TEAM", settings.RedTeam)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.RedTeamSeed, err = parseOptionalInt("DEBATE_RED_TEAM_SEED", settings.RedTeamSeed, func(int) bool { return true })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.MaxEstimatedCostUSD, err = parseOptionalFloat64("DEBATE_MAX_ESTIMATED_COST_USD", settings.MaxEstimatedCostUSD, func(v float64) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.MaxLLMCalls, err = parseOptionalInt("DEBATE_MAX_LLM_CALLS", settings.MaxLLMCalls, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.ModelPrices, err = parseOptionalModelPrices("DEBATE_MODEL_PRICES")
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.DurationGrace, err = parseOptionalDuration("DEBATE_DURATION_GRACE", settings.DurationGrace, func(v time.Duration) bool { return v >= 0 && v <= MaxDurationGrace })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.SlowTurnNudge, err = parseOptionalDuration("DEBATE_SLOW_TURN_NUDGE", settings.SlowTurnNudge, func(v time.Duration) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
 	settings.AudienceMode, err = parseOptionalChoice("DEBATE_AUDIENCE_MODE", settings.AudienceMode, []string{"general", "expert"})
 	if err != nil {
 		return Settings{}, err
 	}
+	settings.OutputMaxAge, err = parseOptionalDuration("DEBATE_OUTPUT_MAX_AGE", settings.OutputMaxAge, func(v time.Duration) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
+	settings.OutputMaxCount, err = parseOptionalInt("DEBATE_OUTPUT_MAX_COUNT", settings.OutputMaxCount, func(v int) bool { return v >= 0 })
+	if err != nil {
+		return Settings{}, err
+	}
 
 	return settings, nil
 }
 
+// Range checks shared by the env parsers and the command-line overrides.
+func ValidMaxTurns(v int) bool               { return v >= 0 }
+func ValidConsensusThreshold(v float64) bool { return v >= 0 && v <= 1 }
+func ValidMaxDuration(v time.Duration) bool  { return v > 0 }
+func ValidMaxTotalTokens(v int) bool         { return v > 0 }
+
 func parseOptionalInt(env string, fallback int, valid func(int) bool) (int, error) {
 	raw := strings.TrimSpace(os.Getenv(env))
 	if raw == "" {
@@ -178,6 +366,18 @@ func parseOptionalDuration(env string, fallback time.Duration, valid func(time.D
 	return v, nil
 }
 
+func parseOptionalBool(env string, fallback bool) (bool, error) {
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return fallback, nil
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean (true/false): %w", env, err)
+	}
+	return v, nil
+}
+
 func parseOptionalChoice(env string, fallback string, allowed []string) (string, error) {
 	raw := strings.ToLower(strings.TrimSpace(os.Getenv(env)))
 	if raw == "" {
@@ -190,3 +390,41 @@ func parseOptionalChoice(env string, fallback string, allowed []string) (string,
 	}
 	return "", fmt.Errorf("%s has invalid value: %s (allowed: %s)", env, raw, strings.Join(allowed, ", "))
 }
+
+// parseOptionalModelPrices reads comma-separated model=prompt:completion
+// entries, with prices in USD per 1,000 tokens.
+func parseOptionalModelPrices(env string) (map[string]ModelPrice, error) {
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return nil, nil
+	}
+	prices := make(map[string]ModelPrice)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		model, pair, ok := strings.Cut(entry, "=")
+		model = strings.TrimSpace(model)
+		if !ok || model == "" {
+			return nil, fmt.Errorf("%s entry must be model=prompt:completion: %s", env, entry)
+		}
+		promptRaw, completionRaw, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s entry must be model=prompt:completion: %s", env, entry)
+		}
+		prompt, err := strconv.ParseFloat(strings.TrimSpace(promptRaw), 64)
+		if err != nil || prompt < 0 {
+			return nil, fmt.Errorf("%s has invalid prompt price for %s: %s", env, model, promptRaw)
+		}
+		completion, err := strconv.ParseFloat(strings.TrimSpace(completionRaw), 64)
+		if err != nil || completion < 0 {
+			return nil, fmt.Errorf("%s has invalid completion price for %s: %s", env, model, completionRaw)
+		}
+		prices[model] = ModelPrice{PromptPer1K: prompt, CompletionPer1K: completion}
+	}
+	if len(prices) == 0 {
+		return nil, nil
+	}
+	return prices, nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +15,17 @@ func TestFromEnvMissingAPIKey(t *testing.T) {
 	}
 }
 
+func TestFromEnvWithoutAPIKeyAllowsMissingKey(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	cfg, err := FromEnvWithoutAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Model != DefaultModel {
+		t.Fatalf("expected default model, got %q", cfg.Model)
+	}
+}
+
 func TestFromEnvSuccess(t *testing.T) {
 	t.Setenv("OPENAI_API_KEY", "test-key")
 	t.Setenv("OPENAI_BASE_URL", "https://example.com")
@@ -48,6 +60,31 @@ func TestFromEnvOverrides(t *testing.T) {
 	t.Setenv("DEBATE_STREAM_TURN_BUFFER", "777")
 	t.Setenv("OPENAI_REQUEST_TIMEOUT", "90s")
 	t.Setenv("OPENAI_API_MAX_RETRIES", "5")
+	t.Setenv("OPENAI_MAX_IN_FLIGHT", "3")
+	t.Setenv("OPENAI_DISABLE_TRUNCATION_RETRY", "true")
+	t.Setenv("DEBATE_SUMMARY_MARKER", " [...]")
+	t.Setenv("DEBATE_SUMMARY_WORD_BOUNDARY", "true")
+	t.Setenv("DEBATE_JUDGE_RECENCY_WEIGHTING", "1")
+	t.Setenv("DEBATE_REQUIRE_ACTION_OWNER", "true")
+	t.Setenv("DEBATE_MIN_DISTINCT_SPEAKERS", "4")
+	t.Setenv("DEBATE_AMBIGUOUS_HANDOFF_POLICY", "Priority")
+	t.Setenv("DEBATE_DISABLE_SAVE_FALLBACK", "true")
+	t.Setenv("DEBATE_CONSENSUS_THRESHOLD_END", "0.85")
+	t.Setenv("DEBATE_JUDGE_PRESCREEN_THRESHOLD", "0.4")
+	t.Setenv("DEBATE_METRICS_CSV", " outputs/metrics.csv ")
+	t.Setenv("DEBATE_ADMIN_TOKEN", " s3cret ")
+	t.Setenv("DEBATE_MODERATOR_INTRO", "true")
+	t.Setenv("DEBATE_DISABLE_FINAL_JUDGE_PASS", "true")
+	t.Setenv("DEBATE_RED_TEAM", "true")
+	t.Setenv("DEBATE_RED_TEAM_SEED", "42")
+	t.Setenv("DEBATE_MAX_ESTIMATED_COST_USD", "2.5")
+	t.Setenv("DEBATE_MAX_LLM_CALLS", "40")
+	t.Setenv("DEBATE_MODEL_PRICES", "gpt-5.2=0.00125:0.01, *=0.002:0.008")
+	t.Setenv("DEBATE_DURATION_GRACE", "30s")
+	t.Setenv("DEBATE_SLOW_TURN_NUDGE", "20s")
+	t.Setenv("DEBATE_MODERATOR_MODE", "never")
+	t.Setenv("DEBATE_WEB_MAX_PERSONAS", "6")
+	t.Setenv("DEBATE_WEB_PERSONA_WARN_AT", "4")
 	t.Setenv("DEBATE_AUDIENCE_MODE", "expert")
 
 	cfg, err := FromEnv()
@@ -93,6 +130,76 @@ func TestFromEnvOverrides(t *testing.T) {
 	if cfg.APIMaxRetries != 5 {
 		t.Fatalf("unexpected retries: %d", cfg.APIMaxRetries)
 	}
+	if cfg.APIMaxInFlight != 3 {
+		t.Fatalf("unexpected max in-flight: %d", cfg.APIMaxInFlight)
+	}
+	if !cfg.DisableTruncationRetry {
+		t.Fatal("expected truncation retry to be disabled")
+	}
+	if cfg.SummaryMarker != " [...]" || !cfg.SummaryWordBoundary {
+		t.Fatalf("unexpected summary style: %q %v", cfg.SummaryMarker, cfg.SummaryWordBoundary)
+	}
+	if !cfg.JudgeRecencyWeighting {
+		t.Fatal("expected judge recency weighting to be enabled")
+	}
+	if !cfg.RequireActionOwner {
+		t.Fatal("expected action owner requirement to be enabled")
+	}
+	if cfg.MinDistinctSpeakers != 4 {
+		t.Fatalf("unexpected min distinct speakers: %d", cfg.MinDistinctSpeakers)
+	}
+	if cfg.AmbiguousHandoffPolicy != "priority" {
+		t.Fatalf("unexpected ambiguous handoff policy: %q", cfg.AmbiguousHandoffPolicy)
+	}
+	if cfg.ModeratorMode != "never" {
+		t.Fatalf("unexpected moderator mode: %q", cfg.ModeratorMode)
+	}
+	if !cfg.DisableSaveFallback {
+		t.Fatal("expected save fallback to be disabled")
+	}
+	if cfg.ConsensusThresholdEnd != 0.85 {
+		t.Fatalf("unexpected consensus threshold end: %v", cfg.ConsensusThresholdEnd)
+	}
+	if cfg.JudgePreScreenThreshold != 0.4 {
+		t.Fatalf("unexpected judge pre-screen threshold: %v", cfg.JudgePreScreenThreshold)
+	}
+	if cfg.MetricsCSVPath != "outputs/metrics.csv" {
+		t.Fatalf("unexpected metrics csv path: %q", cfg.MetricsCSVPath)
+	}
+	if cfg.AdminToken != "s3cret" {
+		t.Fatalf("unexpected admin token: %q", cfg.AdminToken)
+	}
+	if !cfg.ModeratorIntro {
+		t.Fatal("expected moderator intro to be enabled")
+	}
+	if !cfg.DisableFinalJudgePass {
+		t.Fatal("expected final judge pass to be disabled")
+	}
+	if !cfg.RedTeam || cfg.RedTeamSeed != 42 {
+		t.Fatalf("unexpected red team settings: %v %d", cfg.RedTeam, cfg.RedTeamSeed)
+	}
+	if cfg.MaxLLMCalls != 40 {
+		t.Fatalf("unexpected call cap: %d", cfg.MaxLLMCalls)
+	}
+	if cfg.MaxEstimatedCostUSD != 2.5 {
+		t.Fatalf("unexpected cost ceiling: %v", cfg.MaxEstimatedCostUSD)
+	}
+	wantPrices := map[string]ModelPrice{
+		"gpt-5.2": {PromptPer1K: 0.00125, CompletionPer1K: 0.01},
+		"*":       {PromptPer1K: 0.002, CompletionPer1K: 0.008},
+	}
+	if !reflect.DeepEqual(cfg.ModelPrices, wantPrices) {
+		t.Fatalf("unexpected model prices: %+v", cfg.ModelPrices)
+	}
+	if cfg.DurationGrace != 30*time.Second {
+		t.Fatalf("unexpected duration grace: %s", cfg.DurationGrace)
+	}
+	if cfg.SlowTurnNudge != 20*time.Second {
+		t.Fatalf("unexpected slow turn nudge: %s", cfg.SlowTurnNudge)
+	}
+	if cfg.WebMaxPersonas != 6 || cfg.WebPersonaWarnAt != 4 {
+		t.Fatalf("unexpected web persona limits: %d %d", cfg.WebMaxPersonas, cfg.WebPersonaWarnAt)
+	}
 	if cfg.AudienceMode != "expert" {
 		t.Fatalf("unexpected audience mode: %s", cfg.AudienceMode)
 	}
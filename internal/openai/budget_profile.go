@@ -0,0 +1,28 @@
+package openai
+
+// PromptBudgetProfile reports the prompt budget derived for a panel of
+// personaCount personas after turnCount turns, keyed by budget field. It
+// exposes derivePromptBudget for tuning without running a debate.
+// turn_problem_runes is 0 while the full problem text is kept, and
+// reference_doc_runes is 0 once reference material is dropped.
+func PromptBudgetProfile(personaCount int, turnCount int) map[string]int {
+	b := derivePromptBudget(personaCount, turnCount)
+	return map[string]int{
+		"compression_level":              derivePromptCompressionLevel(personaCount, turnCount),
+		"turn_recent_log_limit":          b.turnRecentLogLimit,
+		"turn_speaker_claims":            b.turnSpeakerClaims,
+		"turn_log_summary_runes":         b.turnLogSummaryRunes,
+		"turn_problem_runes":             b.turnProblemRunes,
+		"reference_doc_runes":            b.referenceDocRunes,
+		"interaction_summary_runes":      b.interactionSummaryRunes,
+		"moderator_recent_log_limit":     b.moderatorRecentLogLimit,
+		"moderator_log_summary_runes":    b.moderatorLogSummaryRunes,
+		"moderator_memory_anchor_limit":  b.moderatorMemory.anchorLimit,
+		"moderator_memory_claim_limit":   b.moderatorMemory.speakerClaimLimit,
+		"moderator_memory_claim_runes":   b.moderatorMemory.claimSummaryRunes,
+		"moderator_memory_tension_runes": b.moderatorMemory.tensionSummaryRunes,
+		"moderator_loop_summary_runes":   b.moderatorLoopSummaryRunes,
+		"judge_recent_log_limit":         b.judgeRecentLogLimit,
+		"judge_log_summary_runes":        b.judgeLogSummaryRunes,
+	}
+}
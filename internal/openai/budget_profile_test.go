@@ -0,0 +1,39 @@
+package openai
+
+import "testing"
+
+func TestPromptBudgetProfileShrinksAsTurnsGrow(t *testing.T) {
+	turnCounts := []int{1, 12, 24, 40, 80}
+	prev := PromptBudgetProfile(4, turnCounts[0])
+	if prev["compression_level"] != 0 || prev["turn_problem_runes"] != 0 {
+		t.Fatalf("expected an uncompressed profile for a short debate, got %v", prev)
+	}
+	for _, turns := range turnCounts[1:] {
+		cur := PromptBudgetProfile(4, turns)
+		if len(cur) != len(prev) {
+			t.Fatalf("profile keys changed at %d turns: %v", turns, cur)
+		}
+		for key, value := range cur {
+			before := prev[key]
+			switch key {
+			case "compression_level":
+				if value < before {
+					t.Fatalf("compression level dropped at %d turns: %d -> %d", turns, before, value)
+				}
+				continue
+			case "turn_problem_runes":
+				// 0 means the full problem is kept, i.e. no cap yet.
+				if before == 0 {
+					continue
+				}
+			}
+			if value > before {
+				t.Fatalf("%s grew at %d turns: %d -> %d", key, turns, before, value)
+			}
+		}
+		prev = cur
+	}
+	if prev["compression_level"] != 3 || prev["reference_doc_runes"] != 0 {
+		t.Fatalf("expected the longest debate to hit the top compression level, got %v", prev)
+	}
+}
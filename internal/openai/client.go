@@ -18,6 +18,9 @@ const (
 	finalModeratorMaxOutputToken = 360
 	judgeMaxOutputTokens         = 320
 	judgeRetryMaxOutputTokens    = 512
+	// openingSpeakerParseRetries is how many stricter re-asks an unparsable
+	// opening speaker reply gets before the keyword fallback is used.
+	openingSpeakerParseRetries   = 1
 	judgeTruncationRetryMaxToken = 800
 	openingSpeakerMaxOutputToken = 180
 )
@@ -28,15 +31,45 @@ type Config struct {
 	Model      string
 	Timeout    time.Duration
 	MaxRetries int
+	// SystemPromptPrefix and SystemPromptSuffix wrap every built system prompt.
+	// For the judge, the suffix is inserted ahead of the strict JSON output
+	// rules so those remain the last instructions the model reads.
+	SystemPromptPrefix string
+	SystemPromptSuffix string
+	// JudgeModel and ModeratorModel override Model for consensus judging and
+	// moderator turns (including the final wrap-up). Empty means Model.
+	JudgeModel     string
+	ModeratorModel string
+	// MaxInFlight caps concurrent HTTP requests across every call path
+	// (turns, judge, moderator, poll). 0 means unlimited.
+	MaxInFlight int
+	// DisableTruncationRetry returns the first plain-text response as-is
+	// instead of re-requesting a complete answer when it looks cut off.
+	DisableTruncationRetry bool
+	// SummaryTruncationMarker ends shortened turn summaries in prompts;
+	// empty means "…". SummaryWordBoundary avoids cutting mid-word in
+	// space-delimited scripts (CJK text is still cut at the rune limit).
+	SummaryTruncationMarker string
+	SummaryWordBoundary     bool
 }
 
 type Client struct {
-	apiKey     string
-	endpoint   string
-	model      string
-	timeout    time.Duration
-	maxRetries int
-	httpClient httpDoer
+	apiKey       string
+	endpoint     string
+	model        string
+	judgeModel   string
+	modModel     string
+	timeout      time.Duration
+	maxRetries   int
+	promptPrefix string
+	promptSuffix string
+	// inFlight is a counting semaphore for MaxInFlight; nil when unlimited.
+	inFlight               chan struct{}
+	disableTruncationRetry bool
+	summary                summaryStyle
+	httpClient             httpDoer
+	// sleep replaces the retry wait in tests; nil means sleepWithContext.
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
 type httpDoer interface {
@@ -56,24 +89,43 @@ func NewClient(cfg Config) (*Client, error) {
 	if cfg.MaxRetries < 0 {
 		cfg.MaxRetries = 0
 	}
+	if cfg.MaxInFlight < 0 {
+		return nil, errors.New("max in-flight must be >= 0")
+	}
+	var inFlight chan struct{}
+	if cfg.MaxInFlight > 0 {
+		inFlight = make(chan struct{}, cfg.MaxInFlight)
+	}
 
 	return &Client{
-		apiKey:     strings.TrimSpace(cfg.APIKey),
-		endpoint:   normalizeEndpoint(cfg.BaseURL),
-		model:      strings.TrimSpace(cfg.Model),
-		timeout:    cfg.Timeout,
-		maxRetries: cfg.MaxRetries,
-		httpClient: newDefaultHTTPClient(),
+		apiKey:                 strings.TrimSpace(cfg.APIKey),
+		endpoint:               normalizeEndpoint(cfg.BaseURL),
+		model:                  strings.TrimSpace(cfg.Model),
+		judgeModel:             modelOrDefault(cfg.JudgeModel, cfg.Model),
+		modModel:               modelOrDefault(cfg.ModeratorModel, cfg.Model),
+		timeout:                cfg.Timeout,
+		maxRetries:             cfg.MaxRetries,
+		promptPrefix:           strings.TrimSpace(cfg.SystemPromptPrefix),
+		promptSuffix:           strings.TrimSpace(cfg.SystemPromptSuffix),
+		inFlight:               inFlight,
+		disableTruncationRetry: cfg.DisableTruncationRetry,
+		summary:                newSummaryStyle(cfg.SummaryTruncationMarker, cfg.SummaryWordBoundary),
+		httpClient:             newDefaultHTTPClient(),
 	}, nil
 }
 
 func (c *Client) GenerateTurn(ctx context.Context, input orchestrator.GenerateTurnInput) (orchestrator.GenerateTurnOutput, error) {
+	if input.Structured {
+		return c.generateStructuredTurn(ctx, input)
+	}
 	text, usage, err := c.generatePlainText(
 		ctx,
+		c.model,
 		buildTurnSystemPrompt(),
-		buildTurnUserPrompt(input),
+		buildTurnUserPrompt(input, c.summary),
 		"empty model output",
 		turnMaxOutputTokens,
+		turnTemperature(input.Speaker),
 	)
 	if err != nil {
 		return orchestrator.GenerateTurnOutput{}, err
@@ -81,34 +133,57 @@ func (c *Client) GenerateTurn(ctx context.Context, input orchestrator.GenerateTu
 
 	return orchestrator.GenerateTurnOutput{
 		Content: text,
+		Model:   c.model,
 		Usage:   usage,
 	}, nil
 }
 
+// SelectOpeningSpeaker asks the model for the opening persona. A reply that
+// does not parse to a known persona ID is retried once with a stricter
+// JSON-only instruction, like the judge, before the caller falls back.
+// Usage covers every attempt, including failed ones.
 func (c *Client) SelectOpeningSpeaker(ctx context.Context, input orchestrator.SelectOpeningSpeakerInput) (orchestrator.SelectOpeningSpeakerOutput, error) {
-	text, usage, err := c.generatePlainText(
-		ctx,
-		buildOpeningSpeakerSelectorSystemPrompt(),
-		buildOpeningSpeakerSelectorUserPrompt(input),
-		"empty opening speaker output",
-		openingSpeakerMaxOutputToken,
-	)
-	if err != nil {
-		return orchestrator.SelectOpeningSpeakerOutput{}, err
-	}
+	systemPrompt := buildOpeningSpeakerSelectorSystemPrompt()
+	userPrompt := buildOpeningSpeakerSelectorUserPrompt(input)
 
-	personaID, err := parseOpeningSpeakerID(text)
-	if err != nil {
-		return orchestrator.SelectOpeningSpeakerOutput{}, fmt.Errorf("parse opening speaker id: %w", err)
-	}
-	if !containsPersonaID(input.Personas, personaID) {
-		return orchestrator.SelectOpeningSpeakerOutput{}, fmt.Errorf("parse opening speaker id: unknown persona_id %q", personaID)
-	}
+	var aggregated orchestrator.Usage
+	var parseErr error
+	for attempt := 0; attempt <= openingSpeakerParseRetries; attempt++ {
+		currentUserPrompt := userPrompt
+		if attempt > 0 {
+			currentUserPrompt += "\n\nReturn only the JSON object {\"persona_id\":\"<id>\"} using one of the listed persona ids. No prose or markdown/code fence."
+		}
+		text, usage, err := c.generatePlainText(
+			ctx,
+			c.model,
+			systemPrompt,
+			currentUserPrompt,
+			"empty opening speaker output",
+			openingSpeakerMaxOutputToken,
+			fixedTemperature(input.Personas, judgeTemperature),
+		)
+		aggregated.PromptTokens += usage.PromptTokens
+		aggregated.CompletionTokens += usage.CompletionTokens
+		aggregated.TotalTokens += usage.TotalTokens
+		if err != nil {
+			return orchestrator.SelectOpeningSpeakerOutput{Model: c.model, Usage: aggregated}, err
+		}
 
-	return orchestrator.SelectOpeningSpeakerOutput{
-		PersonaID: personaID,
-		Usage:     usage,
-	}, nil
+		personaID, err := parseOpeningSpeakerID(text)
+		if err == nil && !containsPersonaID(input.Personas, personaID) {
+			err = fmt.Errorf("unknown persona_id %q", personaID)
+		}
+		if err == nil {
+			return orchestrator.SelectOpeningSpeakerOutput{
+				PersonaID: personaID,
+				Model:     c.model,
+				Usage:     aggregated,
+				Retried:   attempt > 0,
+			}, nil
+		}
+		parseErr = err
+	}
+	return orchestrator.SelectOpeningSpeakerOutput{Model: c.model, Usage: aggregated}, fmt.Errorf("parse opening speaker id: %w", parseErr)
 }
 
 func containsPersonaID(personas []persona.Persona, id string) bool {
@@ -127,10 +202,12 @@ func containsPersonaID(personas []persona.Persona, id string) bool {
 func (c *Client) GenerateModerator(ctx context.Context, input orchestrator.GenerateModeratorInput) (orchestrator.GenerateModeratorOutput, error) {
 	text, usage, err := c.generatePlainText(
 		ctx,
+		c.modModel,
 		buildModeratorSystemPrompt(),
-		buildModeratorUserPrompt(input),
+		buildModeratorUserPrompt(input, c.summary),
 		"empty moderator output",
 		moderatorMaxOutputTokens,
+		fixedTemperature(input.Personas, moderatorTemperature),
 	)
 	if err != nil {
 		return orchestrator.GenerateModeratorOutput{}, err
@@ -138,6 +215,30 @@ func (c *Client) GenerateModerator(ctx context.Context, input orchestrator.Gener
 
 	return orchestrator.GenerateModeratorOutput{
 		Content: text,
+		Model:   c.modModel,
+		Usage:   usage,
+	}, nil
+}
+
+// GenerateModeratorIntro writes the moderator's opening introduction of the
+// problem and panel.
+func (c *Client) GenerateModeratorIntro(ctx context.Context, input orchestrator.GenerateModeratorIntroInput) (orchestrator.GenerateModeratorOutput, error) {
+	text, usage, err := c.generatePlainText(
+		ctx,
+		c.modModel,
+		buildModeratorIntroSystemPrompt(),
+		buildModeratorIntroUserPrompt(input),
+		"empty moderator intro output",
+		moderatorMaxOutputTokens,
+		fixedTemperature(input.Personas, moderatorTemperature),
+	)
+	if err != nil {
+		return orchestrator.GenerateModeratorOutput{}, err
+	}
+
+	return orchestrator.GenerateModeratorOutput{
+		Content: text,
+		Model:   c.modModel,
 		Usage:   usage,
 	}, nil
 }
@@ -145,10 +246,12 @@ func (c *Client) GenerateModerator(ctx context.Context, input orchestrator.Gener
 func (c *Client) GenerateFinalModerator(ctx context.Context, input orchestrator.GenerateFinalModeratorInput) (orchestrator.GenerateFinalModeratorOutput, error) {
 	text, usage, err := c.generatePlainText(
 		ctx,
+		c.modModel,
 		buildFinalModeratorSystemPrompt(),
-		buildFinalModeratorUserPrompt(input),
+		buildFinalModeratorUserPrompt(input, c.summary),
 		"empty final moderator output",
 		finalModeratorMaxOutputToken,
+		fixedTemperature(input.Personas, moderatorTemperature),
 	)
 	if err != nil {
 		return orchestrator.GenerateFinalModeratorOutput{}, err
@@ -156,13 +259,14 @@ func (c *Client) GenerateFinalModerator(ctx context.Context, input orchestrator.
 
 	return orchestrator.GenerateFinalModeratorOutput{
 		Content: text,
+		Model:   c.modModel,
 		Usage:   usage,
 	}, nil
 }
 
 func (c *Client) JudgeConsensus(ctx context.Context, input orchestrator.JudgeConsensusInput) (orchestrator.JudgeConsensusOutput, error) {
-	systemPrompt := buildJudgeSystemPrompt()
-	userPrompt := buildJudgeUserPrompt(input)
+	systemPrompt := c.wrapJudgeSystemPrompt(buildJudgeSystemPrompt())
+	userPrompt := buildJudgeUserPrompt(input, c.summary)
 
 	var aggregated orchestrator.Usage
 	for attempt := 0; attempt < 3; attempt++ {
@@ -180,10 +284,10 @@ func (c *Client) JudgeConsensus(ctx context.Context, input orchestrator.JudgeCon
 		if attempt == 2 {
 			currentUserPrompt += "\n\nYour previous response was truncated. Return one complete minified JSON object on a single line, and ensure it ends with `}`. No markdown/code fence."
 		}
-		resp, err := c.callResponses(ctx, []inputMsg{
+		resp, err := c.callResponses(ctx, c.judgeModel, []inputMsg{
 			makeMessage("system", systemPrompt),
 			makeMessage("user", currentUserPrompt),
-		}, maxOutputTokens)
+		}, maxOutputTokens, fixedTemperature(input.Personas, judgeTemperature))
 		if err != nil {
 			return orchestrator.JudgeConsensusOutput{}, err
 		}
@@ -196,6 +300,7 @@ func (c *Client) JudgeConsensus(ctx context.Context, input orchestrator.JudgeCon
 		raw := strings.TrimSpace(extractOutputText(resp))
 		parsed, parseErr := parseConsensus(raw)
 		if parseErr == nil {
+			parsed.Model = c.judgeModel
 			return orchestrator.JudgeConsensusOutput{Consensus: parsed, Usage: aggregated}, nil
 		}
 		if attempt == 2 {
@@ -209,11 +314,14 @@ func (c *Client) JudgeConsensus(ctx context.Context, input orchestrator.JudgeCon
 	return orchestrator.JudgeConsensusOutput{}, errors.New("unreachable consensus parser state")
 }
 
-func (c *Client) callResponses(ctx context.Context, input []inputMsg, maxOutputTokens int) (responseBody, error) {
+// callResponses sends one Responses API request; a nil temperature leaves
+// sampling to the model default.
+func (c *Client) callResponses(ctx context.Context, model string, input []inputMsg, maxOutputTokens int, temperature *float64) (responseBody, error) {
 	reqBody := responseRequest{
-		Model:           c.model,
+		Model:           model,
 		Input:           input,
 		MaxOutputTokens: maxOutputTokens,
+		Temperature:     temperature,
 	}
 
 	payload, err := marshalRequest(reqBody)
@@ -223,9 +331,13 @@ func (c *Client) callResponses(ctx context.Context, input []inputMsg, maxOutputT
 
 	var lastErr error
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if err := c.acquireInFlight(ctx); err != nil {
+			return responseBody{}, err
+		}
 		apiCtx, cancel := context.WithTimeout(ctx, c.timeout)
 		resp, err := c.doRequest(apiCtx, payload)
 		cancel()
+		c.releaseInFlight()
 
 		if err == nil {
 			return resp, nil
@@ -238,7 +350,7 @@ func (c *Client) callResponses(ctx context.Context, input []inputMsg, maxOutputT
 		if !isRetriableError(err) {
 			break
 		}
-		if err := sleepWithContext(ctx, backoffDuration(attempt)); err != nil {
+		if err := c.sleepBeforeRetry(ctx, retryDelay(err, attempt)); err != nil {
 			return responseBody{}, err
 		}
 	}
@@ -249,11 +361,39 @@ func (c *Client) callResponses(ctx context.Context, input []inputMsg, maxOutputT
 	return responseBody{}, lastErr
 }
 
-func (c *Client) generatePlainText(ctx context.Context, systemPrompt string, userPrompt string, emptyOutputError string, maxOutputTokens int) (string, orchestrator.Usage, error) {
-	resp, err := c.callResponses(ctx, []inputMsg{
+func modelOrDefault(override string, fallback string) string {
+	if model := strings.TrimSpace(override); model != "" {
+		return model
+	}
+	return strings.TrimSpace(fallback)
+}
+
+// acquireInFlight blocks until a request slot is free. The slot is held only
+// for one HTTP attempt, so retry backoff does not starve other callers.
+func (c *Client) acquireInFlight(ctx context.Context) error {
+	if c.inFlight == nil {
+		return nil
+	}
+	select {
+	case c.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Client) releaseInFlight() {
+	if c.inFlight != nil {
+		<-c.inFlight
+	}
+}
+
+func (c *Client) generatePlainText(ctx context.Context, model string, systemPrompt string, userPrompt string, emptyOutputError string, maxOutputTokens int, temperature *float64) (string, orchestrator.Usage, error) {
+	systemPrompt = c.wrapSystemPrompt(systemPrompt)
+	resp, err := c.callResponses(ctx, model, []inputMsg{
 		makeMessage("system", systemPrompt),
 		makeMessage("user", userPrompt),
-	}, maxOutputTokens)
+	}, maxOutputTokens, temperature)
 	if err != nil {
 		return "", orchestrator.Usage{}, err
 	}
@@ -264,7 +404,7 @@ func (c *Client) generatePlainText(ctx context.Context, systemPrompt string, use
 	}
 
 	usage := toUsage(resp.Usage)
-	if looksLikeTruncatedText(text, usage.CompletionTokens, maxOutputTokens) {
+	if !c.disableTruncationRetry && looksLikeTruncatedText(text, usage.CompletionTokens, maxOutputTokens) {
 		retryCap := maxOutputTokens * 2
 		if retryCap < maxOutputTokens+120 {
 			retryCap = maxOutputTokens + 120
@@ -274,10 +414,10 @@ func (c *Client) generatePlainText(ctx context.Context, systemPrompt string, use
 		}
 		retryPrompt := userPrompt + "\n\nYour previous response was cut off. Rewrite the whole answer from scratch, concise but complete, and end with a complete sentence."
 
-		retryResp, retryErr := c.callResponses(ctx, []inputMsg{
+		retryResp, retryErr := c.callResponses(ctx, model, []inputMsg{
 			makeMessage("system", systemPrompt),
 			makeMessage("user", retryPrompt),
-		}, retryCap)
+		}, retryCap, temperature)
 		if retryErr == nil {
 			retryText := strings.TrimSpace(extractOutputText(retryResp))
 			if retryText != "" {
@@ -293,6 +433,48 @@ func (c *Client) generatePlainText(ctx context.Context, systemPrompt string, use
 	return text, usage, nil
 }
 
+// SystemPrompts returns every system prompt the client sends, keyed by call
+// kind and wrapped exactly as sent. The builders are deterministic, so this
+// is what saved prompt archives record.
+func (c *Client) SystemPrompts() map[string]string {
+	return map[string]string{
+		"turn":            c.wrapSystemPrompt(buildTurnSystemPrompt()),
+		"structured_turn": c.wrapSystemPrompt(buildStructuredTurnSystemPrompt()),
+		"opening_speaker": c.wrapSystemPrompt(buildOpeningSpeakerSelectorSystemPrompt()),
+		"moderator":       c.wrapSystemPrompt(buildModeratorSystemPrompt()),
+		"moderator_intro": c.wrapSystemPrompt(buildModeratorIntroSystemPrompt()),
+		"final_moderator": c.wrapSystemPrompt(buildFinalModeratorSystemPrompt()),
+		"judge":           c.wrapJudgeSystemPrompt(buildJudgeSystemPrompt()),
+	}
+}
+
+func (c *Client) wrapSystemPrompt(prompt string) string {
+	return joinPromptSections(c.promptPrefix, prompt, c.promptSuffix)
+}
+
+// wrapJudgeSystemPrompt keeps the strict JSON contract at the end of the judge
+// prompt; a suffix placed after it tends to break single-object output.
+func (c *Client) wrapJudgeSystemPrompt(prompt string) string {
+	if c.promptSuffix == "" {
+		return joinPromptSections(c.promptPrefix, prompt)
+	}
+	idx := strings.Index(prompt, judgeOutputFormatHeading)
+	if idx < 0 {
+		return joinPromptSections(c.promptPrefix, c.promptSuffix, prompt)
+	}
+	return joinPromptSections(c.promptPrefix, strings.TrimSpace(prompt[:idx]), c.promptSuffix, prompt[idx:])
+}
+
+func joinPromptSections(sections ...string) string {
+	parts := make([]string, 0, len(sections))
+	for _, section := range sections {
+		if trimmed := strings.TrimSpace(section); trimmed != "" {
+			parts = append(parts, trimmed)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
 func looksLikeTruncatedText(text string, completionTokens int, maxOutputTokens int) bool {
 	if strings.TrimSpace(text) == "" {
 		return true
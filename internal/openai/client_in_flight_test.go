@@ -0,0 +1,105 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+// countingHTTPDoer records the peak number of requests in flight at once.
+type countingHTTPDoer struct {
+	current atomic.Int32
+	peak    atomic.Int32
+	hold    time.Duration
+}
+
+func (d *countingHTTPDoer) Do(req *http.Request) (*http.Response, error) {
+	n := d.current.Add(1)
+	defer d.current.Add(-1)
+	for {
+		peak := d.peak.Load()
+		if n <= peak || d.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(d.hold)
+
+	raw, err := json.Marshal(responseBody{
+		OutputText: "단계적 롤아웃이 필요합니다.",
+		Usage:      apiUsage{InputTokens: 1, OutputTokens: 1, TotalTokens: 2},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(raw)),
+	}, nil
+}
+
+func TestCallResponsesRespectsMaxInFlight(t *testing.T) {
+	const maxInFlight = 2
+	client, err := NewClient(Config{
+		APIKey:      "test-key",
+		Model:       "gpt-test",
+		Timeout:     time.Second,
+		MaxInFlight: maxInFlight,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	doer := &countingHTTPDoer{hold: 20 * time.Millisecond}
+	client.httpClient = doer
+
+	input := sampleJudgeInput()
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GenerateTurn(context.Background(), orchestrator.GenerateTurnInput{
+				Problem:  input.Problem,
+				Personas: input.Personas,
+				Turns:    input.Turns,
+				Speaker:  input.Personas[0],
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+	}
+
+	if peak := doer.peak.Load(); peak > maxInFlight {
+		t.Fatalf("expected at most %d concurrent requests, got %d", maxInFlight, peak)
+	}
+	if peak := doer.peak.Load(); peak < 1 {
+		t.Fatalf("expected requests to reach the doer, peak=%d", peak)
+	}
+}
+
+func TestNewClientRejectsNegativeMaxInFlight(t *testing.T) {
+	_, err := NewClient(Config{
+		APIKey:      "test-key",
+		Model:       "gpt-test",
+		Timeout:     time.Second,
+		MaxInFlight: -1,
+	})
+	if err == nil {
+		t.Fatal("expected error for negative max in-flight")
+	}
+}
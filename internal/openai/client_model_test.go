@@ -0,0 +1,73 @@
+package openai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+func TestClientRecordsPerCallModel(t *testing.T) {
+	client, err := NewClient(Config{
+		APIKey:     "test-key",
+		Model:      "gpt-main",
+		JudgeModel: " gpt-judge ",
+		Timeout:    time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: "운영 관점에서 단계적 롤아웃이 필요합니다.",
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 20, TotalTokens: 30},
+			},
+			{
+				OutputText: `{"reached":true,"score":0.91,"summary":"done","rationale":"aligned","open_risks":[],"next_action_owner":"ops","next_action_trigger_or_deadline":"by EOD","next_action_success_metric":"trigger documented"}`,
+				Usage:      apiUsage{InputTokens: 12, OutputTokens: 32, TotalTokens: 44},
+			},
+			{
+				OutputText: "사회자 정리입니다.",
+				Usage:      apiUsage{InputTokens: 5, OutputTokens: 5, TotalTokens: 10},
+			},
+		},
+	}
+	client.httpClient = doer
+
+	input := sampleJudgeInput()
+	turnOut, err := client.GenerateTurn(context.Background(), orchestrator.GenerateTurnInput{
+		Problem:  input.Problem,
+		Personas: input.Personas,
+		Turns:    input.Turns,
+		Speaker:  input.Personas[0],
+	})
+	if err != nil {
+		t.Fatalf("unexpected turn err: %v", err)
+	}
+	judgeOut, err := client.JudgeConsensus(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected judge err: %v", err)
+	}
+	modOut, err := client.GenerateModerator(context.Background(), orchestrator.GenerateModeratorInput{
+		Problem:     input.Problem,
+		Personas:    input.Personas,
+		Turns:       input.Turns,
+		NextSpeaker: input.Personas[1],
+	})
+	if err != nil {
+		t.Fatalf("unexpected moderator err: %v", err)
+	}
+
+	if turnOut.Model != "gpt-main" || doer.requests[0].Model != "gpt-main" {
+		t.Fatalf("expected turn to use main model, got output=%q request=%q", turnOut.Model, doer.requests[0].Model)
+	}
+	if judgeOut.Consensus.Model != "gpt-judge" || doer.requests[1].Model != "gpt-judge" {
+		t.Fatalf("expected judge to use judge model, got consensus=%q request=%q", judgeOut.Consensus.Model, doer.requests[1].Model)
+	}
+	if modOut.Model != "gpt-main" || doer.requests[2].Model != "gpt-main" {
+		t.Fatalf("expected moderator to fall back to main model, got output=%q request=%q", modOut.Model, doer.requests[2].Model)
+	}
+}
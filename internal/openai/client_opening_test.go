@@ -0,0 +1,77 @@
+package openai
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+func newOpeningSpeakerTestClient(doer *scriptedHTTPDoer) *Client {
+	return &Client{
+		apiKey:                 "test-key",
+		endpoint:               defaultEndpoint,
+		model:                  "gpt-test",
+		timeout:                time.Second,
+		httpClient:             doer,
+		disableTruncationRetry: true,
+	}
+}
+
+func openingSpeakerTestInput() orchestrator.SelectOpeningSpeakerInput {
+	return orchestrator.SelectOpeningSpeakerInput{
+		Problem: "결제 장애를 줄이려면?",
+		Personas: []persona.Persona{
+			{ID: "pm", Name: "PM", Role: "product"},
+			{ID: "sre", Name: "SRE", Role: "reliability"},
+		},
+	}
+}
+
+func TestSelectOpeningSpeakerRetriesUnparsableReply(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{OutputText: "SRE should open because the problem is about outages.", Usage: apiUsage{InputTokens: 30, OutputTokens: 12, TotalTokens: 42}},
+			{OutputText: `{"persona_id":"sre"}`, Usage: apiUsage{InputTokens: 34, OutputTokens: 6, TotalTokens: 40}},
+		},
+	}
+
+	out, err := newOpeningSpeakerTestClient(doer).SelectOpeningSpeaker(context.Background(), openingSpeakerTestInput())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.PersonaID != "sre" || !out.Retried || out.Model != "gpt-test" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+	if out.Usage.TotalTokens != 82 {
+		t.Fatalf("expected usage from both attempts, got %+v", out.Usage)
+	}
+	if len(doer.requests) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(doer.requests))
+	}
+	if !strings.Contains(doer.requests[1].Input[1].Content[0].Text, "Return only the JSON object") {
+		t.Fatalf("strict retry prompt missing: %q", doer.requests[1].Input[1].Content[0].Text)
+	}
+}
+
+func TestSelectOpeningSpeakerFailsAfterRetryWithUnknownPersona(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{OutputText: "not json", Usage: apiUsage{InputTokens: 30, OutputTokens: 2, TotalTokens: 32}},
+			{OutputText: `{"persona_id":"cfo"}`, Usage: apiUsage{InputTokens: 34, OutputTokens: 6, TotalTokens: 40}},
+		},
+	}
+
+	out, err := newOpeningSpeakerTestClient(doer).SelectOpeningSpeaker(context.Background(), openingSpeakerTestInput())
+	if err == nil || !strings.Contains(err.Error(), `unknown persona_id "cfo"`) {
+		t.Fatalf("expected unknown persona error, got %v", err)
+	}
+	if out.Usage.TotalTokens != 72 || out.Model != "gpt-test" {
+		t.Fatalf("expected failed attempts to report usage and model, got %+v", out)
+	}
+}
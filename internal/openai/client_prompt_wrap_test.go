@@ -0,0 +1,118 @@
+package openai
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+func TestGenerateTurnWrapsSystemPromptWithPrefixAndSuffix(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: "운영 관점에서 단계적 롤아웃이 필요합니다.",
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 20, TotalTokens: 30},
+			},
+		},
+	}
+	client := &Client{
+		apiKey:       "test-key",
+		endpoint:     defaultEndpoint,
+		model:        "gpt-test",
+		timeout:      time.Second,
+		promptPrefix: "STYLE PREAMBLE",
+		promptSuffix: "CLOSING GUIDELINE",
+		httpClient:   doer,
+	}
+
+	input := sampleJudgeInput()
+	_, err := client.GenerateTurn(context.Background(), orchestrator.GenerateTurnInput{
+		Problem:  input.Problem,
+		Personas: input.Personas,
+		Turns:    input.Turns,
+		Speaker:  input.Personas[1],
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	system := doer.requests[0].Input[0].Content[0].Text
+	if !strings.HasPrefix(system, "STYLE PREAMBLE\n\n") {
+		t.Fatalf("expected system prompt to start with prefix, got %q", system)
+	}
+	if !strings.HasSuffix(system, "\n\nCLOSING GUIDELINE") {
+		t.Fatalf("expected system prompt to end with suffix, got %q", system)
+	}
+}
+
+func TestJudgeConsensusKeepsJSONRulesAfterSystemPromptSuffix(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: `{"reached":false,"score":0.4,"summary":"open","rationale":"A and B differ","open_risks":[],"next_action_owner":"moderator","next_action_trigger_or_deadline":"48h","next_action_success_metric":"decision memo"}`,
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 40, TotalTokens: 50},
+			},
+		},
+	}
+	client := &Client{
+		apiKey:       "test-key",
+		endpoint:     defaultEndpoint,
+		model:        "gpt-test",
+		timeout:      time.Second,
+		promptPrefix: "STYLE PREAMBLE",
+		promptSuffix: "CLOSING GUIDELINE",
+		httpClient:   doer,
+	}
+
+	if _, err := client.JudgeConsensus(context.Background(), sampleJudgeInput()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	system := doer.requests[0].Input[0].Content[0].Text
+	if !strings.HasPrefix(system, "STYLE PREAMBLE") {
+		t.Fatalf("expected judge system prompt to start with prefix, got %q", system)
+	}
+	suffixAt := strings.Index(system, "CLOSING GUIDELINE")
+	rulesAt := strings.Index(system, judgeOutputFormatHeading)
+	if suffixAt < 0 || rulesAt < 0 || suffixAt > rulesAt {
+		t.Fatalf("expected suffix before strict JSON rules, got suffix=%d rules=%d", suffixAt, rulesAt)
+	}
+}
+
+func TestSystemPromptsMatchWhatJudgeSends(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: `{"reached":false,"score":0.4,"summary":"open","rationale":"A and B differ","open_risks":[],"next_action_owner":"moderator","next_action_trigger_or_deadline":"48h","next_action_success_metric":"decision memo"}`,
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 40, TotalTokens: 50},
+			},
+		},
+	}
+	client := &Client{
+		apiKey:       "test-key",
+		endpoint:     defaultEndpoint,
+		model:        "gpt-test",
+		judgeModel:   "gpt-test",
+		timeout:      time.Second,
+		promptPrefix: "STYLE PREAMBLE",
+		promptSuffix: "CLOSING GUIDELINE",
+		httpClient:   doer,
+	}
+
+	if _, err := client.JudgeConsensus(context.Background(), sampleJudgeInput()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	prompts := client.SystemPrompts()
+	if sent := doer.requests[0].Input[0].Content[0].Text; prompts["judge"] != sent {
+		t.Fatalf("archived judge prompt differs from the one sent:\n%s\n---\n%s", prompts["judge"], sent)
+	}
+	for _, kind := range []string{"turn", "structured_turn", "opening_speaker", "moderator", "moderator_intro", "final_moderator"} {
+		if !strings.HasPrefix(prompts[kind], "STYLE PREAMBLE\n\n") {
+			t.Fatalf("expected %s prompt to be wrapped, got %q", kind, prompts[kind])
+		}
+	}
+}
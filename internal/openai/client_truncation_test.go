@@ -1,8 +1,12 @@
 package openai
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
 )
 
 func TestLooksLikeTruncatedText(t *testing.T) {
@@ -127,3 +131,56 @@ func TestLooksLikeTruncatedJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTurnTruncationRetry(t *testing.T) {
+	truncated := responseBody{
+		OutputText: "핵심 합의는 이루어졌지만 다음 단계에서",
+		Usage:      apiUsage{InputTokens: 10, OutputTokens: turnMaxOutputTokens, TotalTokens: 10 + turnMaxOutputTokens},
+	}
+	complete := responseBody{
+		OutputText: "핵심 합의는 이루어졌습니다.",
+		Usage:      apiUsage{InputTokens: 12, OutputTokens: 20, TotalTokens: 32},
+	}
+
+	tests := []struct {
+		name         string
+		disable      bool
+		wantRequests int
+		wantContent  string
+	}{
+		{name: "enabled by default", disable: false, wantRequests: 2, wantContent: complete.OutputText},
+		{name: "disabled", disable: true, wantRequests: 1, wantContent: truncated.OutputText},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewClient(Config{
+				APIKey:                 "test-key",
+				Model:                  "gpt-test",
+				Timeout:                time.Second,
+				DisableTruncationRetry: tc.disable,
+			})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			doer := &scriptedHTTPDoer{t: t, responses: []responseBody{truncated, complete}}
+			client.httpClient = doer
+
+			input := sampleJudgeInput()
+			out, err := client.GenerateTurn(context.Background(), orchestrator.GenerateTurnInput{
+				Problem:  input.Problem,
+				Personas: input.Personas,
+				Turns:    input.Turns,
+				Speaker:  input.Personas[0],
+			})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if len(doer.requests) != tc.wantRequests {
+				t.Fatalf("expected %d requests, got %d", tc.wantRequests, len(doer.requests))
+			}
+			if out.Content != tc.wantContent {
+				t.Fatalf("unexpected content: %q", out.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"debate/internal/orchestrator"
+)
+
+// dryRunJudgeOutput is the canned verdict: never reached, so a dry run ends
+// on the usual turn/no-progress limits and still reaches the final wrap-up.
+const dryRunJudgeOutput = `{"reached":false,"score":0,"summary":"dry run: no model was called","rationale":"dry run","open_risks":[],"next_action_owner":"dry-run","next_action_trigger_or_deadline":"dry-run","next_action_success_metric":"dry-run"}`
+
+// DryRunClient builds the same prompts as Client but never calls the API.
+// Every system and user prompt is written to the output writer and a
+// deterministic canned reply is returned, so prompt changes can be reviewed
+// without an API key or token spend.
+type DryRunClient struct {
+	prompts *Client
+	mu      sync.Mutex
+	out     io.Writer
+}
+
+// NewDryRunClient uses cfg only for prompt shaping (system prompt prefix and
+// suffix, summary style) and model names; the API key, base URL and timeout
+// are ignored.
+func NewDryRunClient(cfg Config, out io.Writer) *DryRunClient {
+	model := strings.TrimSpace(cfg.Model)
+	if model == "" {
+		model = "dry-run"
+	}
+	return &DryRunClient{
+		prompts: &Client{
+			model:        model,
+			judgeModel:   modelOrDefault(cfg.JudgeModel, model),
+			modModel:     modelOrDefault(cfg.ModeratorModel, model),
+			promptPrefix: strings.TrimSpace(cfg.SystemPromptPrefix),
+			promptSuffix: strings.TrimSpace(cfg.SystemPromptSuffix),
+			summary:      newSummaryStyle(cfg.SummaryTruncationMarker, cfg.SummaryWordBoundary),
+		},
+		out: out,
+	}
+}
+
+func (d *DryRunClient) GenerateTurn(ctx context.Context, input orchestrator.GenerateTurnInput) (orchestrator.GenerateTurnOutput, error) {
+	turn := len(input.Turns) + 1
+	label := fmt.Sprintf("turn %d (%s)", turn, input.Speaker.ID)
+	if input.Structured {
+		d.writePrompts(label, d.prompts.wrapSystemPrompt(buildStructuredTurnSystemPrompt()), buildTurnUserPrompt(input, d.prompts.summary))
+		raw := fmt.Sprintf(`{"claim":"[dry-run] %s claim for turn %d.","evidence":"[dry-run] no model was called.","next_step":"[dry-run] continue.","close":"no","new_point":"no"}`, input.Speaker.Name, turn)
+		fields, content, err := parseStructuredTurn(raw)
+		if err != nil {
+			return orchestrator.GenerateTurnOutput{}, err
+		}
+		return orchestrator.GenerateTurnOutput{Content: content, Structured: fields, Model: d.prompts.model}, nil
+	}
+	d.writePrompts(label, d.prompts.wrapSystemPrompt(buildTurnSystemPrompt()), buildTurnUserPrompt(input, d.prompts.summary))
+	return orchestrator.GenerateTurnOutput{
+		Content: fmt.Sprintf("[dry-run] %s, turn %d.\nCLOSE: no\nNEW_POINT: no", input.Speaker.Name, turn),
+		Model:   d.prompts.model,
+	}, nil
+}
+
+// SelectOpeningSpeaker prints the selector prompts and always picks the
+// first persona.
+func (d *DryRunClient) SelectOpeningSpeaker(ctx context.Context, input orchestrator.SelectOpeningSpeakerInput) (orchestrator.SelectOpeningSpeakerOutput, error) {
+	d.writePrompts("opening speaker", d.prompts.wrapSystemPrompt(buildOpeningSpeakerSelectorSystemPrompt()), buildOpeningSpeakerSelectorUserPrompt(input))
+	if len(input.Personas) == 0 {
+		return orchestrator.SelectOpeningSpeakerOutput{}, errors.New("no personas to select from")
+	}
+	return orchestrator.SelectOpeningSpeakerOutput{PersonaID: input.Personas[0].ID, Model: d.prompts.model}, nil
+}
+
+func (d *DryRunClient) GenerateModerator(ctx context.Context, input orchestrator.GenerateModeratorInput) (orchestrator.GenerateModeratorOutput, error) {
+	d.writePrompts(fmt.Sprintf("moderator after turn %d", len(input.Turns)), d.prompts.wrapSystemPrompt(buildModeratorSystemPrompt()), buildModeratorUserPrompt(input, d.prompts.summary))
+	return orchestrator.GenerateModeratorOutput{
+		Content: "[dry-run] Moderator summary.\nASK: [dry-run] What is still unresolved?",
+		Model:   d.prompts.modModel,
+	}, nil
+}
+
+func (d *DryRunClient) GenerateModeratorIntro(ctx context.Context, input orchestrator.GenerateModeratorIntroInput) (orchestrator.GenerateModeratorOutput, error) {
+	d.writePrompts("moderator intro", d.prompts.wrapSystemPrompt(buildModeratorIntroSystemPrompt()), buildModeratorIntroUserPrompt(input))
+	return orchestrator.GenerateModeratorOutput{
+		Content: "[dry-run] Moderator introduction.",
+		Model:   d.prompts.modModel,
+	}, nil
+}
+
+func (d *DryRunClient) GenerateFinalModerator(ctx context.Context, input orchestrator.GenerateFinalModeratorInput) (orchestrator.GenerateFinalModeratorOutput, error) {
+	d.writePrompts("final moderator", d.prompts.wrapSystemPrompt(buildFinalModeratorSystemPrompt()), buildFinalModeratorUserPrompt(input, d.prompts.summary))
+	return orchestrator.GenerateFinalModeratorOutput{
+		Content: "[dry-run] Final moderator wrap-up.",
+		Model:   d.prompts.modModel,
+	}, nil
+}
+
+func (d *DryRunClient) JudgeConsensus(ctx context.Context, input orchestrator.JudgeConsensusInput) (orchestrator.JudgeConsensusOutput, error) {
+	d.writePrompts(fmt.Sprintf("judge after turn %d", len(input.Turns)), d.prompts.wrapJudgeSystemPrompt(buildJudgeSystemPrompt()), buildJudgeUserPrompt(input, d.prompts.summary))
+	consensus, err := parseConsensus(dryRunJudgeOutput)
+	if err != nil {
+		return orchestrator.JudgeConsensusOutput{}, err
+	}
+	consensus.Model = d.prompts.judgeModel
+	return orchestrator.JudgeConsensusOutput{Consensus: consensus}, nil
+}
+
+// SystemPrompts matches Client.SystemPrompts so -save-prompts works in a
+// dry run.
+func (d *DryRunClient) SystemPrompts() map[string]string {
+	return d.prompts.SystemPrompts()
+}
+
+func (d *DryRunClient) writePrompts(label string, systemPrompt string, userPrompt string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = fmt.Fprintf(d.out, "=== %s: system ===\n%s\n=== %s: user ===\n%s\n\n", label, systemPrompt, label, userPrompt)
+}
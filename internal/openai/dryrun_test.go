@@ -0,0 +1,74 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+func TestDryRunClientPrintsPromptsAndReturnsCannedTurns(t *testing.T) {
+	var out bytes.Buffer
+	client := NewDryRunClient(Config{Model: "gpt-test", SystemPromptPrefix: "PREFIX"}, &out)
+	runner := orchestrator.New(client, orchestrator.Config{MaxTurns: 2, ConsensusThreshold: 0.9})
+
+	personas := []persona.Persona{
+		{ID: "pm", Name: "PM", Role: "product"},
+		{ID: "sre", Name: "SRE", Role: "reliability"},
+	}
+	result, err := runner.Run(context.Background(), "결제 장애를 줄이려면?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Turns) == 0 {
+		t.Fatal("expected canned turns")
+	}
+	for _, turn := range result.Turns {
+		if strings.TrimSpace(turn.Content) == "" {
+			t.Fatalf("expected canned content, got empty turn: %+v", turn)
+		}
+	}
+
+	printed := out.String()
+	wantSystem := client.SystemPrompts()["turn"]
+	if !strings.HasPrefix(wantSystem, "PREFIX") {
+		t.Fatalf("expected prefix in wrapped system prompt, got %q", wantSystem[:20])
+	}
+	if !strings.Contains(printed, "=== turn 1 (pm): system ===\n"+wantSystem+"\n") {
+		t.Fatalf("expected exact turn system prompt in output:\n%s", printed)
+	}
+	if !strings.Contains(printed, "=== turn 1 (pm): user ===") || !strings.Contains(printed, "결제 장애를 줄이려면?") {
+		t.Fatalf("expected turn user prompt with the problem in output:\n%s", printed)
+	}
+	for _, label := range []string{"=== opening speaker: system ===", "=== judge after turn", "=== final moderator: system ==="} {
+		if !strings.Contains(printed, label) {
+			t.Fatalf("expected %q in output:\n%s", label, printed)
+		}
+	}
+}
+
+func TestDryRunClientIsDeterministic(t *testing.T) {
+	input := orchestrator.GenerateTurnInput{
+		Problem:  "p",
+		Personas: []persona.Persona{{ID: "a", Name: "A"}},
+		Speaker:  persona.Persona{ID: "a", Name: "A"},
+	}
+	var first, second bytes.Buffer
+	a, err := NewDryRunClient(Config{}, &first).GenerateTurn(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := NewDryRunClient(Config{}, &second).GenerateTurn(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Content != b.Content || first.String() != second.String() {
+		t.Fatal("expected identical output across dry runs")
+	}
+	if a.Model != "dry-run" {
+		t.Fatalf("expected dry-run model name, got %q", a.Model)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"debate/internal/orchestrator"
 	"debate/internal/persona"
@@ -13,17 +14,29 @@ const (
 	turnPromptRecentLogLimit      = 10
 	turnPromptSpeakerClaims       = 5
 	turnPromptLogSummaryRunes     = 180
+	turnPromptProblemRunes        = 480
+	turnPromptReferenceDocRunes   = 1200
 	moderatorPromptLogSummaryRune = 200
 	judgePromptLogSummaryRunes    = 220
 	judgeSnapshotIssueLimit       = 12
 	issuePlaceholderGuardrail     = "no TBD/unknown/later/soon"
 	nextActionPlaceholderRule     = "no TBD/unknown/later/soon/next cycle"
+	judgeOutputFormatHeading      = "### OUTPUT FORMAT (STRICT JSON)"
+	// Opening selector candidates switch to id/name/role at this panel size,
+	// matching the persona threshold in derivePromptCompressionLevel.
+	openingSelectorCompactPersonas = 8
+	openingSelectorMaxCandidates   = 24
 )
 
 type promptBudget struct {
-	turnRecentLogLimit        int
-	turnSpeakerClaims         int
-	turnLogSummaryRunes       int
+	turnRecentLogLimit  int
+	turnSpeakerClaims   int
+	turnLogSummaryRunes int
+	// turnProblemRunes caps the problem restatement after the first turn;
+	// 0 keeps the full problem text.
+	turnProblemRunes int
+	// referenceDocRunes caps each speaker reference doc; 0 drops them.
+	referenceDocRunes         int
 	interactionSummaryRunes   int
 	moderatorRecentLogLimit   int
 	moderatorLogSummaryRunes  int
@@ -31,6 +44,7 @@ type promptBudget struct {
 	judgeRecentLogLimit       int
 	judgeLogSummaryRunes      int
 	moderatorLoopSummaryRunes int
+	summary                   summaryStyle
 }
 
 func derivePromptBudget(personaCount int, turnCount int) promptBudget {
@@ -39,6 +53,8 @@ func derivePromptBudget(personaCount int, turnCount int) promptBudget {
 		turnRecentLogLimit:        shrinkInt(turnPromptRecentLogLimit, 2*level, 4),
 		turnSpeakerClaims:         shrinkInt(turnPromptSpeakerClaims, level, 3),
 		turnLogSummaryRunes:       shrinkInt(turnPromptLogSummaryRunes, 20*level, 100),
+		turnProblemRunes:          deriveTurnProblemRunes(level),
+		referenceDocRunes:         deriveReferenceDocRunes(level),
 		interactionSummaryRunes:   shrinkInt(moderatorClaimSummaryRunes, 12*level, 72),
 		moderatorRecentLogLimit:   shrinkInt(moderatorRecentLogLimit, 2*level, 4),
 		moderatorLogSummaryRunes:  shrinkInt(moderatorPromptLogSummaryRune, 24*level, 120),
@@ -49,6 +65,13 @@ func derivePromptBudget(personaCount int, turnCount int) promptBudget {
 	}
 }
 
+// withSummaryStyle applies style to every summary the budget produces.
+func (b promptBudget) withSummaryStyle(style summaryStyle) promptBudget {
+	b.summary = style
+	b.moderatorMemory.summary = style
+	return b
+}
+
 func derivePromptCompressionLevel(personaCount int, turnCount int) int {
 	level := 0
 	if turnCount >= 12 {
@@ -66,6 +89,22 @@ func derivePromptCompressionLevel(personaCount int, turnCount int) int {
 	return level
 }
 
+func deriveTurnProblemRunes(level int) int {
+	if level <= 0 {
+		return 0
+	}
+	return shrinkInt(turnPromptProblemRunes, 80*(level-1), 240)
+}
+
+// deriveReferenceDocRunes shrinks reference material with compression and
+// drops it entirely at the highest levels, where the log matters more.
+func deriveReferenceDocRunes(level int) int {
+	if level >= 3 {
+		return 0
+	}
+	return shrinkInt(turnPromptReferenceDocRunes, 400*level, 400)
+}
+
 func shrinkInt(base int, reduce int, min int) int {
 	if min < 1 {
 		min = 1
@@ -149,12 +188,17 @@ Pick the single most relevant persona to frame the discussion. Prioritize Domain
 }
 
 func buildOpeningSpeakerSelectorUserPrompt(input orchestrator.SelectOpeningSpeakerInput) string {
+	// Large panels only get id/name/role per candidate, and the detailed
+	// listing is capped; every id still appears in the allowed list.
+	compact := len(input.Personas) >= openingSelectorCompactPersonas
+
 	var b strings.Builder
 	b.WriteString("Problem:\n")
 	b.WriteString(input.Problem)
 	b.WriteString("\n\nCandidates:\n")
 	allowedIDs := make([]string, 0, len(input.Personas))
 	skippedEmptyID := 0
+	omitted := 0
 	for _, p := range input.Personas {
 		id := strings.TrimSpace(p.ID)
 		if id == "" {
@@ -162,11 +206,18 @@ func buildOpeningSpeakerSelectorUserPrompt(input orchestrator.SelectOpeningSpeak
 			continue
 		}
 		allowedIDs = append(allowedIDs, id)
+		if len(allowedIDs) > openingSelectorMaxCandidates {
+			omitted++
+			continue
+		}
 		b.WriteString(fmt.Sprintf("- id: %s\n", id))
 		if name := strings.TrimSpace(p.Name); name != "" {
 			b.WriteString("  name: " + name + "\n")
 		}
 		b.WriteString(fmt.Sprintf("  role: %s\n", strings.TrimSpace(p.Role)))
+		if compact {
+			continue
+		}
 		if stance := strings.TrimSpace(p.Stance); stance != "" {
 			b.WriteString("  stance: " + stance + "\n")
 		}
@@ -177,6 +228,9 @@ func buildOpeningSpeakerSelectorUserPrompt(input orchestrator.SelectOpeningSpeak
 			b.WriteString("  master_name: " + master + "\n")
 		}
 	}
+	if omitted > 0 {
+		b.WriteString(fmt.Sprintf("- ... %d more candidates (ids in allowed list)\n", omitted))
+	}
 	if skippedEmptyID > 0 {
 		b.WriteString(fmt.Sprintf("\nIgnored candidates with empty id: %d\n", skippedEmptyID))
 	}
@@ -190,8 +244,17 @@ func buildOpeningSpeakerSelectorUserPrompt(input orchestrator.SelectOpeningSpeak
 	return b.String()
 }
 
-func buildTurnUserPrompt(input orchestrator.GenerateTurnInput) string {
-	budget := derivePromptBudget(len(input.Personas), len(input.Turns))
+// turnProblemLine restates the full problem on the first turn; later turns get
+// a shortened restatement once prompt compression kicks in.
+func turnProblemLine(problem string, personaTurns int, limit int, style summaryStyle) string {
+	if personaTurns == 0 || limit <= 0 || utf8.RuneCountInString(problem) <= limit {
+		return "Problem: " + problem
+	}
+	return "Problem (shortened restatement): " + summarizeTurnContent(problem, limit, style)
+}
+
+func buildTurnUserPrompt(input orchestrator.GenerateTurnInput, style summaryStyle) string {
+	budget := derivePromptBudget(len(input.Personas), len(input.Turns)).withSummaryStyle(style)
 	personaTurns := countPersonaTurns(input.Turns)
 	effectiveTurns := deriveEffectiveDebateTurns(len(input.Turns), personaTurns)
 	phase := debatePhase(effectiveTurns, len(input.Personas))
@@ -207,10 +270,12 @@ func buildTurnUserPrompt(input orchestrator.GenerateTurnInput) string {
 
 	var b strings.Builder
 	b.WriteString("<context>\n")
-	b.WriteString("Problem: " + input.Problem + "\n")
+	b.WriteString(turnProblemLine(input.Problem, personaTurns, budget.turnProblemRunes, budget.summary) + "\n")
+	b.WriteString(sharedContextSection(input.SharedContext))
 	b.WriteString("Debate phase:\n")
 	b.WriteString("- current phase: " + phase + "\n")
 	b.WriteString("- requested audience_mode: " + audienceMode + "\n")
+	b.WriteString(responseLanguageLine(input.ResponseLanguage))
 	if audienceMode == orchestrator.AudienceModeExpert {
 		b.WriteString("- audience mode: explain for expert readers with precise terminology and compact logic.\n")
 	} else {
@@ -242,7 +307,9 @@ func buildTurnUserPrompt(input orchestrator.GenerateTurnInput) string {
 
 	b.WriteString("<current_persona>\n")
 	b.WriteString(fmt.Sprintf("- id: %s\n- name: %s\n- role: %s\n", input.Speaker.ID, input.Speaker.Name, input.Speaker.Role))
-	if stance := strings.TrimSpace(input.Speaker.Stance); stance != "" {
+	if override := redTeamStanceLine(input.RedTeamStance); override != "" {
+		b.WriteString(override)
+	} else if stance := strings.TrimSpace(input.Speaker.Stance); stance != "" {
 		b.WriteString("- stance: " + stance + "\n")
 	}
 	if master := strings.TrimSpace(input.Speaker.MasterName); master != "" {
@@ -264,8 +331,15 @@ func buildTurnUserPrompt(input orchestrator.GenerateTurnInput) string {
 			b.WriteString("  - " + item + "\n")
 		}
 	}
+	if considerations := normalizePromptList(input.Speaker.RequiredConsiderations); len(considerations) > 0 {
+		b.WriteString("- must explicitly address (name each in this turn):\n")
+		for _, item := range considerations {
+			b.WriteString("  - " + item + "\n")
+		}
+	}
 	b.WriteString("- persona failure-mode watch: " + derivePersonaFailureMode(input.Speaker) + "\n")
 	b.WriteString("</current_persona>\n\n")
+	writeReferenceMaterial(&b, input.Speaker.ReferenceDocs, budget)
 
 	b.WriteString("Recent debate log:\n")
 	if len(input.Turns) == 0 {
@@ -273,11 +347,14 @@ func buildTurnUserPrompt(input orchestrator.GenerateTurnInput) string {
 	} else {
 		written := 0
 		for _, t := range trimTurns(input.Turns, budget.turnRecentLogLimit) {
-			summary := summarizeTurnWithType(t, budget.turnLogSummaryRunes)
+			summary := summarizeTurnWithType(t, budget.turnLogSummaryRunes, budget.summary)
 			if summary == "" {
 				continue
 			}
 			b.WriteString(fmt.Sprintf("[%d][%s] %s\n", t.Index, t.SpeakerName, summary))
+			if signals := turnSignalsAnnotation(t); signals != "" {
+				b.WriteString("  " + signals + "\n")
+			}
 			written++
 		}
 		if written == 0 {
@@ -362,7 +439,7 @@ You are a strict consensus judge. Your goal is to determine if the debate has pr
 5. Persuasion quality: reached=true requires at least one explicit cross-persona adoption/concession with [Index] support.
 6. Optimality check: chosen direction must dominate alternatives on objective/constraints OR include a concrete uncertainty-reduction experiment.
 
-### OUTPUT FORMAT (STRICT JSON)
+`+judgeOutputFormatHeading+`
 - Return a single-line minified JSON object.
 - Use this exact order: reached, score, summary, rationale, open_risks, next_action_owner, next_action_trigger_or_deadline, next_action_success_metric.
 - summary: exactly 1 sentence; keep it short in a language-neutral way.
@@ -378,30 +455,32 @@ You are a strict consensus judge. Your goal is to determine if the debate has pr
 - Self-repair before final output: validate shape/types/order and repair malformed JSON.`), nextActionPlaceholderRule)
 }
 
-func buildJudgeUserPrompt(input orchestrator.JudgeConsensusInput) string {
-	budget := derivePromptBudget(len(input.Personas), len(input.Turns))
+func buildJudgeUserPrompt(input orchestrator.JudgeConsensusInput, style summaryStyle) string {
+	budget := derivePromptBudget(len(input.Personas), len(input.Turns)).withSummaryStyle(style)
 	judgeTurns := trimTurns(input.Turns, budget.judgeRecentLogLimit)
 	audienceMode := normalizePromptAudienceMode(input.AudienceMode)
 
 	var b strings.Builder
 	b.WriteString("Problem:\n" + input.Problem + "\n\n")
-	b.WriteString("Debate log tail:\n")
-	writtenLog := 0
-	for _, t := range judgeTurns {
-		summary := summarizeTurnWithType(t, budget.judgeLogSummaryRunes)
-		if summary == "" {
-			continue
-		}
-		b.WriteString(fmt.Sprintf("[%d][%s][%s] %s\n", t.Index, t.SpeakerName, t.Type, summary))
-		writtenLog++
-	}
-	if writtenLog == 0 {
-		b.WriteString("- none after control-line filtering.\n")
+	b.WriteString(sharedContextSection(input.SharedContext))
+	if input.RecencyWeighting {
+		writeJudgeRecencyWeightedLog(&b, judgeTurns, budget)
+	} else {
+		b.WriteString("Debate log tail:\n")
+		writeJudgeLogLines(&b, judgeTurns, budget.judgeLogSummaryRunes, budget.summary)
 	}
 	b.WriteString("\nDecision-state snapshot:\n")
 	b.WriteString(buildJudgeDecisionStateSnapshot(input.Turns))
+	writeObserverPerspectives(&b, input.Observers)
+	writeContextOnlyPersonas(&b, input.Personas)
+	writeRedTeamAssignments(&b, input.Personas, input.RedTeamStances)
+	if input.RequestActionOwner {
+		b.WriteString("\nAction owner required:\n")
+		b.WriteString("- the previous verdict's next action named no persona; next_action_owner must name one persona from the debate.\n")
+	}
 	b.WriteString("\nOutput format reminder:\n")
 	b.WriteString("- requested audience_mode: " + audienceMode + "\n")
+	b.WriteString(responseLanguageLine(input.ResponseLanguage))
 	b.WriteString("- return one minified JSON object on a single line only.\n")
 	b.WriteString("- key order: reached, score, summary, rationale, open_risks, next_action_owner, next_action_trigger_or_deadline, next_action_success_metric.\n")
 	b.WriteString("- never omit keys; if uncertain, use conservative concrete defaults.\n")
@@ -458,19 +537,20 @@ The 4 required lines remain mandatory.
 - Self-repair before final output.`)
 }
 
-func buildModeratorUserPrompt(input orchestrator.GenerateModeratorInput) string {
-	budget := derivePromptBudget(len(input.Personas), len(input.Turns))
+func buildModeratorUserPrompt(input orchestrator.GenerateModeratorInput, style summaryStyle) string {
+	budget := derivePromptBudget(len(input.Personas), len(input.Turns)).withSummaryStyle(style)
 	personaTurnCount := countPersonaTurns(input.Turns)
 	noNewPointStreak := trailingNoNewPointStreak(input.Turns)
 	audienceMode := normalizePromptAudienceMode(input.AudienceMode)
 
 	var b strings.Builder
 	b.WriteString("Problem:\n" + input.Problem + "\n\n")
+	b.WriteString(sharedContextSection(input.SharedContext))
 	b.WriteString("Recent debate log:\n")
 	recentTurns := trimTurns(input.Turns, budget.moderatorRecentLogLimit)
 	writtenRecent := 0
 	for _, t := range recentTurns {
-		summary := summarizeTurnWithType(t, budget.moderatorLogSummaryRunes)
+		summary := summarizeTurnWithType(t, budget.moderatorLogSummaryRunes, budget.summary)
 		if summary == "" {
 			continue
 		}
@@ -483,7 +563,7 @@ func buildModeratorUserPrompt(input orchestrator.GenerateModeratorInput) string
 	b.WriteString("\nDebate memory snapshot (anti-recency):\n")
 	b.WriteString(buildModeratorMemorySnapshot(input.Turns, input.PreviousTurn, budget.moderatorMemory))
 	b.WriteString("\nModerator loop status:\n")
-	b.WriteString(buildModeratorLoopStatus(input.Turns, budget.moderatorLoopSummaryRunes))
+	b.WriteString(buildModeratorLoopStatus(input.Turns, budget.moderatorLoopSummaryRunes, budget.summary))
 	b.WriteString("\nNext speaker context:\n")
 	b.WriteString("- next speaker id: " + strings.TrimSpace(input.NextSpeaker.ID) + "\n")
 	b.WriteString("- next speaker role: " + strings.TrimSpace(input.NextSpeaker.Role) + "\n")
@@ -499,6 +579,22 @@ func buildModeratorUserPrompt(input orchestrator.GenerateModeratorInput) string
 	} else {
 		b.WriteString("- next speaker signature lens: none\n")
 	}
+	if focusID := strings.TrimSpace(input.FocusPersona.ID); focusID != "" {
+		b.WriteString("\nFocus persona (deep-dive session):\n")
+		b.WriteString("- focus persona id: " + focusID + " (" + persona.DisplayName(input.FocusPersona) + ")\n")
+		if strings.EqualFold(focusID, strings.TrimSpace(input.NextSpeaker.ID)) {
+			b.WriteString("- hand back to the focus persona with a question that deepens their line of reasoning.\n")
+		} else {
+			b.WriteString("- ask the next speaker to respond directly to the focus persona's latest claim.\n")
+		}
+	}
+	writeObserverPerspectives(&b, input.Observers)
+	if input.RequestActionOwner {
+		b.WriteString("\nAction owner required:\n")
+		b.WriteString("- the latest judged next action has no owner; ask the next speaker to name which persona owns it.\n")
+	}
+	writeRepetitionWatch(&b, input.Turns, input.NextSpeaker)
+	writeConsiderationWatch(&b, input.Turns, input.Personas, input.NextSpeaker)
 	b.WriteString("\nModerator balancing guidance:\n")
 	b.WriteString("- Avoid recency: treat latest turn as one data point, not the whole debate.\n")
 	b.WriteString("- Ask for persuasion accounting: what the next speaker adopted from peers and what remains unresolved.\n")
@@ -515,9 +611,221 @@ func buildModeratorUserPrompt(input orchestrator.GenerateModeratorInput) string
 		b.WriteString("- stagnation detected: force OPTION_A/OPTION_B plus experiment-focused DECISION_CHECK.\n")
 	}
 	b.WriteString("- requested audience_mode: " + audienceMode + "\n")
+	b.WriteString(responseLanguageLine(input.ResponseLanguage))
 	return b.String()
 }
 
+// judgeLatestExchangeTurns is how many trailing turns the recency-weighted
+// judge log keeps verbatim.
+const judgeLatestExchangeTurns = 4
+
+// writeJudgeRecencyWeightedLog splits the judge log into summarized earlier
+// context and verbatim latest exchanges so late alignment carries more weight.
+func writeJudgeRecencyWeightedLog(b *strings.Builder, turns []orchestrator.Turn, budget promptBudget) {
+	latest := trimTurns(turns, judgeLatestExchangeTurns)
+	earlier := turns[:len(turns)-len(latest)]
+
+	b.WriteString("Earlier context (summarized):\n")
+	if len(earlier) == 0 {
+		b.WriteString("- none.\n")
+	} else {
+		writeJudgeLogLines(b, earlier, budget.judgeLogSummaryRunes, budget.summary)
+	}
+	b.WriteString("\nLatest exchanges (verbatim):\n")
+	writeJudgeLogLines(b, latest, 0, budget.summary)
+	b.WriteString("\nRecency weighting:\n")
+	b.WriteString("- weigh the latest exchanges most when judging current alignment.\n")
+	b.WriteString("- do not ignore unresolved risks or objections from earlier context; they still block reached=true until addressed.\n")
+}
+
+// writeJudgeLogLines writes one line per turn; limit <= 0 keeps full content.
+func writeJudgeLogLines(b *strings.Builder, turns []orchestrator.Turn, limit int, style summaryStyle) {
+	written := 0
+	for _, t := range turns {
+		turnLimit := limit
+		if turnLimit <= 0 {
+			turnLimit = utf8.RuneCountInString(t.Content)
+		}
+		summary := summarizeTurnWithType(t, turnLimit, style)
+		if summary == "" {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("[%d][%s][%s] %s\n", t.Index, t.SpeakerName, t.Type, summary))
+		written++
+	}
+	if written == 0 {
+		b.WriteString("- none after control-line filtering.\n")
+	}
+}
+
+// sharedContextSection carries earlier debates' outcomes from the same
+// session; it ends with a blank line so the next section starts cleanly.
+func sharedContextSection(sharedContext string) string {
+	sharedContext = strings.TrimSpace(sharedContext)
+	if sharedContext == "" {
+		return ""
+	}
+	return "Shared context from earlier debates in this session (build on these conclusions; do not re-debate them):\n" + sharedContext + "\n\n"
+}
+
+// writeObserverPerspectives lists non-speaking observer personas whose lens
+// should shape moderation and judging without them taking turns.
+// writeRepetitionWatch names speakers whose latest turn was flagged as a
+// near-duplicate of their own earlier turn so the moderator pushes for novelty.
+func writeRepetitionWatch(b *strings.Builder, turns []orchestrator.Turn, nextSpeaker persona.Persona) {
+	latest := make(map[string]orchestrator.Turn)
+	order := make([]string, 0)
+	for _, t := range turns {
+		if t.Type != orchestrator.TurnTypePersona {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(t.SpeakerID))
+		if _, seen := latest[key]; !seen {
+			order = append(order, key)
+		}
+		latest[key] = t
+	}
+
+	var repeated []orchestrator.Turn
+	for _, key := range order {
+		if latest[key].NearDuplicate {
+			repeated = append(repeated, latest[key])
+		}
+	}
+	if len(repeated) == 0 {
+		return
+	}
+	b.WriteString("\nRepetition watch:\n")
+	for _, t := range repeated {
+		line := fmt.Sprintf("- %s repeated an earlier point almost verbatim in [%d]", t.SpeakerName, t.Index)
+		if strings.EqualFold(t.SpeakerID, strings.TrimSpace(nextSpeaker.ID)) {
+			line += "; ask them for a genuinely new argument, evidence, or concession instead of a restatement"
+		}
+		b.WriteString(line + ".\n")
+	}
+}
+
+// writeConsiderationWatch names speakers whose latest turn skipped all of
+// their required considerations so the moderator can follow up.
+func writeConsiderationWatch(b *strings.Builder, turns []orchestrator.Turn, personas []persona.Persona, nextSpeaker persona.Persona) {
+	latest := make(map[string]orchestrator.Turn)
+	for _, t := range turns {
+		if t.Type == orchestrator.TurnTypePersona {
+			latest[strings.ToLower(strings.TrimSpace(t.SpeakerID))] = t
+		}
+	}
+
+	var lines []string
+	for _, p := range personas {
+		t, ok := latest[strings.ToLower(strings.TrimSpace(p.ID))]
+		considerations := normalizePromptList(p.RequiredConsiderations)
+		if !ok || !t.MissedConsideration || len(considerations) == 0 {
+			continue
+		}
+		line := fmt.Sprintf("- %s did not address %s in [%d]", t.SpeakerName, strings.Join(considerations, ", "), t.Index)
+		if strings.EqualFold(p.ID, strings.TrimSpace(nextSpeaker.ID)) {
+			line += "; ask them to state explicitly how their position handles it"
+		}
+		lines = append(lines, line+".\n")
+	}
+	if len(lines) == 0 {
+		return
+	}
+	b.WriteString("\nRequired considerations watch:\n")
+	for _, line := range lines {
+		b.WriteString(line)
+	}
+}
+
+// writeReferenceMaterial lists the speaker's reference docs as [R<n>] so the
+// turn can cite them; nothing is written when the budget drops them.
+func writeReferenceMaterial(b *strings.Builder, docs []string, budget promptBudget) {
+	if budget.referenceDocRunes <= 0 {
+		return
+	}
+	docs = normalizePromptList(docs)
+	if len(docs) == 0 {
+		return
+	}
+	b.WriteString("Reference material (use and cite as [R<n>]):\n")
+	for i, doc := range docs {
+		doc = strings.Join(strings.Fields(doc), " ")
+		b.WriteString(fmt.Sprintf("[R%d] %s\n", i+1, budget.summary.truncate(doc, budget.referenceDocRunes)))
+	}
+	b.WriteString("\n")
+}
+
+func writeObserverPerspectives(b *strings.Builder, observers []persona.Persona) {
+	if len(observers) == 0 {
+		return
+	}
+	b.WriteString("\nStakeholder perspectives to account for (observers; they never speak and must not be handed the turn):\n")
+	for _, p := range observers {
+		line := "- " + persona.DisplayName(p) + ": " + strings.TrimSpace(p.Role)
+		if sigLens := normalizePromptList(p.SignatureLens); len(sigLens) > 0 {
+			line += "; lens: " + strings.Join(sigLens, ", ")
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
+// writeContextOnlyPersonas lists speakers excluded from consensus so the judge
+// uses what they say without counting them as parties to the agreement.
+func writeContextOnlyPersonas(b *strings.Builder, personas []persona.Persona) {
+	var excluded []persona.Persona
+	for _, p := range personas {
+		if p.ExcludeFromConsensus && !p.Observer {
+			excluded = append(excluded, p)
+		}
+	}
+	if len(excluded) == 0 {
+		return
+	}
+	b.WriteString("\nContext only, not parties to the consensus (use their facts; do not require their agreement or count their stance when scoring alignment):\n")
+	for _, p := range excluded {
+		b.WriteString("- " + persona.DisplayName(p) + ": " + strings.TrimSpace(p.Role) + "\n")
+	}
+}
+
+// redTeamStanceLine replaces the configured stance with an assigned camp
+// when the run is a red-team exercise.
+func redTeamStanceLine(camp string) string {
+	switch camp {
+	case orchestrator.RedTeamSupport:
+		return "- stance (red-team assignment, overrides any configured stance): strongly support the proposal in the problem; defend it against every objection.\n"
+	case orchestrator.RedTeamOppose:
+		return "- stance (red-team assignment, overrides any configured stance): strongly oppose the proposal in the problem; press its weakest points.\n"
+	default:
+		return ""
+	}
+}
+
+// writeRedTeamAssignments tells the judge that positions were assigned for
+// adversarial testing, so persistent disagreement is expected and consensus
+// should rest on arguments that survived the opposition.
+func writeRedTeamAssignments(b *strings.Builder, personas []persona.Persona, stances map[string]string) {
+	if len(stances) == 0 {
+		return
+	}
+	b.WriteString("\nRed-team exercise (stances were assigned for adversarial testing, not chosen by the personas):\n")
+	for _, p := range personas {
+		if camp := stances[p.ID]; camp != "" {
+			b.WriteString("- " + persona.DisplayName(p) + ": " + camp + "\n")
+		}
+	}
+	b.WriteString("- judge which arguments survived the assigned opposition; do not count a persona holding its assigned side as a lack of persuasion.\n")
+}
+
+// responseLanguageLine overrides the same-language-as-problem rule when a
+// response language is configured.
+func responseLanguageLine(language string) string {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return ""
+	}
+	return "- response language: " + language + " (overrides the LANGUAGE RULE; write all narrative text in this language)\n"
+}
+
 func buildFinalModeratorSystemPrompt() string {
 	return strings.TrimSpace(`### ROLE
 You are the closing moderator. Your goal is to provide a definitive wrap-up of the entire debate.
@@ -539,8 +847,8 @@ You are the closing moderator. Your goal is to provide a definitive wrap-up of t
 - Do not introduce new facts beyond the provided debate and judge context.`)
 }
 
-func buildFinalModeratorUserPrompt(input orchestrator.GenerateFinalModeratorInput) string {
-	budget := derivePromptBudget(len(input.Personas), len(input.Turns))
+func buildFinalModeratorUserPrompt(input orchestrator.GenerateFinalModeratorInput, style summaryStyle) string {
+	budget := derivePromptBudget(len(input.Personas), len(input.Turns)).withSummaryStyle(style)
 	audienceMode := normalizePromptAudienceMode(input.AudienceMode)
 	logTail := trimTurns(input.Turns, budget.judgeRecentLogLimit)
 
@@ -567,7 +875,7 @@ func buildFinalModeratorUserPrompt(input orchestrator.GenerateFinalModeratorInpu
 	} else {
 		writtenTail := 0
 		for _, t := range logTail {
-			summary := summarizeTurnWithType(t, budget.judgeLogSummaryRunes)
+			summary := summarizeTurnWithType(t, budget.judgeLogSummaryRunes, budget.summary)
 			if summary == "" {
 				continue
 			}
@@ -578,8 +886,16 @@ func buildFinalModeratorUserPrompt(input orchestrator.GenerateFinalModeratorInpu
 			b.WriteString("- none after control-line filtering.\n")
 		}
 	}
+	if len(input.OpenQuestions) > 0 {
+		b.WriteString("\nOpen questions raised but not answered:\n")
+		for _, q := range input.OpenQuestions {
+			b.WriteString("- " + q + "\n")
+		}
+		b.WriteString("- close each one: answer it from the debate, or name it as deferred with an owner.\n")
+	}
 	b.WriteString("\nAudience guidance:\n")
 	b.WriteString("- requested audience_mode: " + audienceMode + "\n")
+	b.WriteString(responseLanguageLine(input.ResponseLanguage))
 	if audienceMode == orchestrator.AudienceModeExpert {
 		b.WriteString("- expert mode: concise and precise closing summary.\n")
 	} else {
@@ -623,7 +939,7 @@ func buildTurnInteractionSnapshot(turns []orchestrator.Turn, speaker persona.Per
 		return b.String()
 	}
 
-	claims := collectLatestSpeakerClaims(turns, budget.turnSpeakerClaims, budget.interactionSummaryRunes)
+	claims := collectLatestSpeakerClaims(turns, budget.turnSpeakerClaims, budget.interactionSummaryRunes, budget.summary)
 	if len(claims) == 0 {
 		b.WriteString("- latest claim per speaker: unavailable\n")
 	} else {
@@ -633,35 +949,41 @@ func buildTurnInteractionSnapshot(turns []orchestrator.Turn, speaker persona.Per
 		}
 	}
 
-	if ownClaim := findLatestPersonaClaim(turns, speaker, true, budget.interactionSummaryRunes); ownClaim != "" {
+	if ownClaim := findLatestPersonaClaim(turns, speaker, true, budget.interactionSummaryRunes, budget.summary); ownClaim != "" {
 		b.WriteString("- your latest claim: " + ownClaim + "\n")
 		b.WriteString("- repeat guardrail: do not restate this verbatim; add a new condition, metric, or dependency.\n")
 	} else {
 		b.WriteString("- your latest claim: none yet\n")
 	}
 
-	if peerClaim := findLatestPersonaClaim(turns, speaker, false, budget.interactionSummaryRunes); peerClaim != "" {
+	if peerClaim := findLatestPersonaClaim(turns, speaker, false, budget.interactionSummaryRunes, budget.summary); peerClaim != "" {
 		b.WriteString("- most recent peer claim: " + peerClaim + "\n")
 	}
 
-	if modAsk := findLatestModeratorAsk(turns, budget.interactionSummaryRunes); modAsk != "" {
+	if modAsk := findLatestModeratorAsk(turns, budget.interactionSummaryRunes, budget.summary); modAsk != "" {
 		b.WriteString("- latest moderator ask: " + modAsk + "\n")
 	}
 
 	latestTurn := turns[len(turns)-1]
-	if tension := buildTensionCandidate(claims, latestTurn, budget.moderatorMemory.tensionSummaryRunes); tension != "" {
+	if tension := buildTensionCandidate(claims, latestTurn, budget.moderatorMemory.tensionSummaryRunes, budget.summary); tension != "" {
 		b.WriteString("- active tension candidate: " + tension + "\n")
 	}
 	return b.String()
 }
 
-func findLatestModeratorAsk(turns []orchestrator.Turn, summaryRunes int) string {
+// findLatestModeratorAsk returns the latest moderator turn's stored
+// ModeratorAsk, falling back to a summary of the whole turn for results saved
+// before the field existed.
+func findLatestModeratorAsk(turns []orchestrator.Turn, summaryRunes int, style summaryStyle) string {
 	for i := len(turns) - 1; i >= 0; i-- {
 		t := turns[i]
 		if t.Type != orchestrator.TurnTypeModerator {
 			continue
 		}
-		content := summarizeTurnWithType(t, summaryRunes)
+		if ask := summarizeSanitizedContent(t.ModeratorAsk, summaryRunes, style); ask != "" {
+			return ask
+		}
+		content := summarizeTurnWithType(t, summaryRunes, style)
 		if content == "" {
 			continue
 		}
@@ -670,7 +992,7 @@ func findLatestModeratorAsk(turns []orchestrator.Turn, summaryRunes int) string
 	return ""
 }
 
-func buildModeratorLoopStatus(turns []orchestrator.Turn, summaryRunes int) string {
+func buildModeratorLoopStatus(turns []orchestrator.Turn, summaryRunes int, style summaryStyle) string {
 	if len(turns) == 0 {
 		return "- previous moderator ask: none\n- first response after that ask: n/a\n"
 	}
@@ -686,7 +1008,7 @@ func buildModeratorLoopStatus(turns []orchestrator.Turn, summaryRunes int) strin
 		return "- previous moderator ask: none\n- first response after that ask: n/a\n"
 	}
 
-	ask := summarizeTurnWithType(turns[lastModeratorIdx], summaryRunes)
+	ask := summarizeTurnWithType(turns[lastModeratorIdx], summaryRunes, style)
 	if ask == "" {
 		ask = "(empty)"
 	}
@@ -700,7 +1022,7 @@ func buildModeratorLoopStatus(turns []orchestrator.Turn, summaryRunes int) strin
 		if speaker == "" {
 			speaker = strings.TrimSpace(turns[i].SpeakerID)
 		}
-		summary := summarizeTurnWithType(turns[i], summaryRunes)
+		summary := summarizeTurnWithType(turns[i], summaryRunes, style)
 		if summary == "" {
 			continue
 		}
@@ -1248,6 +1570,32 @@ func trailingNoNewPointStreak(turns []orchestrator.Turn) int {
 	return streak
 }
 
+// turnSignalsAnnotation renders a persona turn's CLOSE and NEW_POINT votes as
+// one compact line, since the summarized log body no longer carries them.
+func turnSignalsAnnotation(t orchestrator.Turn) string {
+	if t.Type != orchestrator.TurnTypePersona {
+		return ""
+	}
+	parts := make([]string, 0, 2)
+	if vote, ok := orchestrator.CloseVote(t.Content); ok {
+		parts = append(parts, "close="+yesNo(vote))
+	}
+	if newPoint, ok := parseNewPointDirective(t.Content); ok {
+		parts = append(parts, "new_point="+yesNo(newPoint))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "signals: " + strings.Join(parts, " ")
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
 func parseNewPointDirective(content string) (bool, bool) {
 	text := strings.ReplaceAll(content, "\r\n", "\n")
 	lines := strings.Split(text, "\n")
@@ -1279,7 +1627,7 @@ func parseDirectiveBool(raw string) (bool, bool) {
 	}
 }
 
-func findLatestPersonaClaim(turns []orchestrator.Turn, speaker persona.Persona, self bool, summaryRunes int) string {
+func findLatestPersonaClaim(turns []orchestrator.Turn, speaker persona.Persona, self bool, summaryRunes int, style summaryStyle) string {
 	for i := len(turns) - 1; i >= 0; i-- {
 		t := turns[i]
 		if t.Type != orchestrator.TurnTypePersona {
@@ -1296,7 +1644,7 @@ func findLatestPersonaClaim(turns []orchestrator.Turn, speaker persona.Persona,
 		if speakerName == "" {
 			speakerName = strings.TrimSpace(t.SpeakerID)
 		}
-		claim := summarizeTurnContent(t.Content, summaryRunes)
+		claim := summarizeTurnContent(t.Content, summaryRunes, style)
 		if claim == "" {
 			continue
 		}
@@ -0,0 +1,46 @@
+package openai
+
+import (
+	"strings"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+func buildModeratorIntroSystemPrompt() string {
+	return strings.TrimSpace(`### ROLE
+You are the opening moderator of a multi-persona debate. Set the tone before anyone argues.
+
+### LANGUAGE RULE
+- Respond exclusively in the same language as the problem statement.
+
+### RESPONSE REQUIREMENTS
+- 2-4 concise sentences.
+- Restate the problem in plain words and what a good outcome looks like.
+- Introduce every speaking persona by name with their role in a few words.
+- Invite the opening speaker to begin.
+- Do not take a position, predict the outcome, or introduce new facts.`)
+}
+
+func buildModeratorIntroUserPrompt(input orchestrator.GenerateModeratorIntroInput) string {
+	audienceMode := normalizePromptAudienceMode(input.AudienceMode)
+
+	var b strings.Builder
+	b.WriteString("Problem:\n" + input.Problem + "\n\n")
+	b.WriteString(sharedContextSection(input.SharedContext))
+	b.WriteString("Panel:\n")
+	for _, p := range input.Personas {
+		line := "- " + persona.DisplayName(p) + ": " + strings.TrimSpace(p.Role)
+		if stance := strings.TrimSpace(p.Stance); stance != "" {
+			line += "; stance: " + stance
+		}
+		b.WriteString(line + "\n")
+	}
+	writeObserverPerspectives(&b, input.Observers)
+	b.WriteString("\nOpening speaker: " + persona.DisplayName(input.OpeningSpeaker) + "\n")
+	b.WriteString("\nAudience guidance:\n")
+	b.WriteString("- requested audience_mode: " + audienceMode + "\n")
+	b.WriteString(responseLanguageLine(input.ResponseLanguage))
+	b.WriteString("- Write the opening introduction now.\n")
+	return b.String()
+}
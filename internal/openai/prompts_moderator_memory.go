@@ -25,6 +25,7 @@ type moderatorMemoryBudget struct {
 	speakerClaimLimit   int
 	claimSummaryRunes   int
 	tensionSummaryRunes int
+	summary             summaryStyle
 }
 
 func defaultModeratorMemoryBudget() moderatorMemoryBudget {
@@ -75,7 +76,7 @@ func buildModeratorMemorySnapshot(turns []orchestrator.Turn, previousTurn orches
 		b.WriteString("- anchor turns before latest:\n")
 		writtenAnchors := 0
 		for _, t := range anchors {
-			summary := summarizeTurnWithType(t, budget.claimSummaryRunes)
+			summary := summarizeTurnWithType(t, budget.claimSummaryRunes, budget.summary)
 			if summary == "" {
 				continue
 			}
@@ -87,7 +88,7 @@ func buildModeratorMemorySnapshot(turns []orchestrator.Turn, previousTurn orches
 		}
 	}
 
-	claims := collectLatestSpeakerClaims(turns, budget.speakerClaimLimit, budget.claimSummaryRunes)
+	claims := collectLatestSpeakerClaims(turns, budget.speakerClaimLimit, budget.claimSummaryRunes, budget.summary)
 	if len(claims) == 0 {
 		b.WriteString("- latest claim per speaker: unavailable\n")
 	} else {
@@ -97,7 +98,7 @@ func buildModeratorMemorySnapshot(turns []orchestrator.Turn, previousTurn orches
 		}
 	}
 
-	if tension := buildTensionCandidate(claims, previousTurn, budget.tensionSummaryRunes); tension != "" {
+	if tension := buildTensionCandidate(claims, previousTurn, budget.tensionSummaryRunes, budget.summary); tension != "" {
 		b.WriteString("- tension candidate: " + tension + "\n")
 	}
 	return b.String()
@@ -135,10 +136,10 @@ func selectModeratorAnchorTurns(turns []orchestrator.Turn, previousTurn orchestr
 	return anchors
 }
 
-func collectLatestSpeakerClaims(turns []orchestrator.Turn, limit int, summaryRunes int) []speakerClaim {
-	claims := collectClaimsBySpeaker(turns, limit, summaryRunes, true)
+func collectLatestSpeakerClaims(turns []orchestrator.Turn, limit int, summaryRunes int, style summaryStyle) []speakerClaim {
+	claims := collectClaimsBySpeaker(turns, limit, summaryRunes, style, true)
 	if len(claims) == 0 {
-		claims = collectClaimsBySpeaker(turns, limit, summaryRunes, false)
+		claims = collectClaimsBySpeaker(turns, limit, summaryRunes, style, false)
 	}
 	for i, j := 0, len(claims)-1; i < j; i, j = i+1, j-1 {
 		claims[i], claims[j] = claims[j], claims[i]
@@ -146,7 +147,7 @@ func collectLatestSpeakerClaims(turns []orchestrator.Turn, limit int, summaryRun
 	return claims
 }
 
-func collectClaimsBySpeaker(turns []orchestrator.Turn, limit int, summaryRunes int, personaOnly bool) []speakerClaim {
+func collectClaimsBySpeaker(turns []orchestrator.Turn, limit int, summaryRunes int, style summaryStyle, personaOnly bool) []speakerClaim {
 	if limit <= 0 {
 		return nil
 	}
@@ -169,7 +170,7 @@ func collectClaimsBySpeaker(turns []orchestrator.Turn, limit int, summaryRunes i
 		if _, exists := seenSpeaker[key]; exists {
 			continue
 		}
-		summary := summarizeTurnWithType(t, summaryRunes)
+		summary := summarizeTurnWithType(t, summaryRunes, style)
 		if summary == "" {
 			continue
 		}
@@ -182,7 +183,7 @@ func collectClaimsBySpeaker(turns []orchestrator.Turn, limit int, summaryRunes i
 	return claims
 }
 
-func buildTensionCandidate(claims []speakerClaim, previousTurn orchestrator.Turn, summaryRunes int) string {
+func buildTensionCandidate(claims []speakerClaim, previousTurn orchestrator.Turn, summaryRunes int, style summaryStyle) string {
 	if len(claims) < 2 {
 		return ""
 	}
@@ -201,9 +202,9 @@ func buildTensionCandidate(claims []speakerClaim, previousTurn orchestrator.Turn
 				}
 				return fmt.Sprintf("%s (%s) vs %s (%s)",
 					current.speaker,
-					summarizeTurnContent(current.claim, summaryRunes),
+					summarizeTurnContent(current.claim, summaryRunes, style),
 					other.speaker,
-					summarizeTurnContent(other.claim, summaryRunes),
+					summarizeTurnContent(other.claim, summaryRunes, style),
 				)
 			}
 		}
@@ -213,9 +214,9 @@ func buildTensionCandidate(claims []speakerClaim, previousTurn orchestrator.Turn
 	right := claims[len(claims)-1]
 	return fmt.Sprintf("%s (%s) vs %s (%s)",
 		left.speaker,
-		summarizeTurnContent(left.claim, summaryRunes),
+		summarizeTurnContent(left.claim, summaryRunes, style),
 		right.speaker,
-		summarizeTurnContent(right.claim, summaryRunes),
+		summarizeTurnContent(right.claim, summaryRunes, style),
 	)
 }
 
@@ -234,22 +235,22 @@ func normalizeSpeakerKey(t orchestrator.Turn) string {
 	return strings.ToLower(name)
 }
 
-func summarizeTurnContent(content string, limit int) string {
+func summarizeTurnContent(content string, limit int, style summaryStyle) string {
 	clean := stripMachineControlLines(content)
-	return summarizeSanitizedContent(clean, limit)
+	return summarizeSanitizedContent(clean, limit, style)
 }
 
-func summarizeTurnWithType(turn orchestrator.Turn, limit int) string {
+func summarizeTurnWithType(turn orchestrator.Turn, limit int, style summaryStyle) string {
 	if turn.Type == orchestrator.TurnTypeModerator {
 		clean := stripMachineControlLinesPreserveModeratorCore(turn.Content)
-		return summarizeSanitizedContent(clean, limit)
+		return summarizeSanitizedContent(clean, limit, style)
 	}
-	return summarizeTurnContent(turn.Content, limit)
+	return summarizeTurnContent(turn.Content, limit, style)
 }
 
-func summarizeSanitizedContent(content string, limit int) string {
+func summarizeSanitizedContent(content string, limit int, style summaryStyle) string {
 	compact := strings.Join(strings.Fields(strings.TrimSpace(content)), " ")
-	return truncateRunes(compact, limit)
+	return style.truncate(compact, limit)
 }
 
 func stripMachineControlLines(content string) string {
@@ -269,10 +270,7 @@ func stripMachineControlLines(content string) string {
 			strings.HasPrefix(upper, "SELF_CHECK:"),
 			strings.HasPrefix(upper, "META_DELTA:"),
 			strings.HasPrefix(upper, "HANDOFF_ASK:"),
-			strings.HasPrefix(upper, "NEXT:"),
-			strings.HasPrefix(upper, "CLOSE:"),
-			strings.HasPrefix(upper, "NEW_POINT:"),
-			strings.HasPrefix(upper, "NEW_POINT="),
+			isTurnSignalLine(candidate),
 			strings.HasPrefix(upper, "SYNTHESIS:"),
 			strings.HasPrefix(upper, "TENSION:"),
 			strings.HasPrefix(upper, "ASK:"),
@@ -288,6 +286,43 @@ func stripMachineControlLines(content string) string {
 	return strings.Join(filtered, "\n")
 }
 
+// nextSpeakerLinePrefixes mirror the NEXT aliases the orchestrator accepts,
+// so a handoff line never leaks into a summarized log entry.
+var nextSpeakerLinePrefixes = []string{
+	"NEXT:", "NEXT=", "NEXT_SPEAKER:", "NEXT_SPEAKER=", "다음 화자:", "다음화자:",
+}
+
+// voteSignalLinePrefixes are the canonical CLOSE/NEW_POINT forms, stripped
+// whatever their value.
+var voteSignalLinePrefixes = []string{"CLOSE:", "NEW_POINT:", "NEW_POINT="}
+
+// voteSignalAliasPrefixes are only treated as signals when followed by a
+// yes/no value, since the Korean forms can also open an ordinary sentence.
+var voteSignalAliasPrefixes = []string{"CLOSE=", "NEW-POINT:", "종료:", "토론종료:", "신규포인트:", "새논점:"}
+
+// isTurnSignalLine reports whether a normalized line is a NEXT, CLOSE or
+// NEW_POINT control line in any form the orchestrator parses.
+func isTurnSignalLine(candidate string) bool {
+	upper := strings.ToUpper(candidate)
+	for _, prefix := range nextSpeakerLinePrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			return true
+		}
+	}
+	for _, prefix := range voteSignalLinePrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			return true
+		}
+	}
+	for _, prefix := range voteSignalAliasPrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			_, ok := parseDirectiveBool(upper[len(prefix):])
+			return ok
+		}
+	}
+	return false
+}
+
 func stripMachineControlLinesPreserveModeratorCore(content string) string {
 	text := strings.ReplaceAll(content, "\r\n", "\n")
 	lines := strings.Split(text, "\n")
@@ -305,10 +340,7 @@ func stripMachineControlLinesPreserveModeratorCore(content string) string {
 			strings.HasPrefix(upper, "SELF_CHECK:"),
 			strings.HasPrefix(upper, "META_DELTA:"),
 			strings.HasPrefix(upper, "HANDOFF_ASK:"),
-			strings.HasPrefix(upper, "NEXT:"),
-			strings.HasPrefix(upper, "CLOSE:"),
-			strings.HasPrefix(upper, "NEW_POINT:"),
-			strings.HasPrefix(upper, "NEW_POINT="):
+			isTurnSignalLine(candidate):
 			continue
 		case strings.HasPrefix(upper, "SYNTHESIS:"),
 			strings.HasPrefix(upper, "TENSION:"),
@@ -382,17 +414,3 @@ func isLikelyOrderedListPrefix(prefix string) bool {
 	// Avoid stripping date-like strings such as "2026. 3월".
 	return len(prefix) <= 3
 }
-
-func truncateRunes(text string, limit int) string {
-	if limit <= 0 {
-		return ""
-	}
-	runes := []rune(text)
-	if len(runes) <= limit {
-		return text
-	}
-	if limit == 1 {
-		return "…"
-	}
-	return string(runes[:limit-1]) + "…"
-}
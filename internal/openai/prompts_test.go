@@ -26,7 +26,7 @@ func TestBuildTurnUserPromptIncludesSignatureLensForKnownExpert(t *testing.T) {
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "signature lens") {
 		t.Fatalf("expected signature lens guidance, prompt=%q", prompt)
 	}
@@ -69,7 +69,7 @@ func TestBuildModeratorUserPromptIncludesNextSpeakerLens(t *testing.T) {
 		},
 	}
 
-	prompt := buildModeratorUserPrompt(input)
+	prompt := buildModeratorUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "next speaker signature lens") {
 		t.Fatalf("expected next speaker lens in moderator prompt, prompt=%q", prompt)
 	}
@@ -276,6 +276,35 @@ func TestBuildOpeningSpeakerSelectorUserPromptSkipsEmptyIDCandidates(t *testing.
 	}
 }
 
+func TestBuildOpeningSpeakerSelectorUserPromptCompactsLargeRoster(t *testing.T) {
+	detailed := func(id string) persona.Persona {
+		return persona.Persona{ID: id, Name: strings.ToUpper(id), Role: "role " + id, Stance: "cautious", Expertise: []string{"expertise " + id}, MasterName: "Master " + id}
+	}
+
+	small := buildOpeningSpeakerSelectorUserPrompt(orchestrator.SelectOpeningSpeakerInput{
+		Problem:  "small panel",
+		Personas: []persona.Persona{detailed("p1"), detailed("p2")},
+	})
+	if !strings.Contains(small, "stance: cautious") || !strings.Contains(small, "expertise: expertise p1") || !strings.Contains(small, "master_name: Master p1") {
+		t.Fatalf("expected detail fields for small roster, prompt=%q", small)
+	}
+
+	personas := make([]persona.Persona, 0, openingSelectorCompactPersonas)
+	for i := 0; i < openingSelectorCompactPersonas; i++ {
+		personas = append(personas, detailed(fmt.Sprintf("p%d", i+1)))
+	}
+	large := buildOpeningSpeakerSelectorUserPrompt(orchestrator.SelectOpeningSpeakerInput{
+		Problem:  "large panel",
+		Personas: personas,
+	})
+	if strings.Contains(large, "stance:") || strings.Contains(large, "expertise:") || strings.Contains(large, "master_name:") {
+		t.Fatalf("expected detail fields dropped for large roster, prompt=%q", large)
+	}
+	if !strings.Contains(large, "- id: p8\n  name: P8\n  role: role p8\n") {
+		t.Fatalf("expected id/name/role kept for large roster, prompt=%q", large)
+	}
+}
+
 func TestBuildModeratorSystemPromptReducesRecencyBias(t *testing.T) {
 	prompt := buildModeratorSystemPrompt()
 	if !strings.Contains(prompt, "Avoid recency bias") {
@@ -352,6 +381,107 @@ func TestBuildModeratorSystemPromptReducesRecencyBias(t *testing.T) {
 	}
 }
 
+func TestBuildPromptsIncludeObserverPerspectives(t *testing.T) {
+	speakers := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product"},
+		{ID: "p2", Name: "SRE", Role: "reliability"},
+	}
+	observers := []persona.Persona{
+		{ID: "cust", Name: "고객 대표", Role: "customer", SignatureLens: []string{"switching cost for existing users"}, Observer: true},
+	}
+	turns := []orchestrator.Turn{{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "의견"}}
+
+	moderator := buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+		Problem:      "요금제 개편",
+		Personas:     speakers,
+		Turns:        turns,
+		PreviousTurn: turns[0],
+		NextSpeaker:  speakers[1],
+		Observers:    observers,
+	}, summaryStyle{})
+	judge := buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
+		Problem:   "요금제 개편",
+		Personas:  speakers,
+		Turns:     turns,
+		Observers: observers,
+	}, summaryStyle{})
+	for name, prompt := range map[string]string{"moderator": moderator, "judge": judge} {
+		if !strings.Contains(prompt, "Stakeholder perspectives to account for") {
+			t.Fatalf("expected stakeholder section in %s prompt, prompt=%q", name, prompt)
+		}
+		if !strings.Contains(prompt, "- 고객 대표: customer; lens: switching cost for existing users") {
+			t.Fatalf("expected observer lens in %s prompt, prompt=%q", name, prompt)
+		}
+	}
+
+	without := buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+		Problem:      "요금제 개편",
+		Personas:     speakers,
+		Turns:        turns,
+		PreviousTurn: turns[0],
+		NextSpeaker:  speakers[1],
+	}, summaryStyle{})
+	if strings.Contains(without, "Stakeholder perspectives") {
+		t.Fatalf("expected no stakeholder section without observers, prompt=%q", without)
+	}
+}
+
+func TestBuildJudgeUserPromptMarksExcludedPersonasAsContextOnly(t *testing.T) {
+	speakers := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product"},
+		{ID: "p2", Name: "SRE", Role: "reliability"},
+		{ID: "facts", Name: "Data Desk", Role: "fact provider", ExcludeFromConsensus: true},
+	}
+	turns := []orchestrator.Turn{{Index: 1, SpeakerID: "facts", SpeakerName: "Data Desk", Type: orchestrator.TurnTypePersona, Content: "지난 분기 장애 4건"}}
+
+	prompt := buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
+		Problem:  "요금제 개편",
+		Personas: speakers,
+		Turns:    turns,
+	}, summaryStyle{})
+	if !strings.Contains(prompt, "Context only, not parties to the consensus") || !strings.Contains(prompt, "- Data Desk: fact provider\n") {
+		t.Fatalf("expected context-only note for excluded persona, prompt=%q", prompt)
+	}
+	if strings.Contains(prompt, "- PM: product\n") {
+		t.Fatalf("expected only excluded personas in the context-only note, prompt=%q", prompt)
+	}
+
+	without := buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
+		Problem:  "요금제 개편",
+		Personas: speakers[:2],
+		Turns:    turns,
+	}, summaryStyle{})
+	if strings.Contains(without, "Context only") {
+		t.Fatalf("expected no context-only note without excluded personas, prompt=%q", without)
+	}
+}
+
+func TestBuildPromptsIncludeSharedContext(t *testing.T) {
+	speakers := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product"},
+		{ID: "p2", Name: "SRE", Role: "reliability"},
+	}
+	turns := []orchestrator.Turn{{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "의견"}}
+	shared := "- debate 1: 장애 줄이기\n  - conclusion: 카나리 배포 도입"
+
+	prompts := map[string]string{
+		"turn": buildTurnUserPrompt(orchestrator.GenerateTurnInput{
+			Problem: "온콜 인력 배치", Personas: speakers, Turns: turns, Speaker: speakers[1], SharedContext: shared,
+		}, summaryStyle{}),
+		"moderator": buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+			Problem: "온콜 인력 배치", Personas: speakers, Turns: turns, PreviousTurn: turns[0], NextSpeaker: speakers[1], SharedContext: shared,
+		}, summaryStyle{}),
+		"judge": buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
+			Problem: "온콜 인력 배치", Personas: speakers, Turns: turns, SharedContext: shared,
+		}, summaryStyle{}),
+	}
+	for name, prompt := range prompts {
+		if !strings.Contains(prompt, "Shared context from earlier debates") || !strings.Contains(prompt, "카나리 배포 도입") {
+			t.Fatalf("expected shared context in %s prompt, prompt=%q", name, prompt)
+		}
+	}
+}
+
 func TestBuildModeratorUserPromptIncludesMemoryAnchorsAndTension(t *testing.T) {
 	input := orchestrator.GenerateModeratorInput{
 		Problem: "신규 기능 론칭 전략",
@@ -381,7 +511,7 @@ func TestBuildModeratorUserPromptIncludesMemoryAnchorsAndTension(t *testing.T) {
 		},
 	}
 
-	prompt := buildModeratorUserPrompt(input)
+	prompt := buildModeratorUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "anchor turns before latest") {
 		t.Fatalf("expected anchor turn section, prompt=%q", prompt)
 	}
@@ -409,7 +539,7 @@ func TestFindLatestModeratorAskPreservesStructuredModeratorLines(t *testing.T) {
 		},
 	}
 
-	ask := findLatestModeratorAsk(turns, 220)
+	ask := findLatestModeratorAsk(turns, 220, summaryStyle{})
 	if ask == "" {
 		t.Fatalf("expected structured moderator content to remain in summary")
 	}
@@ -446,7 +576,7 @@ func TestBuildModeratorLoopStatusSkipsEmptyPersonaResponse(t *testing.T) {
 		},
 	}
 
-	status := buildModeratorLoopStatus(turns, 220)
+	status := buildModeratorLoopStatus(turns, 220, summaryStyle{})
 	if strings.Contains(status, "A: ") {
 		t.Fatalf("did not expect empty summarized response from speaker A, status=%q", status)
 	}
@@ -479,7 +609,7 @@ func TestBuildFinalModeratorUserPromptIncludesFinalStatus(t *testing.T) {
 		FinalStatus: orchestrator.StatusConsensusReached,
 	}
 
-	prompt := buildFinalModeratorUserPrompt(input)
+	prompt := buildFinalModeratorUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "status: "+orchestrator.StatusConsensusReached) {
 		t.Fatalf("expected final status in prompt, prompt=%q", prompt)
 	}
@@ -535,7 +665,7 @@ func TestBuildFinalModeratorUserPromptCompressesLogTailByBudget(t *testing.T) {
 		Personas:  personas,
 		Turns:     turns,
 		Consensus: orchestrator.Consensus{Score: 0.7},
-	})
+	}, summaryStyle{})
 
 	if strings.Contains(prompt, "[1][PM][persona]") {
 		t.Fatalf("expected compressed final log tail to drop earliest turns, prompt=%q", prompt)
@@ -565,7 +695,7 @@ func TestBuildTurnUserPromptIncludesInteractionSnapshotAndObjectives(t *testing.
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "Interaction memory snapshot:") {
 		t.Fatalf("expected interaction memory section, prompt=%q", prompt)
 	}
@@ -681,7 +811,7 @@ func TestBuildTurnUserPromptConvergenceIncludesDecisionTemplate(t *testing.T) {
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "current phase: convergence") {
 		t.Fatalf("expected convergence phase, prompt=%q", prompt)
 	}
@@ -709,7 +839,7 @@ func TestBuildTurnUserPromptDeadlockModeWhenNoNewPointStreak(t *testing.T) {
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "trailing persona NEW_POINT=no streak: 2") {
 		t.Fatalf("expected no-new-point streak summary, prompt=%q", prompt)
 	}
@@ -751,7 +881,7 @@ func TestBuildTurnUserPromptDoesNotForceQualityCheckpointEveryTurn(t *testing.T)
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if strings.Contains(prompt, "quality checkpoint required now") {
 		t.Fatalf("did not expect forced quality checkpoint on non-checkpoint turn, prompt=%q", prompt)
 	}
@@ -787,7 +917,7 @@ func TestBuildModeratorUserPromptIncludesScorecardCadenceTrigger(t *testing.T) {
 		},
 	}
 
-	prompt := buildModeratorUserPrompt(input)
+	prompt := buildModeratorUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "persona turns observed so far: 4") {
 		t.Fatalf("expected persona turn count in cadence signals, prompt=%q", prompt)
 	}
@@ -857,6 +987,50 @@ func TestBuildJudgeSystemPromptHasConservativeRubric(t *testing.T) {
 	}
 }
 
+func TestBuildJudgeUserPromptRecencyWeighting(t *testing.T) {
+	long := strings.Repeat("초기 리스크: 데이터 마이그레이션 롤백 계획이 없다. ", 20)
+	turns := []orchestrator.Turn{
+		{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: long},
+		{Index: 2, SpeakerID: "p2", SpeakerName: "SRE", Type: orchestrator.TurnTypePersona, Content: "롤백 리허설이 먼저다"},
+		{Index: 3, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "리허설 일정에 동의"},
+		{Index: 4, SpeakerID: "p2", SpeakerName: "SRE", Type: orchestrator.TurnTypePersona, Content: "그럼 금요일 리허설"},
+		{Index: 5, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: long},
+	}
+	input := orchestrator.JudgeConsensusInput{
+		Problem: "마이그레이션 일정",
+		Personas: []persona.Persona{
+			{ID: "p1", Name: "PM", Role: "product"},
+			{ID: "p2", Name: "SRE", Role: "reliability"},
+		},
+		Turns: turns,
+	}
+
+	flat := buildJudgeUserPrompt(input, summaryStyle{})
+	if !strings.Contains(flat, "Debate log tail:") || strings.Contains(flat, "Latest exchanges") {
+		t.Fatalf("expected flat judge log when weighting is off, prompt=%q", flat)
+	}
+
+	input.RecencyWeighting = true
+	prompt := buildJudgeUserPrompt(input, summaryStyle{})
+	earlierIdx := strings.Index(prompt, "Earlier context (summarized):\n[1][PM]")
+	latestIdx := strings.Index(prompt, "Latest exchanges (verbatim):\n[2][SRE]")
+	if earlierIdx < 0 || latestIdx < 0 || earlierIdx > latestIdx {
+		t.Fatalf("expected earlier context before latest exchanges, prompt=%q", prompt)
+	}
+	if strings.Contains(prompt, "Debate log tail:") {
+		t.Fatalf("expected flat log header to be replaced, prompt=%q", prompt)
+	}
+	if !strings.Contains(prompt[latestIdx:], strings.TrimSpace(long)) {
+		t.Fatalf("expected latest exchanges verbatim, prompt=%q", prompt)
+	}
+	if strings.Contains(prompt[earlierIdx:latestIdx], strings.TrimSpace(long)) {
+		t.Fatalf("expected earlier context to be summarized, prompt=%q", prompt)
+	}
+	if !strings.Contains(prompt, "weigh the latest exchanges most") || !strings.Contains(prompt, "do not ignore unresolved risks") {
+		t.Fatalf("expected recency weighting guidance, prompt=%q", prompt)
+	}
+}
+
 func TestBuildJudgeUserPromptIncludesFormatReminder(t *testing.T) {
 	prompt := buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
 		Problem: "릴리즈 Go/No-Go 결정",
@@ -866,7 +1040,7 @@ func TestBuildJudgeUserPromptIncludesFormatReminder(t *testing.T) {
 		Turns: []orchestrator.Turn{
 			{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "기능 범위를 축소하면 가능"},
 		},
-	})
+	}, summaryStyle{})
 	if !strings.Contains(prompt, "Output format reminder:") {
 		t.Fatalf("expected output format reminder section, prompt=%q", prompt)
 	}
@@ -917,7 +1091,7 @@ func TestBuildTurnUserPromptSkipsEmptySpeakerFields(t *testing.T) {
 		},
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if strings.Contains(prompt, "- stance: \n") {
 		t.Fatalf("did not expect blank stance field, prompt=%q", prompt)
 	}
@@ -995,7 +1169,7 @@ func TestBuildTurnUserPromptExpertAudienceMode(t *testing.T) {
 		AudienceMode: orchestrator.AudienceModeExpert,
 	}
 
-	prompt := buildTurnUserPrompt(input)
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "requested audience_mode: expert") {
 		t.Fatalf("expected expert audience mode indicator, prompt=%q", prompt)
 	}
@@ -1016,7 +1190,7 @@ func TestBuildFinalModeratorUserPromptIncludesAudienceMode(t *testing.T) {
 		AudienceMode: orchestrator.AudienceModeExpert,
 	}
 
-	prompt := buildFinalModeratorUserPrompt(input)
+	prompt := buildFinalModeratorUserPrompt(input, summaryStyle{})
 	if !strings.Contains(prompt, "requested audience_mode: expert") {
 		t.Fatalf("expected audience mode indicator in final moderator prompt, prompt=%q", prompt)
 	}
@@ -1242,7 +1416,7 @@ func TestSummarizeTurnContentStripsMarkdownPrefixedDirectiveLines(t *testing.T)
 		"실제 사용자 영향: 초기 전환율은 소폭 개선되지만 리스크 모니터링이 필요합니다.",
 	}, "\n")
 
-	summary := summarizeTurnContent(content, 200)
+	summary := summarizeTurnContent(content, 200, summaryStyle{})
 	if strings.Contains(strings.ToUpper(summary), "ISSUE_UPDATE") ||
 		strings.Contains(strings.ToUpper(summary), "PERSUASION_UPDATE") ||
 		strings.Contains(strings.ToUpper(summary), "DECISION_CHECK") ||
@@ -1262,14 +1436,72 @@ func TestSummarizeTurnContentControlOnlyReturnsEmpty(t *testing.T) {
 		"NEW_POINT: no",
 	}, "\n")
 
-	if got := summarizeTurnContent(content, 120); got != "" {
+	if got := summarizeTurnContent(content, 120, summaryStyle{}); got != "" {
 		t.Fatalf("expected control-only content to summarize as empty, got %q", got)
 	}
 }
 
+func TestLogSummariesNeverContainControlLines(t *testing.T) {
+	turns := []orchestrator.Turn{
+		{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: strings.Join([]string{
+			"단계적 출시로 리스크를 줄이자는 입장입니다.",
+			"- NEXT: p2",
+			"CLOSE=yes",
+			"NEW-POINT: no",
+		}, "\n")},
+		{Index: 2, SpeakerID: "p2", SpeakerName: "SRE", Type: orchestrator.TurnTypePersona, Content: strings.Join([]string{
+			"롤백 자동화가 먼저 필요합니다.",
+			"NEXT_SPEAKER: p1",
+			"종료: no",
+			"신규포인트: yes",
+		}, "\n")},
+		{Index: 3, SpeakerID: "moderator", SpeakerName: "Moderator", Type: orchestrator.TurnTypeModerator, Content: "두 입장을 정리합니다.\n다음 화자: p1"},
+	}
+
+	var b strings.Builder
+	writeJudgeLogLines(&b, turns, 0, summaryStyle{})
+	for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		upper := strings.ToUpper(line)
+		for _, marker := range []string{"NEXT", "CLOSE", "NEW_POINT", "NEW-POINT", "종료:", "신규포인트", "다음 화자"} {
+			if strings.Contains(upper, marker) {
+				t.Fatalf("control marker %q leaked into log summary %q", marker, line)
+			}
+		}
+	}
+	if !strings.Contains(b.String(), "롤백 자동화가 먼저 필요합니다.") {
+		t.Fatalf("expected argument body to remain, got %q", b.String())
+	}
+}
+
+func TestSummarizeTurnContentKeepsKoreanAliasSentence(t *testing.T) {
+	got := summarizeTurnContent("종료: 구형 API는 다음 분기에 내립니다.", 120, summaryStyle{})
+	if !strings.Contains(got, "구형 API") {
+		t.Fatalf("expected non-boolean alias line to remain, got %q", got)
+	}
+}
+
+func TestBuildTurnUserPromptAnnotatesTurnSignals(t *testing.T) {
+	input := orchestrator.GenerateTurnInput{
+		Problem: "배포 전략",
+		Personas: []persona.Persona{
+			{ID: "p1", Name: "PM", Role: "product"},
+			{ID: "p2", Name: "SRE", Role: "reliability"},
+		},
+		Speaker: persona.Persona{ID: "p2", Name: "SRE", Role: "reliability"},
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "단계적 출시를 제안합니다.\nNEXT: p2\nCLOSE: yes\nNEW_POINT: no"},
+		},
+	}
+
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
+	if !strings.Contains(prompt, "[1][PM] 단계적 출시를 제안합니다.\n  signals: close=yes new_point=no\n") {
+		t.Fatalf("expected summary followed by signals annotation, got %q", prompt)
+	}
+}
+
 func TestSummarizeTurnContentDoesNotStripYearLikeSentencePrefix(t *testing.T) {
 	content := "2026. 3월까지 실험 완료 후 결과를 공유합니다."
-	got := summarizeTurnContent(content, 120)
+	got := summarizeTurnContent(content, 120, summaryStyle{})
 	if !strings.Contains(got, "2026. 3월까지") {
 		t.Fatalf("expected year-like sentence prefix to remain, got %q", got)
 	}
@@ -1385,6 +1617,35 @@ func TestBuildModeratorMemorySnapshotShowsAnchorFallbackAfterFiltering(t *testin
 	}
 }
 
+func TestBuildModeratorMemorySnapshotShrinksWithPromptBudget(t *testing.T) {
+	names := []string{"PM", "Risk", "Data", "Ops", "Legal"}
+	claim := strings.Repeat("연간 요금제는 이탈률을 낮추지만 환불 정책과 결제 실패 처리 비용을 함께 검토해야 합니다. ", 4)
+	turns := make([]orchestrator.Turn, 0, len(names)*2)
+	for i := 0; i < cap(turns); i++ {
+		name := names[i%len(names)]
+		turns = append(turns, orchestrator.Turn{
+			Index: i + 1, SpeakerID: strings.ToLower(name), SpeakerName: name,
+			Type: orchestrator.TurnTypePersona, Content: fmt.Sprintf("%s %s의 %d번째 주장", claim, name, i+1),
+		})
+	}
+	previous := turns[len(turns)-1]
+
+	low := buildModeratorMemorySnapshot(turns, previous, derivePromptBudget(len(names), 4).moderatorMemory)
+	high := buildModeratorMemorySnapshot(turns, previous, derivePromptBudget(8, 48).moderatorMemory)
+	if len(high) >= len(low) {
+		t.Fatalf("expected shorter snapshot at high compression: low=%d high=%d", len(low), len(high))
+	}
+	if got, want := strings.Count(high, "  - ["), 2; got != want {
+		t.Fatalf("expected %d anchors at high compression, got %d: %q", want, got, high)
+	}
+	claimLines := func(snapshot string) int {
+		return strings.Count(snapshot, "\n  - ") - strings.Count(snapshot, "\n  - [")
+	}
+	if claimLines(high) >= claimLines(low) {
+		t.Fatalf("expected fewer speaker claims at high compression:\nlow=%q\nhigh=%q", low, high)
+	}
+}
+
 func TestBuildJudgeUserPromptShowsFilteredNoneWhenDebateTailHasOnlyControlLines(t *testing.T) {
 	prompt := buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
 		Problem: "릴리즈 의사결정",
@@ -1400,7 +1661,7 @@ func TestBuildJudgeUserPromptShowsFilteredNoneWhenDebateTailHasOnlyControlLines(
 				Content:     "HANDOFF_ASK: 다음 액션?\nNEXT: p1\nCLOSE: no\nNEW_POINT: no",
 			},
 		},
-	})
+	}, summaryStyle{})
 
 	if !strings.Contains(prompt, "- none after control-line filtering.") {
 		t.Fatalf("expected filtered-none fallback in judge debate tail, prompt=%q", prompt)
@@ -1422,13 +1683,29 @@ func TestBuildFinalModeratorUserPromptShowsFilteredNoneWhenLogTailHasOnlyControl
 				Content:     "HANDOFF_ASK: 다음 액션?\nNEXT: p1\nCLOSE: no\nNEW_POINT: no",
 			},
 		},
-	})
+	}, summaryStyle{})
 
 	if !strings.Contains(prompt, "Final debate log tail:\n- none after control-line filtering.") {
 		t.Fatalf("expected filtered-none fallback in final debate log tail, prompt=%q", prompt)
 	}
 }
 
+func TestBuildFinalModeratorUserPromptListsOpenQuestions(t *testing.T) {
+	prompt := buildFinalModeratorUserPrompt(orchestrator.GenerateFinalModeratorInput{
+		Problem:       "최종 정리",
+		OpenQuestions: []string{"Who signs off on the cutover date?"},
+	}, summaryStyle{})
+
+	if !strings.Contains(prompt, "Open questions raised but not answered:\n- Who signs off on the cutover date?\n") {
+		t.Fatalf("expected open questions section, prompt=%q", prompt)
+	}
+
+	prompt = buildFinalModeratorUserPrompt(orchestrator.GenerateFinalModeratorInput{Problem: "최종 정리"}, summaryStyle{})
+	if strings.Contains(prompt, "Open questions raised") {
+		t.Fatalf("expected no open questions section, prompt=%q", prompt)
+	}
+}
+
 func TestSummarizeCloseReadinessMergesIssueUpdatesByIssue(t *testing.T) {
 	turns := []orchestrator.Turn{
 		{
@@ -1486,7 +1763,7 @@ func TestBuildJudgeUserPromptIncludesDecisionStateSnapshot(t *testing.T) {
 				Content:     "DECISION_CHECK: choose Option A or B; metric_threshold=p95<300ms; owner=risk; decide_by=2026-03-19; success_metric=에러율 1% 미만; stop_condition=에러율 1% 초과",
 			},
 		},
-	})
+	}, summaryStyle{})
 
 	if !strings.Contains(prompt, "Decision-state snapshot:") {
 		t.Fatalf("expected decision-state snapshot section, prompt=%q", prompt)
@@ -1504,3 +1781,178 @@ func TestBuildJudgeUserPromptIncludesDecisionStateSnapshot(t *testing.T) {
 		t.Fatalf("expected bounded experiment signal summary in judge prompt, prompt=%q", prompt)
 	}
 }
+
+func TestBuildTurnUserPromptShortensProblemUnderCompression(t *testing.T) {
+	problem := strings.Repeat("결제 재시도 정책과 장애 대응 범위를 함께 정해야 합니다. ", 40)
+	personas := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product"},
+		{ID: "p2", Name: "Data", Role: "analytics"},
+	}
+	turns := make([]orchestrator.Turn, 0, 30)
+	for i := 1; i <= 30; i++ {
+		speaker := personas[i%2]
+		turns = append(turns, orchestrator.Turn{Index: i, SpeakerID: speaker.ID, SpeakerName: speaker.Name, Type: orchestrator.TurnTypePersona, Content: "근거를 보강합니다."})
+	}
+
+	first := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: problem, Personas: personas, Speaker: personas[0]}, summaryStyle{})
+	if !strings.Contains(first, "Problem: "+problem) {
+		t.Fatalf("expected full problem in first turn prompt, prompt=%q", first)
+	}
+
+	later := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: problem, Personas: personas, Turns: turns, Speaker: personas[0]}, summaryStyle{})
+	if strings.Contains(later, problem) {
+		t.Fatalf("expected truncated problem in high-compression prompt, prompt=%q", later)
+	}
+	if !strings.Contains(later, "Problem (shortened restatement): ") || !strings.Contains(later, "…") {
+		t.Fatalf("expected shortened problem restatement, prompt=%q", later)
+	}
+
+	short := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: problem, Personas: personas, Turns: turns[:4], Speaker: personas[0]}, summaryStyle{})
+	if !strings.Contains(short, "Problem: "+problem) {
+		t.Fatalf("expected full problem without compression, prompt=%q", short)
+	}
+}
+
+func TestBuildTurnUserPromptIncludesSpeakerReferenceDocs(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product", ReferenceDocs: []string{"2025 churn study: annual plans retain 18% better."}},
+		{ID: "p2", Name: "Data", Role: "analytics"},
+	}
+
+	prompt := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "연간 요금제를 도입할까?", Personas: personas, Speaker: personas[0]}, summaryStyle{})
+	if !strings.Contains(prompt, "Reference material (use and cite as [R<n>]):\n[R1] 2025 churn study: annual plans retain 18% better.\n") {
+		t.Fatalf("expected reference material for speaker, prompt=%q", prompt)
+	}
+
+	other := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "연간 요금제를 도입할까?", Personas: personas, Speaker: personas[1]}, summaryStyle{})
+	if strings.Contains(other, "Reference material") {
+		t.Fatalf("expected no reference material for speaker without docs, prompt=%q", other)
+	}
+
+	turns := make([]orchestrator.Turn, 0, 40)
+	for i := 1; i <= 40; i++ {
+		speaker := personas[i%2]
+		turns = append(turns, orchestrator.Turn{Index: i, SpeakerID: speaker.ID, SpeakerName: speaker.Name, Type: orchestrator.TurnTypePersona, Content: "근거를 보강합니다."})
+	}
+	compressed := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "연간 요금제를 도입할까?", Personas: personas, Turns: turns, Speaker: personas[0]}, summaryStyle{})
+	if strings.Contains(compressed, "churn study") {
+		t.Fatalf("expected reference material dropped under high compression, prompt=%q", compressed)
+	}
+}
+
+func TestBuildModeratorUserPromptPushesNearDuplicateSpeakerTowardNovelty(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product"},
+		{ID: "p2", Name: "Data", Role: "analytics"},
+	}
+	turns := []orchestrator.Turn{
+		{Index: 1, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "연간 요금제를 먼저 도입합시다."},
+		{Index: 2, SpeakerID: "p2", SpeakerName: "Data", Type: orchestrator.TurnTypePersona, Content: "이탈 데이터부터 확인해야 합니다."},
+		{Index: 3, SpeakerID: "p1", SpeakerName: "PM", Type: orchestrator.TurnTypePersona, Content: "연간 요금제를 먼저 도입합시다.", NearDuplicate: true},
+	}
+
+	prompt := buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+		Problem: "연간 요금제를 도입할까?", Personas: personas, Turns: turns, PreviousTurn: turns[2], NextSpeaker: personas[0],
+	}, summaryStyle{})
+	if !strings.Contains(prompt, "Repetition watch:\n- PM repeated an earlier point almost verbatim in [3]; ask them for a genuinely new argument") {
+		t.Fatalf("expected novelty push for next speaker, prompt=%q", prompt)
+	}
+
+	turns[2].NearDuplicate = false
+	prompt = buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+		Problem: "연간 요금제를 도입할까?", Personas: personas, Turns: turns, PreviousTurn: turns[2], NextSpeaker: personas[0],
+	}, summaryStyle{})
+	if strings.Contains(prompt, "Repetition watch") {
+		t.Fatalf("expected no repetition watch without flagged turns, prompt=%q", prompt)
+	}
+}
+
+func TestBuildPromptsSurfaceRequiredConsiderations(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Legal", Role: "privacy", RequiredConsiderations: []string{"GDPR", "data residency"}},
+		{ID: "p2", Name: "Data", Role: "analytics"},
+	}
+
+	prompt := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "고객 이벤트를 통합할까?", Personas: personas, Speaker: personas[0]}, summaryStyle{})
+	if !strings.Contains(prompt, "- must explicitly address (name each in this turn):\n  - GDPR\n  - data residency\n") {
+		t.Fatalf("expected required considerations for speaker, prompt=%q", prompt)
+	}
+	other := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "고객 이벤트를 통합할까?", Personas: personas, Speaker: personas[1]}, summaryStyle{})
+	if strings.Contains(other, "must explicitly address") {
+		t.Fatalf("expected no required considerations for other speaker, prompt=%q", other)
+	}
+
+	turns := []orchestrator.Turn{
+		{Index: 1, SpeakerID: "p1", SpeakerName: "Legal", Type: orchestrator.TurnTypePersona, Content: "통합 저장소로 갑시다.", MissedConsideration: true},
+	}
+	moderator := buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+		Problem: "고객 이벤트를 통합할까?", Personas: personas, Turns: turns, PreviousTurn: turns[0], NextSpeaker: personas[0],
+	}, summaryStyle{})
+	if !strings.Contains(moderator, "Required considerations watch:\n- Legal did not address GDPR, data residency in [1]; ask them to state explicitly") {
+		t.Fatalf("expected moderator follow-up on missed considerations, prompt=%q", moderator)
+	}
+}
+
+func TestUserPromptsIncludeResponseLanguageOverride(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Growth PM", Role: "growth"},
+		{ID: "p2", Name: "Operator", Role: "ops"},
+	}
+	prompts := map[string]string{
+		"turn": buildTurnUserPrompt(orchestrator.GenerateTurnInput{
+			Problem: "활성화율을 높이는 방법은?", Personas: personas, Speaker: personas[0], ResponseLanguage: "en",
+		}, summaryStyle{}),
+		"moderator": buildModeratorUserPrompt(orchestrator.GenerateModeratorInput{
+			Problem: "활성화율을 높이는 방법은?", Personas: personas, NextSpeaker: personas[1], ResponseLanguage: "en",
+		}, summaryStyle{}),
+		"judge": buildJudgeUserPrompt(orchestrator.JudgeConsensusInput{
+			Problem: "활성화율을 높이는 방법은?", Personas: personas, ResponseLanguage: "en",
+		}, summaryStyle{}),
+		"final": buildFinalModeratorUserPrompt(orchestrator.GenerateFinalModeratorInput{
+			Problem: "활성화율을 높이는 방법은?", Personas: personas, ResponseLanguage: "en",
+		}, summaryStyle{}),
+	}
+	for name, prompt := range prompts {
+		if !strings.Contains(prompt, "- response language: en") {
+			t.Fatalf("expected %s prompt to carry the language override, prompt=%q", name, prompt)
+		}
+	}
+
+	plain := buildTurnUserPrompt(orchestrator.GenerateTurnInput{Problem: "x", Personas: personas, Speaker: personas[0]}, summaryStyle{})
+	if strings.Contains(plain, "response language:") {
+		t.Fatalf("expected no language override by default, prompt=%q", plain)
+	}
+}
+
+func TestBuildPromptsApplyRedTeamStances(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "PM", Role: "product", Stance: "cautious adoption"},
+		{ID: "p2", Name: "Risk", Role: "risk"},
+	}
+	input := orchestrator.GenerateTurnInput{
+		Problem:       "가격 정책 실험",
+		Personas:      personas,
+		Speaker:       personas[0],
+		RedTeamStance: orchestrator.RedTeamOppose,
+	}
+
+	prompt := buildTurnUserPrompt(input, summaryStyle{})
+	if !strings.Contains(prompt, "red-team assignment, overrides any configured stance): strongly oppose") {
+		t.Fatalf("expected red-team stance override, prompt=%q", prompt)
+	}
+	if strings.Contains(prompt, "cautious adoption") {
+		t.Fatalf("expected the configured stance to be ignored, prompt=%q", prompt)
+	}
+
+	input.RedTeamStance = ""
+	if prompt := buildTurnUserPrompt(input, summaryStyle{}); !strings.Contains(prompt, "- stance: cautious adoption") {
+		t.Fatalf("expected the configured stance without red-team, prompt=%q", prompt)
+	}
+
+	judge := sampleJudgeInput()
+	judge.RedTeamStances = map[string]string{"a": orchestrator.RedTeamSupport, "b": orchestrator.RedTeamOppose}
+	judgePrompt := buildJudgeUserPrompt(judge, summaryStyle{})
+	if !strings.Contains(judgePrompt, "assigned for adversarial testing") || !strings.Contains(judgePrompt, "- B: oppose") {
+		t.Fatalf("expected judge to be told about red-team stances, prompt=%q", judgePrompt)
+	}
+}
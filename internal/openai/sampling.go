@@ -0,0 +1,36 @@
+package openai
+
+import "debate/internal/persona"
+
+// Persona creativity maps linearly onto [minTurnTemperature,
+// maxTurnTemperature]. The moderator and judge use fixed low temperatures so
+// a creative roster does not make summaries or verdicts noisier. Sampling is
+// opt-in: temperatures are only sent once a persona in the roster sets
+// Creativity, since some models reject the parameter.
+const (
+	minTurnTemperature   = 0.2
+	maxTurnTemperature   = 1.0
+	moderatorTemperature = 0.3
+	judgeTemperature     = 0.0
+)
+
+// turnTemperature is the sampling temperature for speaker's turn, or nil to
+// keep the model default.
+func turnTemperature(speaker persona.Persona) *float64 {
+	if speaker.Creativity == nil {
+		return nil
+	}
+	t := minTurnTemperature + *speaker.Creativity*(maxTurnTemperature-minTurnTemperature)
+	return &t
+}
+
+// fixedTemperature returns temperature for moderator, judge and selection
+// calls when the roster opts into per-persona sampling, otherwise nil.
+func fixedTemperature(personas []persona.Persona, temperature float64) *float64 {
+	for _, p := range personas {
+		if p.Creativity != nil {
+			return &temperature
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+func TestGenerateTurnTemperatureFollowsCreativity(t *testing.T) {
+	low, high := 0.1, 0.9
+	personas := []persona.Persona{
+		{ID: "pm", Name: "PM", Role: "product", Creativity: &low},
+		{ID: "ux", Name: "UX", Role: "design", Creativity: &high},
+	}
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{OutputText: "낮은 온도 발언", Usage: apiUsage{TotalTokens: 10}},
+			{OutputText: "높은 온도 발언", Usage: apiUsage{TotalTokens: 10}},
+		},
+	}
+	client := newOpeningSpeakerTestClient(doer)
+
+	for _, speaker := range personas {
+		if _, err := client.GenerateTurn(context.Background(), orchestrator.GenerateTurnInput{
+			Problem:  "온보딩 개선",
+			Personas: personas,
+			Speaker:  speaker,
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(doer.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doer.requests))
+	}
+	lowTemp, highTemp := doer.requests[0].Temperature, doer.requests[1].Temperature
+	if lowTemp == nil || highTemp == nil {
+		t.Fatalf("expected temperatures on both requests, got %v and %v", lowTemp, highTemp)
+	}
+	if *highTemp <= *lowTemp {
+		t.Fatalf("expected high-creativity temperature %v above low-creativity %v", *highTemp, *lowTemp)
+	}
+}
+
+func TestSamplingTemperaturesOmittedWithoutCreativity(t *testing.T) {
+	speaker := persona.Persona{ID: "pm", Name: "PM", Role: "product"}
+	if got := turnTemperature(speaker); got != nil {
+		t.Fatalf("expected no turn temperature, got %v", *got)
+	}
+	if got := fixedTemperature([]persona.Persona{speaker}, judgeTemperature); got != nil {
+		t.Fatalf("expected no judge temperature, got %v", *got)
+	}
+
+	creative := 1.0
+	speaker.Creativity = &creative
+	if got := fixedTemperature([]persona.Persona{speaker}, judgeTemperature); got == nil || *got != judgeTemperature {
+		t.Fatalf("expected fixed judge temperature once roster opts in, got %v", got)
+	}
+}
@@ -0,0 +1,209 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+// streamEvent is the subset of a Responses API server-sent event the client
+// reads: text deltas, the completed response (for usage), and failures.
+type streamEvent struct {
+	Type     string        `json:"type"`
+	Delta    string        `json:"delta"`
+	Response *responseBody `json:"response"`
+	Message  string        `json:"message"`
+}
+
+// StreamFinalModerator generates the final wrap-up as a streamed request and
+// passes text fragments to onDelta as they arrive. Unlike the plain call it
+// does not re-request a cut-off answer, since the fragments are already shown.
+func (c *Client) StreamFinalModerator(ctx context.Context, input orchestrator.GenerateFinalModeratorInput, onDelta func(string)) (orchestrator.GenerateFinalModeratorOutput, error) {
+	text, usage, err := c.streamPlainText(
+		ctx,
+		c.modModel,
+		buildFinalModeratorSystemPrompt(),
+		buildFinalModeratorUserPrompt(input, c.summary),
+		"empty final moderator output",
+		finalModeratorMaxOutputToken,
+		fixedTemperature(input.Personas, moderatorTemperature),
+		onDelta,
+	)
+	if err != nil {
+		return orchestrator.GenerateFinalModeratorOutput{}, err
+	}
+
+	return orchestrator.GenerateFinalModeratorOutput{
+		Content: text,
+		Model:   c.modModel,
+		Usage:   usage,
+	}, nil
+}
+
+// StreamTurn generates a persona turn as a streamed request, passing text
+// fragments to onDelta. Structured turns fall back to GenerateTurn, since
+// their raw JSON is not worth showing. Like StreamFinalModerator it skips the
+// truncation retry.
+func (c *Client) StreamTurn(ctx context.Context, input orchestrator.GenerateTurnInput, onDelta func(string)) (orchestrator.GenerateTurnOutput, error) {
+	if input.Structured {
+		return c.GenerateTurn(ctx, input)
+	}
+	text, usage, err := c.streamPlainText(
+		ctx,
+		c.model,
+		buildTurnSystemPrompt(),
+		buildTurnUserPrompt(input, c.summary),
+		"empty model output",
+		turnMaxOutputTokens,
+		turnTemperature(input.Speaker),
+		onDelta,
+	)
+	if err != nil {
+		return orchestrator.GenerateTurnOutput{}, err
+	}
+
+	return orchestrator.GenerateTurnOutput{
+		Content: text,
+		Model:   c.model,
+		Usage:   usage,
+	}, nil
+}
+
+// streamPlainText is generatePlainText over a streamed request. Failed
+// attempts are retried only while nothing has been passed to onDelta yet.
+func (c *Client) streamPlainText(ctx context.Context, model string, systemPrompt string, userPrompt string, emptyOutputError string, maxOutputTokens int, temperature *float64, onDelta func(string)) (string, orchestrator.Usage, error) {
+	payload, err := marshalRequest(responseRequest{
+		Model: model,
+		Input: []inputMsg{
+			makeMessage("system", c.wrapSystemPrompt(systemPrompt)),
+			makeMessage("user", userPrompt),
+		},
+		MaxOutputTokens: maxOutputTokens,
+		Temperature:     temperature,
+		Stream:          true,
+	})
+	if err != nil {
+		return "", orchestrator.Usage{}, err
+	}
+
+	emitted := false
+	forward := func(delta string) {
+		emitted = true
+		if onDelta != nil {
+			onDelta(delta)
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if err := c.acquireInFlight(ctx); err != nil {
+			return "", orchestrator.Usage{}, err
+		}
+		apiCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		text, usage, err := c.doStreamRequest(apiCtx, payload, forward)
+		cancel()
+		c.releaseInFlight()
+
+		if err == nil {
+			text = strings.TrimSpace(text)
+			if text == "" {
+				return "", orchestrator.Usage{}, errors.New(emptyOutputError)
+			}
+			return text, toUsage(usage), nil
+		}
+		lastErr = err
+
+		if attempt == c.maxRetries || emitted || !isRetriableError(err) {
+			break
+		}
+		if err := c.sleepBeforeRetry(ctx, retryDelay(err, attempt)); err != nil {
+			return "", orchestrator.Usage{}, err
+		}
+	}
+	return "", orchestrator.Usage{}, lastErr
+}
+
+// doStreamRequest sends a streaming Responses request and reads its events
+// until the response completes. The returned text is the completed
+// response's output, or the joined deltas when that is missing.
+func (c *Client) doStreamRequest(ctx context.Context, payload []byte, onDelta func(string)) (string, apiUsage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", apiUsage{}, fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", apiUsage{}, fmt.Errorf("openai request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes))
+		if err != nil {
+			return "", apiUsage{}, fmt.Errorf("read response body: %w", err)
+		}
+		return "", apiUsage{}, &httpStatusError{statusCode: resp.StatusCode, message: decodeAPIError(body), retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+	}
+
+	var deltas strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxResponseBodyBytes)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "" || data == "[DONE]" {
+			continue
+		}
+
+		var event streamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return "", apiUsage{}, fmt.Errorf("decode response: %w", err)
+		}
+		switch event.Type {
+		case "response.output_text.delta":
+			if event.Delta != "" {
+				deltas.WriteString(event.Delta)
+				onDelta(event.Delta)
+			}
+		case "response.completed":
+			if event.Response == nil {
+				return deltas.String(), apiUsage{}, nil
+			}
+			text := extractOutputText(*event.Response)
+			if strings.TrimSpace(text) == "" {
+				text = deltas.String()
+			}
+			return text, event.Response.Usage, nil
+		case "response.failed", "error":
+			message := strings.TrimSpace(event.Message)
+			if event.Response != nil && event.Response.Error != nil {
+				message = strings.TrimSpace(event.Response.Error.Message)
+			}
+			if message == "" {
+				message = event.Type
+			}
+			return "", apiUsage{}, fmt.Errorf("api error: %s", message)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", apiUsage{}, fmt.Errorf("read response body: %w", err)
+	}
+	return "", apiUsage{}, errors.New("read response body: stream ended before the response completed")
+}
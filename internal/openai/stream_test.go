@@ -0,0 +1,136 @@
+package openai
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+// sseHTTPDoer replies with a fixed server-sent event body and records the
+// request payload.
+type sseHTTPDoer struct {
+	body    string
+	payload string
+}
+
+func (d *sseHTTPDoer) Do(req *http.Request) (*http.Response, error) {
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	d.payload = string(raw)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:       io.NopCloser(strings.NewReader(d.body)),
+	}, nil
+}
+
+func TestStreamFinalModeratorForwardsDeltasAndUsage(t *testing.T) {
+	client, err := NewClient(Config{APIKey: "test-key", Model: "gpt-test", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	doer := &sseHTTPDoer{body: strings.Join([]string{
+		"event: response.created",
+		`data: {"type":"response.created"}`,
+		"",
+		"event: response.output_text.delta",
+		`data: {"type":"response.output_text.delta","delta":"단계적 "}`,
+		"",
+		"event: response.output_text.delta",
+		`data: {"type":"response.output_text.delta","delta":"롤아웃으로 합의했습니다."}`,
+		"",
+		"event: response.completed",
+		`data: {"type":"response.completed","response":{"output_text":"단계적 롤아웃으로 합의했습니다.","usage":{"input_tokens":7,"output_tokens":5,"total_tokens":12}}}`,
+		"",
+	}, "\n")}
+	client.httpClient = doer
+
+	input := sampleJudgeInput()
+	var deltas []string
+	out, err := client.StreamFinalModerator(context.Background(), orchestrator.GenerateFinalModeratorInput{
+		Problem:  input.Problem,
+		Personas: input.Personas,
+		Turns:    input.Turns,
+	}, func(delta string) {
+		deltas = append(deltas, delta)
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !strings.Contains(doer.payload, `"stream":true`) {
+		t.Fatalf("expected a streaming request, got %s", doer.payload)
+	}
+	if strings.Join(deltas, "|") != "단계적 |롤아웃으로 합의했습니다." {
+		t.Fatalf("unexpected deltas: %q", deltas)
+	}
+	if out.Content != "단계적 롤아웃으로 합의했습니다." || out.Usage.TotalTokens != 12 || out.Model != "gpt-test" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+}
+
+func TestStreamFinalModeratorReportsStreamFailure(t *testing.T) {
+	client, err := NewClient(Config{APIKey: "test-key", Model: "gpt-test", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	client.httpClient = &sseHTTPDoer{body: strings.Join([]string{
+		`data: {"type":"response.output_text.delta","delta":"partial"}`,
+		"",
+		`data: {"type":"response.failed","response":{"error":{"message":"server overloaded"}}}`,
+		"",
+	}, "\n")}
+
+	_, err = client.StreamFinalModerator(context.Background(), orchestrator.GenerateFinalModeratorInput{Problem: "p"}, func(string) {})
+	if err == nil || !strings.Contains(err.Error(), "server overloaded") {
+		t.Fatalf("expected the stream failure message, got %v", err)
+	}
+}
+
+func TestStreamTurnForwardsDeltas(t *testing.T) {
+	client, err := NewClient(Config{APIKey: "test-key", Model: "gpt-test", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	doer := &sseHTTPDoer{body: strings.Join([]string{
+		"event: response.output_text.delta",
+		`data: {"type":"response.output_text.delta","delta":"카나리 배포를 "}`,
+		"",
+		"event: response.output_text.delta",
+		`data: {"type":"response.output_text.delta","delta":"먼저 하죠."}`,
+		"",
+		"event: response.completed",
+		`data: {"type":"response.completed","response":{"output_text":"카나리 배포를 먼저 하죠.","usage":{"input_tokens":9,"output_tokens":4,"total_tokens":13}}}`,
+		"",
+	}, "\n")}
+	client.httpClient = doer
+
+	input := sampleJudgeInput()
+	var deltas []string
+	out, err := client.StreamTurn(context.Background(), orchestrator.GenerateTurnInput{
+		Problem:  input.Problem,
+		Personas: input.Personas,
+		Turns:    input.Turns,
+		Speaker:  input.Personas[0],
+	}, func(delta string) {
+		deltas = append(deltas, delta)
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !strings.Contains(doer.payload, `"stream":true`) {
+		t.Fatalf("expected a streaming request, got %s", doer.payload)
+	}
+	if strings.Join(deltas, "|") != "카나리 배포를 |먼저 하죠." {
+		t.Fatalf("unexpected deltas: %q", deltas)
+	}
+	if out.Content != "카나리 배포를 먼저 하죠." || out.Usage.TotalTokens != 13 || out.Model != "gpt-test" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+}
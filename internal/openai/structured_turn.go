@@ -0,0 +1,150 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"debate/internal/orchestrator"
+)
+
+// structuredTurnRequiredKeys are the schema fields every structured turn must carry.
+var structuredTurnRequiredKeys = []string{"claim", "evidence", "next_step"}
+
+func buildStructuredTurnSystemPrompt() string {
+	return buildTurnSystemPrompt() + "\n\n" + strings.TrimSpace(`### STRUCTURED OUTPUT (overrides narrative and terminal command layout)
+- Return exactly one JSON object, no markdown or code fence.
+- Required keys: claim (string), evidence (string), next_step (string).
+- Put terminal commands in keys instead of trailing lines: handoff_ask (string), next (persona_id), close ("yes"|"no"), new_point ("yes"|"no").
+- JSON template: {"claim":"","evidence":"","next_step":"","handoff_ask":"","next":"","close":"no","new_point":"yes"}`)
+}
+
+// generateStructuredTurn asks for a JSON turn, retries once on invalid JSON,
+// and falls back to the raw text as plain content when the retry also fails.
+func (c *Client) generateStructuredTurn(ctx context.Context, input orchestrator.GenerateTurnInput) (orchestrator.GenerateTurnOutput, error) {
+	systemPrompt := c.wrapSystemPrompt(buildStructuredTurnSystemPrompt())
+	userPrompt := buildTurnUserPrompt(input, c.summary)
+
+	var (
+		aggregated orchestrator.Usage
+		raw        string
+	)
+	for attempt := 0; attempt < 2; attempt++ {
+		currentUserPrompt := userPrompt
+		if attempt == 1 {
+			currentUserPrompt += "\n\nYour previous response was not a valid JSON object with claim, evidence, and next_step. Return only that JSON object."
+		}
+		resp, err := c.callResponses(ctx, c.model, []inputMsg{
+			makeMessage("system", systemPrompt),
+			makeMessage("user", currentUserPrompt),
+		}, turnMaxOutputTokens, turnTemperature(input.Speaker))
+		if err != nil {
+			return orchestrator.GenerateTurnOutput{}, err
+		}
+		usage := toUsage(resp.Usage)
+		aggregated.PromptTokens += usage.PromptTokens
+		aggregated.CompletionTokens += usage.CompletionTokens
+		aggregated.TotalTokens += usage.TotalTokens
+
+		raw = strings.TrimSpace(extractOutputText(resp))
+		fields, content, parseErr := parseStructuredTurn(raw)
+		if parseErr == nil {
+			return orchestrator.GenerateTurnOutput{
+				Content:    content,
+				Structured: fields,
+				Model:      c.model,
+				Usage:      aggregated,
+			}, nil
+		}
+	}
+
+	if raw == "" {
+		return orchestrator.GenerateTurnOutput{}, errors.New("empty model output")
+	}
+	return orchestrator.GenerateTurnOutput{
+		Content: stripCodeFence(raw),
+		Model:   c.model,
+		Usage:   aggregated,
+	}, nil
+}
+
+// parseStructuredTurn decodes the first JSON object that satisfies the turn
+// schema and renders it as plain content with trailing control lines.
+func parseStructuredTurn(raw string) (map[string]any, string, error) {
+	cleaned := stripCodeFence(strings.TrimSpace(raw))
+	if cleaned == "" {
+		return nil, "", errors.New("empty structured turn output")
+	}
+	candidates := extractJSONObjectCandidates(cleaned)
+	if len(candidates) == 0 {
+		return nil, "", errors.New("structured turn output has no JSON object")
+	}
+
+	var firstErr error
+	for _, jsonText := range candidates {
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(jsonText), &fields); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if err := validateStructuredTurn(fields); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		return fields, renderStructuredTurn(fields), nil
+	}
+	return nil, "", fmt.Errorf("invalid structured turn: %w", firstErr)
+}
+
+func validateStructuredTurn(fields map[string]any) error {
+	for _, key := range structuredTurnRequiredKeys {
+		value, ok := fields[key].(string)
+		if !ok || strings.TrimSpace(value) == "" {
+			return errors.New("missing required structured turn key: " + key)
+		}
+	}
+	return nil
+}
+
+func renderStructuredTurn(fields map[string]any) string {
+	lines := []string{
+		structuredString(fields, "claim"),
+		"Evidence: " + structuredString(fields, "evidence"),
+		"Next step: " + structuredString(fields, "next_step"),
+	}
+	controls := []struct {
+		key   string
+		label string
+	}{
+		{"handoff_ask", "HANDOFF_ASK"},
+		{"next", "NEXT"},
+		{"close", "CLOSE"},
+		{"new_point", "NEW_POINT"},
+	}
+	for _, control := range controls {
+		if value := structuredString(fields, control.key); value != "" {
+			lines = append(lines, control.label+": "+value)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func structuredString(fields map[string]any, key string) string {
+	switch v := fields[key].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case bool:
+		if v {
+			return "yes"
+		}
+		return "no"
+	default:
+		return ""
+	}
+}
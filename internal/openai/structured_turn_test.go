@@ -0,0 +1,112 @@
+package openai
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+func newStructuredTurnTestClient(doer *scriptedHTTPDoer) *Client {
+	return &Client{
+		apiKey:     "test-key",
+		endpoint:   defaultEndpoint,
+		model:      "gpt-test",
+		timeout:    time.Second,
+		httpClient: doer,
+	}
+}
+
+func structuredTurnInput() orchestrator.GenerateTurnInput {
+	input := sampleJudgeInput()
+	return orchestrator.GenerateTurnInput{
+		Problem:    input.Problem,
+		Personas:   input.Personas,
+		Turns:      input.Turns,
+		Speaker:    input.Personas[1],
+		Structured: true,
+	}
+}
+
+func TestGenerateTurnStructuredParsesJSONAndRendersContent(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: "```json\n{\"claim\":\"단계적 롤아웃이 필요합니다.\",\"evidence\":\"지난 분기 장애 2건이 일괄 배포에서 발생했습니다.\",\"next_step\":\"카나리 10%로 시작합니다.\",\"next\":\"architect\",\"close\":\"no\",\"new_point\":\"yes\"}\n```",
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 20, TotalTokens: 30},
+			},
+		},
+	}
+	client := newStructuredTurnTestClient(doer)
+
+	out, err := client.GenerateTurn(context.Background(), structuredTurnInput())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(doer.requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(doer.requests))
+	}
+	system := doer.requests[0].Input[0].Content[0].Text
+	if !strings.Contains(system, "### STRUCTURED OUTPUT") {
+		t.Fatalf("expected structured output section in system prompt, got %q", system)
+	}
+	if got := out.Structured["claim"]; got != "단계적 롤아웃이 필요합니다." {
+		t.Fatalf("unexpected structured claim: %#v", got)
+	}
+	for _, want := range []string{
+		"단계적 롤아웃이 필요합니다.",
+		"Evidence: 지난 분기 장애 2건이 일괄 배포에서 발생했습니다.",
+		"Next step: 카나리 10%로 시작합니다.",
+		"NEXT: architect",
+		"CLOSE: no",
+		"NEW_POINT: yes",
+	} {
+		if !strings.Contains(out.Content, want) {
+			t.Fatalf("expected content to contain %q, got %q", want, out.Content)
+		}
+	}
+	if out.Usage.TotalTokens != 30 {
+		t.Fatalf("unexpected usage: %+v", out.Usage)
+	}
+}
+
+func TestGenerateTurnStructuredFallsBackToPlainContentAfterRetry(t *testing.T) {
+	doer := &scriptedHTTPDoer{
+		t: t,
+		responses: []responseBody{
+			{
+				OutputText: "{\"claim\": \"unterminated",
+				Usage:      apiUsage{InputTokens: 10, OutputTokens: 5, TotalTokens: 15},
+			},
+			{
+				OutputText: "단계적 롤아웃이 필요합니다.\nNEXT: architect",
+				Usage:      apiUsage{InputTokens: 12, OutputTokens: 6, TotalTokens: 18},
+			},
+		},
+	}
+	client := newStructuredTurnTestClient(doer)
+
+	out, err := client.GenerateTurn(context.Background(), structuredTurnInput())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(doer.requests) != 2 {
+		t.Fatalf("expected one retry, got %d requests", len(doer.requests))
+	}
+	retryUser := doer.requests[1].Input[1].Content[0].Text
+	if !strings.Contains(retryUser, "not a valid JSON object") {
+		t.Fatalf("expected retry nudge in user prompt, got %q", retryUser)
+	}
+	if out.Structured != nil {
+		t.Fatalf("expected no structured fields on fallback, got %#v", out.Structured)
+	}
+	if out.Content != "단계적 롤아웃이 필요합니다.\nNEXT: architect" {
+		t.Fatalf("unexpected fallback content: %q", out.Content)
+	}
+	if out.Usage.TotalTokens != 33 {
+		t.Fatalf("expected usage from both attempts, got %+v", out.Usage)
+	}
+}
@@ -0,0 +1,69 @@
+package openai
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const defaultTruncationMarker = "…"
+
+// summaryStyle controls how turn summaries are shortened in prompts. The zero
+// value cuts at the rune limit and appends defaultTruncationMarker.
+type summaryStyle struct {
+	marker string
+	// wordBoundary backs a cut off to the previous space when it would split
+	// a word in a space-delimited script. CJK text keeps the rune cut.
+	wordBoundary bool
+}
+
+func newSummaryStyle(marker string, wordBoundary bool) summaryStyle {
+	return summaryStyle{marker: marker, wordBoundary: wordBoundary}
+}
+
+func (s summaryStyle) truncate(text string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	marker := s.marker
+	if marker == "" {
+		marker = defaultTruncationMarker
+	}
+	keep := limit - utf8.RuneCountInString(marker)
+	if keep <= 0 {
+		return string([]rune(marker)[:limit])
+	}
+	cut := runes[:keep]
+	if s.wordBoundary && splitsWord(runes[keep-1], runes[keep]) {
+		if space := lastSpace(cut); space >= keep/2 {
+			cut = cut[:space]
+		}
+	}
+	return strings.TrimRightFunc(string(cut), unicode.IsSpace) + marker
+}
+
+// splitsWord reports whether cutting between before and after lands inside
+// a word of a space-delimited script.
+func splitsWord(before rune, after rune) bool {
+	return isSpaceDelimitedWordRune(before) && isSpaceDelimitedWordRune(after)
+}
+
+func isSpaceDelimitedWordRune(r rune) bool {
+	if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+		return false
+	}
+	return !unicode.In(r, unicode.Han, unicode.Hangul, unicode.Hiragana, unicode.Katakana)
+}
+
+func lastSpace(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,71 @@
+package openai
+
+import "testing"
+
+func TestSummaryStyleTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		style summaryStyle
+		text  string
+		limit int
+		want  string
+	}{
+		{
+			name:  "default cuts at rune limit",
+			style: summaryStyle{},
+			text:  "rollout the canary release",
+			limit: 12,
+			want:  "rollout the…",
+		},
+		{
+			name:  "word boundary backs off to previous space",
+			style: newSummaryStyle("", true),
+			text:  "gradual rollout behind feature flags",
+			limit: 20,
+			want:  "gradual rollout…",
+		},
+		{
+			name:  "word boundary keeps clean cut at space",
+			style: newSummaryStyle("", true),
+			text:  "ship it now please",
+			limit: 8,
+			want:  "ship it…",
+		},
+		{
+			name:  "korean keeps rune truncation",
+			style: newSummaryStyle("", true),
+			text:  "단계적롤아웃을먼저진행해야합니다",
+			limit: 8,
+			want:  "단계적롤아웃을…",
+		},
+		{
+			name:  "korean with spaces still cuts at rune limit",
+			style: newSummaryStyle("", true),
+			text:  "단계적 롤아웃을 먼저 진행해야 합니다",
+			limit: 10,
+			want:  "단계적 롤아웃을…",
+		},
+		{
+			name:  "custom marker counts toward limit",
+			style: newSummaryStyle(" [...]", false),
+			text:  "abcdefghijkl",
+			limit: 10,
+			want:  "abcd [...]",
+		},
+		{
+			name:  "short text unchanged",
+			style: newSummaryStyle(" [...]", true),
+			text:  "short",
+			limit: 10,
+			want:  "short",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.style.truncate(tc.text, tc.limit); got != tc.want {
+				t.Fatalf("truncate()=%q, want=%q", got, tc.want)
+			}
+		})
+	}
+}
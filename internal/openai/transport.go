@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/rand"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -22,6 +25,9 @@ type responseRequest struct {
 	Model           string     `json:"model"`
 	Input           []inputMsg `json:"input"`
 	MaxOutputTokens int        `json:"max_output_tokens,omitempty"`
+	// Temperature is omitted when nil; a pointer so 0 can still be sent.
+	Temperature *float64 `json:"temperature,omitempty"`
+	Stream      bool     `json:"stream,omitempty"`
 }
 
 type inputMsg struct {
@@ -120,7 +126,7 @@ func (c *Client) doRequest(ctx context.Context, payload []byte) (responseBody, e
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		apiErr := decodeAPIError(body)
-		return responseBody{}, &httpStatusError{statusCode: resp.StatusCode, message: apiErr}
+		return responseBody{}, &httpStatusError{statusCode: resp.StatusCode, message: apiErr, retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
 	}
 
 	var decoded responseBody
@@ -151,6 +157,9 @@ func decodeAPIError(body []byte) string {
 type httpStatusError struct {
 	statusCode int
 	message    string
+	// retryAfter is the server's Retry-After delay, clamped to
+	// maxRetryAfter; 0 when the header was absent or unparsable.
+	retryAfter time.Duration
 }
 
 func (e *httpStatusError) Error() string {
@@ -191,6 +200,52 @@ func isRetriableError(err error) bool {
 	return false
 }
 
+// maxRetryAfter caps a server-requested Retry-After so one response cannot
+// stall a debate for minutes.
+const maxRetryAfter = 30 * time.Second
+
+// parseRetryAfter reads a Retry-After value given as delay seconds or as an
+// HTTP date relative to now. Missing, invalid or past values return 0.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(value); err == nil {
+		delay = at.Sub(now)
+	}
+	if delay <= 0 {
+		return 0
+	}
+	if delay > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return delay
+}
+
+// retryDelay is how long to wait before retry attempt+1: the server's
+// Retry-After when err carries one, otherwise backoffDuration.
+func retryDelay(err error, attempt int) time.Duration {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) && statusErr.retryAfter > 0 {
+		return statusErr.retryAfter
+	}
+	return backoffDuration(attempt)
+}
+
+// backoffRand jitters retry delays; *rand.Rand is not safe for concurrent
+// use, so every draw holds backoffRandMu.
+var (
+	backoffRandMu sync.Mutex
+	backoffRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoffDuration applies full jitter: a uniform delay in [0, 500ms*2^attempt]
+// capped at 4s, so concurrent runs hitting a rate limit do not retry in
+// lockstep.
 func backoffDuration(attempt int) time.Duration {
 	base := 500.0
 	exp := math.Pow(2, float64(attempt))
@@ -198,7 +253,18 @@ func backoffDuration(attempt int) time.Duration {
 	if ms > 4000 {
 		ms = 4000
 	}
-	return time.Duration(ms) * time.Millisecond
+	ceiling := int64(time.Duration(ms) * time.Millisecond)
+	backoffRandMu.Lock()
+	defer backoffRandMu.Unlock()
+	return time.Duration(backoffRand.Int63n(ceiling + 1))
+}
+
+// sleepBeforeRetry waits d, or calls the test hook when one is set.
+func (c *Client) sleepBeforeRetry(ctx context.Context, d time.Duration) error {
+	if c.sleep != nil {
+		return c.sleep(ctx, d)
+	}
+	return sleepWithContext(ctx, d)
 }
 
 func sleepWithContext(ctx context.Context, d time.Duration) error {
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNormalizeEndpoint(t *testing.T) {
@@ -62,3 +66,99 @@ func TestIsRetriableError(t *testing.T) {
 		t.Fatal("response size limit errors should not be retriable")
 	}
 }
+
+func TestBackoffDurationJittersWithinBounds(t *testing.T) {
+	ceilings := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second}
+	for attempt, ceiling := range ceilings {
+		distinct := make(map[time.Duration]struct{})
+		for i := 0; i < 500; i++ {
+			d := backoffDuration(attempt)
+			if d < 0 || d > ceiling {
+				t.Fatalf("attempt %d: backoff %s outside [0, %s]", attempt, d, ceiling)
+			}
+			distinct[d] = struct{}{}
+		}
+		if len(distinct) < 2 {
+			t.Fatalf("attempt %d: expected jittered delays, got a single value", attempt)
+		}
+	}
+}
+
+type rateLimitedDoer struct {
+	retryAfter string
+	calls      int
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls++
+	if d.calls == 1 {
+		header := make(http.Header)
+		header.Set("Retry-After", d.retryAfter)
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"error":{"message":"rate limited"}}`)),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(`{"output_text":"ok"}`)),
+	}, nil
+}
+
+func TestCallResponsesHonorsRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		header string
+		want   time.Duration
+	}{
+		{header: "2", want: 2 * time.Second},
+		{header: "3600", want: maxRetryAfter},
+	} {
+		doer := &rateLimitedDoer{retryAfter: tc.header}
+		var slept []time.Duration
+		client := &Client{
+			apiKey:     "test-key",
+			endpoint:   defaultEndpoint,
+			model:      "gpt-test",
+			timeout:    time.Second,
+			maxRetries: 1,
+			httpClient: doer,
+			sleep: func(ctx context.Context, d time.Duration) error {
+				slept = append(slept, d)
+				return nil
+			},
+		}
+		if _, err := client.callResponses(context.Background(), "gpt-test", nil, 10, nil); err != nil {
+			t.Fatalf("Retry-After %s: unexpected error: %v", tc.header, err)
+		}
+		if doer.calls != 2 {
+			t.Fatalf("Retry-After %s: expected a retry, got %d calls", tc.header, doer.calls)
+		}
+		if len(slept) != 1 || slept[0] != tc.want {
+			t.Fatalf("Retry-After %s: expected one %s wait, got %v", tc.header, tc.want, slept)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{in: "", want: 0},
+		{in: "0", want: 0},
+		{in: "-5", want: 0},
+		{in: "soon", want: 0},
+		{in: " 7 ", want: 7 * time.Second},
+		{in: "120", want: maxRetryAfter},
+		{in: now.Add(10 * time.Second).Format(http.TimeFormat), want: 10 * time.Second},
+		{in: now.Add(-10 * time.Second).Format(http.TimeFormat), want: 0},
+	}
+	for _, tc := range tests {
+		if got := parseRetryAfter(tc.in, now); got != tc.want {
+			t.Fatalf("parseRetryAfter(%q) = %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/persona"
+)
+
+// findActionOwner returns the persona named as owner of the consensus next
+// action. It checks next_action_owner first, then required_next_action, and
+// accepts the same mentions as handoff routing plus the persona's role.
+func findActionOwner(consensus Consensus, personas []persona.Persona) (persona.Persona, bool) {
+	for _, text := range []string{consensus.NextActionOwner, consensus.RequiredNextAction} {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		for _, p := range personas {
+			if mentionsPersona(text, p) || mentionsAlias(text, p.Role) {
+				return p, true
+			}
+		}
+	}
+	return persona.Persona{}, false
+}
+
+// markActionOwner sets OwnerMissing when RequireActionOwner is on and the
+// judge's next action names no persona.
+func (o *Orchestrator) markActionOwner(consensus *Consensus, personas []persona.Persona) {
+	if !o.cfg.RequireActionOwner {
+		return
+	}
+	_, found := findActionOwner(*consensus, personas)
+	consensus.OwnerMissing = !found
+}
@@ -0,0 +1,94 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindActionOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		consensus Consensus
+		wantID    string
+		wantFound bool
+	}{
+		{
+			name:      "owner field names persona",
+			consensus: Consensus{NextActionOwner: "Operator", RequiredNextAction: "ship the canary"},
+			wantID:    "o",
+			wantFound: true,
+		},
+		{
+			name:      "required action mentions role",
+			consensus: Consensus{RequiredNextAction: "architecture lead drafts the migration plan by Friday"},
+			wantID:    "a",
+			wantFound: true,
+		},
+		{
+			name:      "no persona mentioned",
+			consensus: Consensus{NextActionOwner: "the team", RequiredNextAction: "ship the canary by Friday"},
+			wantFound: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := findActionOwner(tc.consensus, testPersonas())
+			if found != tc.wantFound || got.ID != tc.wantID {
+				t.Fatalf("findActionOwner()=(%q,%v), want (%q,%v)", got.ID, found, tc.wantID, tc.wantFound)
+			}
+		})
+	}
+}
+
+type ownerlessActionLLM struct {
+	fakeLLM
+	moderatorOwnerRequests int
+	judgeOwnerRequests     int
+}
+
+func (r *ownerlessActionLLM) GenerateModerator(ctx context.Context, input GenerateModeratorInput) (GenerateModeratorOutput, error) {
+	if input.RequestActionOwner {
+		r.moderatorOwnerRequests++
+	}
+	return r.fakeLLM.GenerateModerator(ctx, input)
+}
+
+func (r *ownerlessActionLLM) JudgeConsensus(ctx context.Context, input JudgeConsensusInput) (JudgeConsensusOutput, error) {
+	if input.RequestActionOwner {
+		r.judgeOwnerRequests++
+	}
+	out, err := r.fakeLLM.JudgeConsensus(ctx, input)
+	out.Consensus.NextActionOwner = "the team"
+	out.Consensus.RequiredNextAction = "ship the canary by Friday"
+	return out, err
+}
+
+func TestRunFlagsMissingActionOwner(t *testing.T) {
+	llm := &ownerlessActionLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	orch := New(llm, Config{MaxTurns: 6, RequireActionOwner: true})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !result.Consensus.OwnerMissing {
+		t.Fatalf("expected owner_missing to be set, got %+v", result.Consensus)
+	}
+	if llm.moderatorOwnerRequests == 0 {
+		t.Fatal("expected moderator to be asked for an action owner")
+	}
+	if llm.judgeOwnerRequests == 0 {
+		t.Fatal("expected judge to be asked for an action owner")
+	}
+}
+
+func TestRunIgnoresMissingActionOwnerByDefault(t *testing.T) {
+	llm := &ownerlessActionLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	orch := New(llm, Config{MaxTurns: 6})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Consensus.OwnerMissing || llm.moderatorOwnerRequests != 0 || llm.judgeOwnerRequests != 0 {
+		t.Fatalf("expected no owner checks, got %+v", llm)
+	}
+}
@@ -0,0 +1,23 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/persona"
+)
+
+// missedConsideration reports whether content mentions none of the speaker's
+// required considerations. Matching is a case-insensitive substring check,
+// so the flag is a hint for the moderator rather than a verdict.
+func missedConsideration(speaker persona.Persona, content string) bool {
+	if len(speaker.RequiredConsiderations) == 0 {
+		return false
+	}
+	text := strings.ToLower(claimText(content))
+	for _, consideration := range speaker.RequiredConsiderations {
+		if term := strings.ToLower(strings.TrimSpace(consideration)); term != "" && strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestMissedConsideration(t *testing.T) {
+	speaker := persona.Persona{ID: "a", RequiredConsiderations: []string{"GDPR", "data residency"}}
+
+	if missedConsideration(speaker, "Ship the EU rollout once Data Residency is confirmed.") {
+		t.Fatal("expected a case-insensitive mention to count")
+	}
+	if !missedConsideration(speaker, "Ship the rollout next sprint.\nNEXT: gdpr") {
+		t.Fatal("expected directive lines not to count as addressing a consideration")
+	}
+	if missedConsideration(persona.Persona{ID: "b"}, "anything") {
+		t.Fatal("expected personas without considerations never to be flagged")
+	}
+}
+
+func TestRunFlagsTurnsThatOmitRequiredConsiderations(t *testing.T) {
+	personas := testPersonas()
+	personas[0].RequiredConsiderations = []string{"GDPR"}
+	personas[1].RequiredConsiderations = []string{"on-call load"}
+	llm := &fakeLLM{judgeAtTurn: 999, turnBySpeakerID: map[string]string{
+		"a": "Centralize the customer events store for faster analytics.",
+		"o": "Agreed, as long as on-call load stays flat.",
+	}}
+	orch := New(llm, Config{MaxTurns: 2})
+	result, err := orch.Run(context.Background(), "Should we centralize customer events?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	flagged := make(map[string]bool)
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona {
+			flagged[turn.SpeakerID] = turn.MissedConsideration
+		}
+	}
+	if !flagged["a"] {
+		t.Fatal("expected turn omitting GDPR to be flagged")
+	}
+	if flagged["o"] {
+		t.Fatal("expected turn naming its consideration not to be flagged")
+	}
+}
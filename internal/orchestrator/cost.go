@@ -0,0 +1,53 @@
+package orchestrator
+
+import "strings"
+
+// DefaultModelPriceKey prices models that have no entry of their own in a
+// CostModel.
+const DefaultModelPriceKey = "*"
+
+// ModelPrice is the USD price per 1,000 tokens for one model.
+type ModelPrice struct {
+	PromptPer1K     float64 `json:"prompt_per_1k"`
+	CompletionPer1K float64 `json:"completion_per_1k"`
+}
+
+// CostModel maps model names to their token prices. Lookups fall back to the
+// DefaultModelPriceKey entry; models with neither are counted as free.
+type CostModel map[string]ModelPrice
+
+// Estimate returns the estimated USD cost of usage billed to model.
+func (m CostModel) Estimate(model string, usage Usage) float64 {
+	price, ok := m[strings.TrimSpace(model)]
+	if !ok {
+		price, ok = m[DefaultModelPriceKey]
+	}
+	if !ok {
+		return 0
+	}
+	return float64(usage.PromptTokens)/1000*price.PromptPer1K +
+		float64(usage.CompletionTokens)/1000*price.CompletionPer1K
+}
+
+// recordUsage counts one model call and adds its usage and estimated cost
+// to metrics.
+func (o *Orchestrator) recordUsage(metrics *Metrics, model string, usage Usage) {
+	metrics.LLMCalls++
+	addUsage(metrics, usage)
+	metrics.EstimatedCostUSD += o.cfg.CostModel.Estimate(model, usage)
+}
+
+// budgetStatus reports the stop status once res has used up the token,
+// estimated cost or model call budget.
+func (o *Orchestrator) budgetStatus(res *Result) (string, bool) {
+	if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
+		return StatusTokenLimitReached, true
+	}
+	if reachedCostLimit(res.Metrics.EstimatedCostUSD, o.cfg.MaxEstimatedCostUSD) {
+		return StatusCostLimitReached, true
+	}
+	if reachedCallLimit(res.Metrics.LLMCalls, o.cfg.MaxLLMCalls) {
+		return StatusCallLimitReached, true
+	}
+	return "", false
+}
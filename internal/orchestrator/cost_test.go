@@ -0,0 +1,111 @@
+package orchestrator
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCostModelEstimateFallsBackToDefaultPrice(t *testing.T) {
+	model := CostModel{
+		"judge-model":        {PromptPer1K: 1, CompletionPer1K: 4},
+		DefaultModelPriceKey: {PromptPer1K: 0.5, CompletionPer1K: 1},
+	}
+	usage := Usage{PromptTokens: 2000, CompletionTokens: 500, TotalTokens: 2500}
+
+	if got := model.Estimate("judge-model", usage); math.Abs(got-4) > 1e-9 {
+		t.Fatalf("judge-model cost = %v, want 4", got)
+	}
+	if got := model.Estimate("other", usage); math.Abs(got-1.5) > 1e-9 {
+		t.Fatalf("fallback cost = %v, want 1.5", got)
+	}
+	if got := (CostModel{"judge-model": {PromptPer1K: 1}}).Estimate("other", usage); got != 0 {
+		t.Fatalf("unpriced model cost = %v, want 0", got)
+	}
+}
+
+func TestRunStopsOnEstimatedCostLimit(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{
+		MaxDuration:    time.Hour,
+		MaxTotalTokens: 100000,
+		// Opening speaker selection costs 0.003, persona turns 0.02 and
+		// moderator turns 0.009, so the second persona turn crosses it.
+		MaxEstimatedCostUSD: 0.045,
+		CostModel:           CostModel{DefaultModelPriceKey: {PromptPer1K: 1, CompletionPer1K: 2}},
+	})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusCostLimitReached {
+		t.Fatalf("unexpected status: %s", result.Status)
+	}
+	if math.Abs(result.Metrics.EstimatedCostUSD-0.052) > 1e-9 {
+		t.Fatalf("estimated cost = %v, want 0.052", result.Metrics.EstimatedCostUSD)
+	}
+	if llm.generateCalls != 2 || llm.finalCalls != 0 {
+		t.Fatalf("expected 2 persona calls and no final moderator call, got %d and %d", llm.generateCalls, llm.finalCalls)
+	}
+	if last := result.Turns[len(result.Turns)-1]; last.Type != TurnTypeModerator {
+		t.Fatalf("expected final turn to be moderator, got %s", last.Type)
+	}
+}
+
+func TestOpeningSpeakerSelectionIsPricedByItsModel(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, selectModel: "gpt-5"}
+	// Only the selector's model is priced, so its 1+1 tokens are the whole
+	// estimate: 1*1 + 1*2 per 1K tokens.
+	orch := New(llm, Config{MaxTurns: 2, CostModel: CostModel{"gpt-5": {PromptPer1K: 1000, CompletionPer1K: 2000}}})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.selectCalls != 1 {
+		t.Fatalf("expected one selector call, got %d", llm.selectCalls)
+	}
+	if math.Abs(result.Metrics.EstimatedCostUSD-3) > 1e-9 {
+		t.Fatalf("estimated cost = %v, want 3 from the selector call", result.Metrics.EstimatedCostUSD)
+	}
+}
+
+func TestRunStopsOnLLMCallLimit(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{MaxLLMCalls: 3, MaxDuration: time.Hour})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusCallLimitReached {
+		t.Fatalf("expected status=%s, got %s", StatusCallLimitReached, result.Status)
+	}
+	made := llm.selectCalls + llm.generateCalls + llm.moderatorCalls + llm.judgeCalls + llm.finalCalls
+	if made != 3 || result.Metrics.LLMCalls != 3 {
+		t.Fatalf("expected exactly 3 model calls, made=%d recorded=%d", made, result.Metrics.LLMCalls)
+	}
+	if llm.finalCalls != 0 {
+		t.Fatalf("expected the fallback wrap-up instead of a final moderator call, got %d", llm.finalCalls)
+	}
+	last := result.Turns[len(result.Turns)-1]
+	if last.Type != TurnTypeModerator || strings.TrimSpace(last.Content) == "" {
+		t.Fatalf("expected a fallback final moderator turn, got %+v", last)
+	}
+}
+
+func TestFinalWrapUpUsingLastAllowedCallKeepsStatus(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	// select + 2 persona turns + moderator + judge + final wrap-up = 6 calls.
+	orch := New(llm, Config{MaxTurns: 2, MaxLLMCalls: 6, ModeratorMode: ModeratorModeAlways})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Metrics.LLMCalls != 6 || llm.finalCalls != 1 {
+		t.Fatalf("expected 6 calls including the wrap-up, got %d (final=%d)", result.Metrics.LLMCalls, llm.finalCalls)
+	}
+	if result.Status != StatusMaxTurnsReached {
+		t.Fatalf("a wrap-up within the cap should not relabel the run, got %s", result.Status)
+	}
+}
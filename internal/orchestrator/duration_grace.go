@@ -0,0 +1,49 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// maxDurationGrace caps Config.DurationGrace so a misconfigured grace cannot
+// turn the duration limit into a soft suggestion.
+const maxDurationGrace = 5 * time.Minute
+
+func normalizeDurationGrace(grace time.Duration) time.Duration {
+	if grace < 0 {
+		return 0
+	}
+	if grace > maxDurationGrace {
+		return maxDurationGrace
+	}
+	return grace
+}
+
+// durationGraceStatus gives a debate stopped by MaxDuration one more judge
+// call, bounded by Config.DurationGrace, in case the turns since the last
+// verdict just reached consensus. It never adds a persona turn. turnNo is the
+// last persona turn; status passes through unless it is
+// StatusDurationReached, and only a confirmed consensus replaces it.
+func (o *Orchestrator) durationGraceStatus(ctx context.Context, started time.Time, res *Result, personas []persona.Persona, turnNo int, progress *judgeProgress, status string) string {
+	if status != StatusDurationReached || o.cfg.DurationGrace <= 0 {
+		return status
+	}
+	if turnNo <= 0 || progress.judgedTurnNo == turnNo {
+		return status
+	}
+	if _, stop := o.budgetStatus(res); stop {
+		return status
+	}
+
+	graceCtx, cancel := context.WithDeadline(ctx, started.Add(o.cfg.MaxDuration+o.cfg.DurationGrace))
+	stopReason := res.StopReason
+	graceStatus, _, err := o.evaluateConsensus(graceCtx, res, personas, turnNo, progress)
+	cancel()
+	if err != nil || graceStatus != StatusConsensusReached {
+		res.StopReason = stopReason
+		return status
+	}
+	return graceStatus
+}
@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// deadlineAtTurnLLM blocks the blockAt-th persona turn until its context
+// expires, so a run hits MaxDuration at a known point without sleeping.
+type deadlineAtTurnLLM struct {
+	fakeLLM
+	blockAt int
+	calls   int
+}
+
+func (f *deadlineAtTurnLLM) GenerateTurn(ctx context.Context, input GenerateTurnInput) (GenerateTurnOutput, error) {
+	f.calls++
+	if f.calls == f.blockAt {
+		<-ctx.Done()
+		return GenerateTurnOutput{}, ctx.Err()
+	}
+	return f.fakeLLM.GenerateTurn(ctx, input)
+}
+
+func runIntoDurationLimit(t *testing.T, grace time.Duration) (Result, *deadlineAtTurnLLM) {
+	t.Helper()
+	// Turns 1-2 are judged as consensus (first confirmation), turn 3 is not
+	// judged, and turn 4 runs into the deadline.
+	llm := &deadlineAtTurnLLM{fakeLLM: fakeLLM{judgeAtTurn: 2, openingSpeakerID: "a"}, blockAt: 4}
+	orch := New(llm, Config{
+		MaxTurns:           10,
+		ConsensusThreshold: 0.75,
+		MaxDuration:        50 * time.Millisecond,
+		DurationGrace:      grace,
+	})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	return result, llm
+}
+
+func TestDurationGraceStopsOnConsensusReachedWithinGrace(t *testing.T) {
+	result, llm := runIntoDurationLimit(t, time.Second)
+
+	if result.Status != StatusConsensusReached {
+		t.Fatalf("expected status %q, got %q", StatusConsensusReached, result.Status)
+	}
+	if llm.judgeCalls != 2 {
+		t.Fatalf("expected the grace to add exactly one judge call, got %d judge calls", llm.judgeCalls)
+	}
+	if llm.calls != 4 {
+		t.Fatalf("expected no persona turn during the grace, got %d turn calls", llm.calls)
+	}
+}
+
+func TestDurationGraceDisabledStopsOnDuration(t *testing.T) {
+	result, llm := runIntoDurationLimit(t, 0)
+
+	if result.Status != StatusDurationReached {
+		t.Fatalf("expected status %q, got %q", StatusDurationReached, result.Status)
+	}
+	if llm.judgeCalls != 1 {
+		t.Fatalf("expected no judge call past the limit, got %d judge calls", llm.judgeCalls)
+	}
+}
+
+func TestNormalizeConfigCapsDurationGrace(t *testing.T) {
+	if got := NormalizeConfig(Config{DurationGrace: time.Hour}).DurationGrace; got != maxDurationGrace {
+		t.Fatalf("expected grace capped at %s, got %s", maxDurationGrace, got)
+	}
+	if got := NormalizeConfig(Config{DurationGrace: -time.Second}).DurationGrace; got != 0 {
+		t.Fatalf("expected negative grace to disable it, got %s", got)
+	}
+}
@@ -0,0 +1,33 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// finalJudgePass re-judges the complete transcript when persona turns were
+// added after the last verdict, so a debate cut off by the turn cap reports
+// consensus for everything that was said. turnNo is the last persona turn.
+// Failures keep the earlier verdict; the final wrap-up still runs.
+func (o *Orchestrator) finalJudgePass(ctx context.Context, started time.Time, res *Result, personas []persona.Persona, turnNo int, progress *judgeProgress) {
+	if o.cfg.DisableFinalJudgePass || turnNo <= 0 || progress.judgedTurnNo == turnNo {
+		return
+	}
+	if _, stop := o.budgetStatus(res); stop {
+		return
+	}
+
+	stepCtx, cancel := o.callContext(ctx, started)
+	judgeOut, err := o.llm.JudgeConsensus(stepCtx, o.judgeInput(res, personas))
+	cancel()
+	if err != nil {
+		return
+	}
+	o.recordUsage(&res.Metrics, judgeOut.Consensus.Model, judgeOut.Usage)
+	res.Consensus = judgeOut.Consensus
+	res.Consensus.Threshold = o.consensusThresholdAt(turnNo)
+	o.markActionOwner(&res.Consensus, personas)
+	progress.judgedTurnNo = turnNo
+}
@@ -0,0 +1,46 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFinalJudgePassReflectsTurnsAfterLastVerdict(t *testing.T) {
+	// Two personas are judged every second turn, so the third and last turn
+	// under the hard cap would otherwise never be judged.
+	llm := &fakeLLM{judgeAtTurn: 999, judgeScoreBase: 0.2, judgeScoreStep: 0.5}
+	result, err := New(llm, Config{UnlimitedHardMaxTurns: 3}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusMaxTurnsReached {
+		t.Fatalf("unexpected status: %s", result.Status)
+	}
+	if llm.judgeCalls != 2 {
+		t.Fatalf("expected a final judge pass after the scheduled one, got %d calls", llm.judgeCalls)
+	}
+	if result.Consensus.Score != 0.7 {
+		t.Fatalf("expected the final verdict's late score, got %.2f", result.Consensus.Score)
+	}
+}
+
+func TestFinalJudgePassCanBeDisabled(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, judgeScoreBase: 0.2, judgeScoreStep: 0.5}
+	result, err := New(llm, Config{UnlimitedHardMaxTurns: 3, DisableFinalJudgePass: true}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.judgeCalls != 1 || result.Consensus.Score != 0.2 {
+		t.Fatalf("expected only the scheduled verdict, got calls=%d score=%.2f", llm.judgeCalls, result.Consensus.Score)
+	}
+}
+
+func TestFinalJudgePassSkippedWhenLastTurnWasJudged(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	if _, err := New(llm, Config{MaxTurns: 2}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.judgeCalls != 1 {
+		t.Fatalf("expected no extra judge call, got %d", llm.judgeCalls)
+	}
+}
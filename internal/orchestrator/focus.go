@@ -0,0 +1,62 @@
+package orchestrator
+
+import "debate/internal/persona"
+
+// defaultFocusBias routes every fallback handoff from another persona back to
+// the focus persona, so the focus persona speaks every other turn.
+const defaultFocusBias = 1.0
+
+// focusRouter biases fallback next-speaker selection toward one persona.
+// Explicit handoffs (NEXT lines, addressed names) bypass it entirely.
+type focusRouter struct {
+	index int
+	bias  float64
+	// credit accumulates bias per non-focus turn; a full unit sends the
+	// fallback back to the focus persona.
+	credit    float64
+	lastOther int
+}
+
+func newFocusRouter(personas []persona.Persona, focusID string, bias float64) *focusRouter {
+	index := findPersonaIndex(personas, focusID)
+	if index < 0 || len(personas) < 2 {
+		return nil
+	}
+	if bias <= 0 {
+		bias = defaultFocusBias
+	}
+	if bias > 1 {
+		bias = 1
+	}
+	return &focusRouter{index: index, bias: bias, lastOther: index}
+}
+
+// fallback returns the next speaker for a non-directed handoff. From the
+// focus persona it rotates through the others; from anyone else it returns
+// to the focus persona once enough bias has accumulated.
+func (r *focusRouter) fallback(current int, roundRobin int, count int) int {
+	if r == nil {
+		return roundRobin
+	}
+	if current == r.index {
+		next := (r.lastOther + 1) % count
+		if next == r.index {
+			next = (next + 1) % count
+		}
+		return next
+	}
+	r.lastOther = current
+	r.credit += r.bias
+	if r.credit >= 1 {
+		r.credit--
+		return r.index
+	}
+	return roundRobin
+}
+
+func (r *focusRouter) persona(personas []persona.Persona) persona.Persona {
+	if r == nil {
+		return persona.Persona{}
+	}
+	return personas[r.index]
+}
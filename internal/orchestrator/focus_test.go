@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func focusTestPersonas() []persona.Persona {
+	return []persona.Persona{
+		{ID: "a", Name: "Architect", Role: "architecture"},
+		{ID: "o", Name: "Operator", Role: "operations"},
+		{ID: "s", Name: "Security", Role: "security"},
+		{ID: "p", Name: "Product", Role: "product"},
+	}
+}
+
+func countPersonaTurnsBySpeaker(turns []Turn) map[string]int {
+	counts := make(map[string]int)
+	for _, turn := range turns {
+		if turn.Type == TurnTypePersona {
+			counts[turn.SpeakerID]++
+		}
+	}
+	return counts
+}
+
+func TestRunFocusPersonaGetsDisproportionateShare(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{
+		MaxTurns:            12,
+		ConsensusThreshold:  0.95,
+		MaxNoProgressJudges: 100,
+		NoProgressEpsilon:   0.0001,
+		FocusPersonaID:      "s",
+	})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", focusTestPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	counts := countPersonaTurnsBySpeaker(result.Turns)
+	if counts["s"] < 6 {
+		t.Fatalf("expected focus persona to speak at least every other turn, got %v", counts)
+	}
+	for _, id := range []string{"a", "o", "p"} {
+		if counts[id] == 0 {
+			t.Fatalf("expected %s to keep speaking, got %v", id, counts)
+		}
+		if counts[id] >= counts["s"] {
+			t.Fatalf("expected focus persona to out-speak %s, got %v", id, counts)
+		}
+	}
+}
+
+func TestRunFocusPersonaKeepsExplicitHandoff(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn: 999,
+		turnBySpeakerID: map[string]string{
+			"a": "설계 관점 정리\nNEXT: p",
+		},
+	}
+	orch := New(llm, Config{
+		MaxTurns:            2,
+		ConsensusThreshold:  0.95,
+		MaxNoProgressJudges: 100,
+		FocusPersonaID:      "s",
+	})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", focusTestPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	speakers := make([]string, 0, 2)
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona {
+			speakers = append(speakers, turn.SpeakerID)
+		}
+	}
+	if len(speakers) != 2 || speakers[0] != "a" || speakers[1] != "p" {
+		t.Fatalf("expected explicit NEXT handoff a -> p, got %v", speakers)
+	}
+}
+
+func TestFocusRouterPartialBiasAlternatesReturns(t *testing.T) {
+	router := newFocusRouter(focusTestPersonas(), "a", 0.5)
+	if got := router.fallback(1, 2, 4); got != 2 {
+		t.Fatalf("expected first half-credit to keep round robin, got %d", got)
+	}
+	if got := router.fallback(2, 3, 4); got != 0 {
+		t.Fatalf("expected accumulated credit to return to focus, got %d", got)
+	}
+	if got := router.fallback(0, 1, 4); got != 3 {
+		t.Fatalf("expected focus to hand to the next other after the last one, got %d", got)
+	}
+	if newFocusRouter(focusTestPersonas(), "missing", 1) != nil {
+		t.Fatal("expected unknown focus id to disable routing")
+	}
+}
@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"strings"
+	"time"
+)
+
+// drainInterjections turns every question waiting on Config.Interjections
+// into a moderator turn so the next persona answers it first. It never
+// blocks; a closed or nil channel is ignored.
+func (o *Orchestrator) drainInterjections(res *Result, onTurn func(Turn)) {
+	if o.cfg.Interjections == nil {
+		return
+	}
+	for {
+		select {
+		case question, ok := <-o.cfg.Interjections:
+			if !ok {
+				return
+			}
+			question = strings.TrimSpace(question)
+			if question == "" {
+				continue
+			}
+			turn := Turn{
+				Index:        nextTurnIndex(res.Turns),
+				SpeakerID:    ModeratorSpeakerID,
+				SpeakerName:  o.cfg.ModeratorName,
+				Type:         TurnTypeModerator,
+				Content:      question,
+				Timestamp:    time.Now().UTC(),
+				Injected:     true,
+				ModeratorAsk: question,
+			}
+			res.Turns = append(res.Turns, turn)
+			if onTurn != nil {
+				onTurn(turn)
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRunRecordsInterjectedQuestionBeforeNextPersonaTurn(t *testing.T) {
+	questions := make(chan string, 1)
+	orch := New(&fakeLLM{judgeAtTurn: 999}, Config{MaxTurns: 3, Interjections: questions})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), func(turn Turn) {
+		if turn.Index == 1 {
+			questions <- "  What would the rollback cost be?  "
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	for i, turn := range result.Turns {
+		if !turn.Injected {
+			continue
+		}
+		if turn.Type != TurnTypeModerator || turn.SpeakerID != ModeratorSpeakerID || turn.Content != "What would the rollback cost be?" {
+			t.Fatalf("unexpected injected turn: %+v", turn)
+		}
+		if i+1 >= len(result.Turns) || result.Turns[i+1].Type != TurnTypePersona {
+			t.Fatalf("expected a persona turn to answer the injected question, turns=%+v", result.Turns)
+		}
+		return
+	}
+	t.Fatalf("expected an injected moderator turn, got %+v", result.Turns)
+}
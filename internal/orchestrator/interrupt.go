@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/persona"
+)
+
+// interruptTracker counts interruptions per persona so that a trigger phrase
+// repeated every turn cannot keep handing the floor to the same persona.
+type interruptTracker struct {
+	used map[string]int
+}
+
+func newInterruptTracker() *interruptTracker {
+	return &interruptTracker{used: make(map[string]int)}
+}
+
+// claim returns the index of the first unmuted persona, other than speaker,
+// that can interrupt, has budget left, and has a trigger in content. The
+// returned persona's budget is charged.
+func (t *interruptTracker) claim(personas []persona.Persona, speaker persona.Persona, content string, mutes *muteTracker) (int, bool) {
+	lower := strings.ToLower(content)
+	for i, p := range personas {
+		if !p.CanInterrupt || strings.EqualFold(p.ID, speaker.ID) || mutes.isMuted(p) {
+			continue
+		}
+		key := strings.ToLower(p.ID)
+		if t.used[key] >= interruptBudget(p) {
+			continue
+		}
+		for _, trigger := range p.InterruptTriggers {
+			if trigger = strings.ToLower(strings.TrimSpace(trigger)); trigger != "" && strings.Contains(lower, trigger) {
+				t.used[key]++
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func interruptBudget(p persona.Persona) int {
+	if p.InterruptBudget <= 0 {
+		return 1
+	}
+	return p.InterruptBudget
+}
@@ -0,0 +1,58 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestInterruptTriggerRoutesNextTurnToInterrupter(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn:     999,
+		turnBySpeakerID: map[string]string{"a": "We can skip the backfill; some data loss is acceptable."},
+	}
+	personas := []persona.Persona{
+		{ID: "a", Name: "Architect", Role: "architecture"},
+		{ID: "o", Name: "Operator", Role: "operations"},
+		{ID: "s", Name: "Steward", Role: "data governance", CanInterrupt: true, InterruptTriggers: []string{"Data Loss"}},
+	}
+
+	result, err := New(llm, Config{MaxTurns: 8}).Run(context.Background(), "How do we migrate the ledger?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	var personaTurns []Turn
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona {
+			personaTurns = append(personaTurns, turn)
+		}
+	}
+	if len(personaTurns) < 3 || personaTurns[0].SpeakerID != "a" {
+		t.Fatalf("expected the architect to open, got %+v", personaTurns)
+	}
+	if next := personaTurns[1]; next.SpeakerID != "s" || !next.Interrupt {
+		t.Fatalf("expected the steward to interrupt right after the trigger, got %+v", next)
+	}
+	if got := extractExplicitNextSpeakerValue(personaTurns[0].Content); got != "s" {
+		t.Fatalf("expected the trigger turn to hand off to the interrupter, got NEXT %q in %q", got, personaTurns[0].Content)
+	}
+	if strings.Contains(personaTurns[0].Content, "NEXT: o") {
+		t.Fatalf("expected no NEXT line for the skipped handoff target, got %q", personaTurns[0].Content)
+	}
+	if result.Turns[1].Type != TurnTypePersona {
+		t.Fatalf("expected the interruption to skip the moderator, got %+v", result.Turns[1])
+	}
+
+	interrupts := 0
+	for _, turn := range personaTurns {
+		if turn.Interrupt {
+			interrupts++
+		}
+	}
+	if interrupts != 1 {
+		t.Fatalf("expected the default budget to allow one interruption, got %d", interrupts)
+	}
+}
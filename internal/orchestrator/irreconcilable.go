@@ -0,0 +1,73 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// irreconcilableScoreFloor is the judge score below which a round counts
+// toward deadlock detection.
+const irreconcilableScoreFloor = 0.40
+
+// deadlockTracker counts consecutive low-score judges whose tension pair
+// (the latest persona speaker and the most recent other persona) is unchanged.
+type deadlockTracker struct {
+	pair      [2]string
+	lowJudges int
+	lastScore float64
+}
+
+func (d *deadlockTracker) observe(score float64, turns []Turn) {
+	pair, ok := tensionPair(turns)
+	if !ok || score >= irreconcilableScoreFloor {
+		*d = deadlockTracker{}
+		return
+	}
+	if d.pair == pair {
+		d.lowJudges++
+	} else {
+		d.pair = pair
+		d.lowJudges = 1
+	}
+	d.lastScore = score
+}
+
+func (d *deadlockTracker) deadlocked(afterJudges int) bool {
+	return afterJudges > 0 && d.lowJudges >= afterJudges
+}
+
+func (d *deadlockTracker) reason() string {
+	return fmt.Sprintf(
+		"deadlock between %s and %s: consensus score stayed below %.2f for %d consecutive judges (last %.2f)",
+		d.pair[0], d.pair[1], irreconcilableScoreFloor, d.lowJudges, d.lastScore,
+	)
+}
+
+// tensionPair mirrors the moderator's tension candidate: the latest persona
+// speaker against the most recent different persona. IDs are sorted so the
+// pair compares equal regardless of who spoke last.
+func tensionPair(turns []Turn) ([2]string, bool) {
+	latest := ""
+	for i := len(turns) - 1; i >= 0; i-- {
+		t := turns[i]
+		if t.Type != TurnTypePersona {
+			continue
+		}
+		id := strings.TrimSpace(t.SpeakerID)
+		if id == "" {
+			continue
+		}
+		if latest == "" {
+			latest = id
+			continue
+		}
+		if id == latest {
+			continue
+		}
+		pair := []string{latest, id}
+		sort.Strings(pair)
+		return [2]string{pair[0], pair[1]}, true
+	}
+	return [2]string{}, false
+}
@@ -0,0 +1,62 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRunStopsEarlyWhenDeadlockedPairPersists(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, judgeScoreBase: 0.15}
+	orch := New(llm, Config{
+		MaxTurns:                  0,
+		ConsensusThreshold:        0.8,
+		MaxNoProgressJudges:       100,
+		NoProgressEpsilon:         0.0001,
+		IrreconcilableAfterJudges: 3,
+	})
+
+	result, err := orch.Run(context.Background(), "Ship now or harden first?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusIrreconcilable {
+		t.Fatalf("expected status=%s, got %s", StatusIrreconcilable, result.Status)
+	}
+	if llm.judgeCalls != 3 {
+		t.Fatalf("expected 3 judge calls before deadlock, got %d", llm.judgeCalls)
+	}
+	if !strings.Contains(result.StopReason, "deadlock between a and o") {
+		t.Fatalf("expected stop reason to name the pair, got %q", result.StopReason)
+	}
+	if llm.finalCalls != 1 {
+		t.Fatalf("expected final moderator turn, got %d", llm.finalCalls)
+	}
+}
+
+func TestDeadlockTrackerResetsWhenPairChangesOrScoreRises(t *testing.T) {
+	ao := []Turn{
+		{Type: TurnTypePersona, SpeakerID: "a"},
+		{Type: TurnTypeModerator, SpeakerID: ModeratorSpeakerID},
+		{Type: TurnTypePersona, SpeakerID: "o"},
+	}
+	ox := append(append([]Turn(nil), ao...), Turn{Type: TurnTypePersona, SpeakerID: "x"})
+
+	var tracker deadlockTracker
+	tracker.observe(0.1, ao)
+	tracker.observe(0.1, ao)
+	if !tracker.deadlocked(2) {
+		t.Fatalf("expected deadlock after two low judges, got %+v", tracker)
+	}
+	tracker.observe(0.1, ox)
+	if tracker.deadlocked(2) {
+		t.Fatalf("expected new pair to restart the count, got %+v", tracker)
+	}
+	tracker.observe(0.5, ox)
+	if tracker.lowJudges != 0 {
+		t.Fatalf("expected score above floor to reset, got %+v", tracker)
+	}
+	if tracker.deadlocked(0) {
+		t.Fatal("expected disabled check to never report deadlock")
+	}
+}
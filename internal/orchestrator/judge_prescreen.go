@@ -0,0 +1,165 @@
+package orchestrator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// agreementKeywords mark a persona turn as leaning toward agreement for
+// HeuristicConsensus. English keywords match whole words (multi-word entries
+// match consecutive words); Korean keywords match the start of a word so
+// particles and endings ("동의합니다", "합의로") still count.
+var agreementKeywords = []string{
+	"agree", "agreed", "agrees", "agreeing", "agreement", "consensus", "aligned",
+	"on board", "support this", "sounds good",
+	"동의", "합의", "찬성", "공감", "수용",
+}
+
+// disagreementKeywords make a turn non-agreeing outright, whatever else it
+// says. They are matched the same way as agreementKeywords.
+var disagreementKeywords = []string{
+	"disagree", "disagreed", "disagrees", "disagreeing", "disagreement",
+	"반대",
+}
+
+// negationWords cancel an English agreement keyword when they appear in the
+// two words before it ("no consensus", "don't really agree").
+var negationWords = map[string]bool{
+	"not": true, "no": true, "never": true, "without": true,
+	"don't": true, "dont": true, "doesn't": true, "didn't": true, "can't": true, "cannot": true,
+	"isn't": true, "aren't": true, "wasn't": true, "won't": true, "wouldn't": true,
+}
+
+// koreanNegationSuffixes cancel a Korean agreement keyword when they follow
+// it in the same word ("동의하지", "합의없이"); koreanNegationWords do so when
+// they start the next word ("합의가 없다", "동의 안 함").
+var (
+	koreanNegationSuffixes = []string{"하지", "되지", "못", "않", "없"}
+	koreanNegationWords    = []string{"못", "않", "없"}
+)
+
+// HeuristicConsensus is a cheap, deterministic consensus estimate in [0, 1]
+// that needs no model call. It looks at each speaker's latest persona turn:
+// a CLOSE: yes vote counts 0.6 and agreement wording counts 0.4, averaged
+// over speakers. Config.JudgePreScreenThreshold uses it to skip judge calls
+// while the panel is plainly still arguing.
+func HeuristicConsensus(turns []Turn) float64 {
+	latest := make(map[string]string)
+	var order []string
+	for _, turn := range turns {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(turn.SpeakerID))
+		if _, seen := latest[key]; !seen {
+			order = append(order, key)
+		}
+		latest[key] = turn.Content
+	}
+	if len(order) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, key := range order {
+		content := latest[key]
+		if vote, ok := CloseVote(content); ok && vote {
+			total += 0.6
+		}
+		if containsAgreementKeyword(content) {
+			total += 0.4
+		}
+	}
+	return total / float64(len(order))
+}
+
+func containsAgreementKeyword(content string) bool {
+	agreed := false
+	for _, line := range strings.Split(content, "\n") {
+		words := agreementWords(line)
+		for i := range words {
+			for _, keyword := range disagreementKeywords {
+				if matchKeywordAt(words, i, keyword) {
+					return false
+				}
+			}
+			for _, keyword := range agreementKeywords {
+				if matchKeywordAt(words, i, keyword) && !negatedKeywordAt(words, i, keyword) {
+					agreed = true
+				}
+			}
+		}
+	}
+	return agreed
+}
+
+func agreementWords(content string) []string {
+	lower := strings.ToLower(strings.ReplaceAll(content, "\u2019", "'"))
+	return strings.FieldsFunc(lower, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+}
+
+func matchKeywordAt(words []string, i int, keyword string) bool {
+	parts := strings.Fields(keyword)
+	if i+len(parts) > len(words) {
+		return false
+	}
+	if isHangulKeyword(keyword) {
+		return strings.HasPrefix(words[i], keyword)
+	}
+	for j, part := range parts {
+		if words[i+j] != part {
+			return false
+		}
+	}
+	return true
+}
+
+func negatedKeywordAt(words []string, i int, keyword string) bool {
+	if isHangulKeyword(keyword) {
+		rest := strings.TrimPrefix(words[i], keyword)
+		for _, negation := range koreanNegationSuffixes {
+			if strings.Contains(rest, negation) {
+				return true
+			}
+		}
+		if i+1 >= len(words) {
+			return false
+		}
+		next := words[i+1]
+		if next == "안" {
+			return true
+		}
+		for _, negation := range koreanNegationWords {
+			if strings.HasPrefix(next, negation) {
+				return true
+			}
+		}
+		return false
+	}
+	for j := i - 1; j >= 0 && j >= i-2; j-- {
+		if negationWords[words[j]] {
+			return true
+		}
+	}
+	return false
+}
+
+func isHangulKeyword(keyword string) bool {
+	for _, r := range keyword {
+		if unicode.Is(unicode.Hangul, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// passesJudgePreScreen reports whether a scheduled judge call should run.
+// It always does when JudgePreScreenThreshold is 0.
+func (o *Orchestrator) passesJudgePreScreen(turns []Turn) bool {
+	if o.cfg.JudgePreScreenThreshold <= 0 {
+		return true
+	}
+	return HeuristicConsensus(turns) >= o.cfg.JudgePreScreenThreshold
+}
@@ -0,0 +1,116 @@
+package orchestrator
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestHeuristicConsensusScoresLatestTurnPerSpeaker(t *testing.T) {
+	turns := []Turn{
+		{SpeakerID: "a", Type: TurnTypePersona, Content: "I agree with the plan.\nCLOSE: yes"},
+		{SpeakerID: "o", Type: TurnTypePersona, Content: "This will not scale.\nCLOSE: no"},
+		{SpeakerID: ModeratorSpeakerID, Type: TurnTypeModerator, Content: "We agree on everything.\nCLOSE: yes"},
+	}
+	if got := HeuristicConsensus(turns); math.Abs(got-0.5) > 1e-9 {
+		t.Fatalf("expected 0.5, got %v", got)
+	}
+
+	turns = append(turns, Turn{SpeakerID: "o", Type: TurnTypePersona, Content: "동의합니다. 합의로 가죠.\nCLOSE: yes"})
+	if got := HeuristicConsensus(turns); math.Abs(got-1) > 1e-9 {
+		t.Fatalf("expected the later turn to replace the earlier one, got %v", got)
+	}
+	if got := HeuristicConsensus(nil); got != 0 {
+		t.Fatalf("expected 0 for no turns, got %v", got)
+	}
+}
+
+func TestContainsAgreementKeywordIgnoresNegatedAgreement(t *testing.T) {
+	cases := map[string]bool{
+		"I agree with the plan.":                 true,
+		"Agreed, ship it.":                       true,
+		"We're on board with the rollout.":       true,
+		"동의합니다. 합의로 가죠.":                         true,
+		"I disagree.":                            false,
+		"We don't agree on the timeline.":        false,
+		"I do not really agree.":                 false,
+		"There is no consensus yet.":             false,
+		"The teams are not aligned.":             false,
+		"I agree on scope but disagree on cost.": false,
+		"그 안에 반대합니다.":                            false,
+		"동의하지 않습니다.":                             false,
+		"아직 합의가 없습니다.":                           false,
+		"Let's keep the agreeable tone.":         false,
+		"CLOSE: no\nAgreed, ship it.":            true,
+	}
+	for content, want := range cases {
+		if got := containsAgreementKeyword(content); got != want {
+			t.Errorf("containsAgreementKeyword(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestJudgePreScreenSkipsJudgeWhileHeuristicIsLow(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{MaxTurns: 6, JudgePreScreenThreshold: 0.5, DisableFinalJudgePass: true})
+	res, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.judgeCalls != 0 {
+		t.Fatalf("expected the pre-screen to skip every judge call, got %d", llm.judgeCalls)
+	}
+	if res.Status != StatusMaxTurnsReached {
+		t.Fatalf("expected max turns status, got %s", res.Status)
+	}
+
+	unscreened := &fakeLLM{judgeAtTurn: 999}
+	if _, err := New(unscreened, Config{MaxTurns: 6, DisableFinalJudgePass: true}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if unscreened.judgeCalls == 0 {
+		t.Fatal("expected judge calls when the pre-screen is disabled")
+	}
+}
+
+func TestJudgePreScreenCallsJudgeOnceHeuristicIsHigh(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn: 999,
+		turnBySpeakerID: map[string]string{
+			"a": "I agree with the rollout.\nCLOSE: yes",
+			"o": "Agreed, ship it.\nCLOSE: yes",
+		},
+	}
+	orch := New(llm, Config{MaxTurns: 6, JudgePreScreenThreshold: 0.9, DisableFinalJudgePass: true})
+	if _, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.judgeCalls == 0 {
+		t.Fatal("expected the judge to run once the heuristic reached the threshold")
+	}
+}
+
+func TestJudgePreScreenTreatsDisagreementAsNonAgreement(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn: 999,
+		turnBySpeakerID: map[string]string{
+			"a": "I disagree with the rollout.\nCLOSE: yes",
+			"o": "I disagree, this will not scale.\nCLOSE: yes",
+		},
+	}
+	turns := []Turn{
+		{SpeakerID: "a", Type: TurnTypePersona, Content: llm.turnBySpeakerID["a"]},
+		{SpeakerID: "o", Type: TurnTypePersona, Content: llm.turnBySpeakerID["o"]},
+	}
+	if got := HeuristicConsensus(turns); got >= 0.9 {
+		t.Fatalf("expected disagreement to keep the score below 0.9, got %v", got)
+	}
+
+	orch := New(llm, Config{MaxTurns: 6, JudgePreScreenThreshold: 0.9, DisableFinalJudgePass: true})
+	if _, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.judgeCalls != 0 {
+		t.Fatalf("expected the pre-screen to skip the judge while personas disagree, got %d calls", llm.judgeCalls)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"debate/internal/persona"
 )
 
 func fallbackSummary(turns []Turn) string {
@@ -19,6 +21,7 @@ func finalizeResult(res *Result, started time.Time, status string) {
 	res.Status = status
 	res.EndedAt = time.Now().UTC()
 	res.Metrics.LatencyMS = time.Since(started).Milliseconds()
+	res.StanceDrift = computeStanceDrift(res.Turns)
 }
 
 func ensureConsensusSummary(res *Result) {
@@ -29,11 +32,16 @@ func ensureConsensusSummary(res *Result) {
 
 func (o *Orchestrator) finalizeWithModerator(ctx context.Context, res *Result, started time.Time, status string, onTurn func(Turn)) (Result, error) {
 	ensureConsensusSummary(res)
+	res.OpenQuestions = collectOpenQuestions(res.Turns)
 	finalCtx, cancel := o.callContext(ctx, started)
 	finalTurn := o.appendFinalModeratorTurn(finalCtx, res, status)
 	cancel()
-	if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-		status = StatusTokenLimitReached
+	if budgetStatus, stop := o.budgetStatus(res); stop && budgetStatus != status && budgetStatus != StatusCallLimitReached {
+		// The wrap-up pushed the run over budget; the earlier reason no
+		// longer explains the final status. Using the last allowed call
+		// does not exceed MaxLLMCalls, so that cap never overrides here.
+		status = budgetStatus
+		res.StopReason = ""
 	}
 	if finalTurn != nil && onTurn != nil {
 		onTurn(*finalTurn)
@@ -48,40 +56,76 @@ func (o *Orchestrator) appendFinalModeratorTurn(ctx context.Context, res *Result
 	}
 
 	input := GenerateFinalModeratorInput{
-		Problem:      res.Problem,
-		Personas:     res.Personas,
-		Turns:        res.Turns,
-		Consensus:    res.Consensus,
-		FinalStatus:  status,
-		AudienceMode: o.cfg.AudienceMode,
+		Problem:          res.Problem,
+		Personas:         persona.Speakers(res.Personas),
+		Turns:            res.Turns,
+		Consensus:        res.Consensus,
+		FinalStatus:      status,
+		AudienceMode:     o.cfg.AudienceMode,
+		ResponseLanguage: o.cfg.ResponseLanguage,
+		OpenQuestions:    res.OpenQuestions,
 	}
 
 	content := ""
+	model := ""
 	// Respect hard stop reasons without making an additional LLM call.
-	if status != StatusTokenLimitReached && status != StatusDurationReached &&
-		!reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-		out, err := o.llm.GenerateFinalModerator(ctx, input)
+	_, overBudget := o.budgetStatus(res)
+	if status != StatusTokenLimitReached && status != StatusCostLimitReached && status != StatusCallLimitReached &&
+		status != StatusDurationReached && !overBudget {
+		stopWatch := o.watchSlowCall(ModeratorSpeakerID, o.cfg.ModeratorName)
+		out, err := o.generateFinalModerator(ctx, input)
+		stopWatch()
 		if err == nil {
-			addUsage(&res.Metrics, out.Usage)
+			o.recordUsage(&res.Metrics, out.Model, out.Usage)
+			recordSpeakerUsage(res, ModeratorSpeakerID, out.Usage)
 			content = strings.TrimSpace(out.Content)
+			model = strings.TrimSpace(out.Model)
 		}
 	}
 	if content == "" {
 		content = fallbackFinalModeratorContent(*res, status)
+		model = ""
 	}
 
 	finalTurn := Turn{
 		Index:       nextTurnIndex(res.Turns),
 		SpeakerID:   ModeratorSpeakerID,
-		SpeakerName: ModeratorSpeakerName,
+		SpeakerName: o.cfg.ModeratorName,
 		Type:        TurnTypeModerator,
 		Content:     content,
 		Timestamp:   time.Now().UTC(),
+		Model:       model,
 	}
 	res.Turns = append(res.Turns, finalTurn)
 	return &finalTurn
 }
 
+// generateFinalModerator streams the wrap-up when a delta callback is set and
+// the client supports it, and otherwise makes the plain call.
+func (o *Orchestrator) generateFinalModerator(ctx context.Context, input GenerateFinalModeratorInput) (GenerateFinalModeratorOutput, error) {
+	if o.cfg.OnFinalModeratorDelta != nil {
+		if streamer, ok := o.llm.(FinalModeratorStreamer); ok {
+			return streamer.StreamFinalModerator(ctx, input, o.cfg.OnFinalModeratorDelta)
+		}
+	}
+	return o.llm.GenerateFinalModerator(ctx, input)
+}
+
+// generateTurn streams a plain persona turn when a delta callback is set and
+// the client supports it, and otherwise makes the plain call. Structured
+// turns are JSON until parsed, so they are never streamed.
+func (o *Orchestrator) generateTurn(ctx context.Context, input GenerateTurnInput) (GenerateTurnOutput, error) {
+	if o.cfg.OnTurnDelta != nil && !input.Structured {
+		if streamer, ok := o.llm.(TurnStreamer); ok {
+			speakerID := input.Speaker.ID
+			return streamer.StreamTurn(ctx, input, func(delta string) {
+				o.cfg.OnTurnDelta(speakerID, delta)
+			})
+		}
+	}
+	return o.llm.GenerateTurn(ctx, input)
+}
+
 func nextTurnIndex(turns []Turn) int {
 	if len(turns) == 0 {
 		return 1
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// streamingFinalLLM streams the final wrap-up in two fragments.
+type streamingFinalLLM struct {
+	fakeLLM
+	streamCalls int
+}
+
+func (s *streamingFinalLLM) StreamFinalModerator(_ context.Context, _ GenerateFinalModeratorInput, onDelta func(string)) (GenerateFinalModeratorOutput, error) {
+	s.streamCalls++
+	onDelta("streamed ")
+	onDelta("wrap-up")
+	return GenerateFinalModeratorOutput{Content: "streamed wrap-up", Usage: Usage{TotalTokens: 8}}, nil
+}
+
+func TestFinalModeratorStreamsDeltasBeforeFinalTurn(t *testing.T) {
+	llm := &streamingFinalLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	var events []string
+	orch := New(llm, Config{
+		MaxTurns: 2,
+		OnFinalModeratorDelta: func(delta string) {
+			events = append(events, "delta:"+delta)
+		},
+	})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), func(turn Turn) {
+		events = append(events, "turn:"+turn.Content)
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.streamCalls != 1 || llm.finalCalls != 0 {
+		t.Fatalf("expected one streamed wrap-up and no plain call, got stream=%d plain=%d", llm.streamCalls, llm.finalCalls)
+	}
+	want := "delta:streamed ,delta:wrap-up,turn:streamed wrap-up"
+	if got := strings.Join(events[len(events)-3:], ","); got != want {
+		t.Fatalf("expected deltas before the final turn, got %v", events)
+	}
+	if last := result.Turns[len(result.Turns)-1]; last.Content != "streamed wrap-up" {
+		t.Fatalf("expected the streamed wrap-up as the final turn, got %+v", last)
+	}
+}
+
+func TestFinalModeratorWithoutDeltaCallbackUsesPlainCall(t *testing.T) {
+	llm := &streamingFinalLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	if _, err := New(llm, Config{MaxTurns: 2}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.streamCalls != 0 || llm.finalCalls != 1 {
+		t.Fatalf("expected the plain final call, got stream=%d plain=%d", llm.streamCalls, llm.finalCalls)
+	}
+}
+
+// streamingTurnLLM streams every plain persona turn in two fragments.
+type streamingTurnLLM struct {
+	fakeLLM
+	streamCalls int
+}
+
+func (s *streamingTurnLLM) StreamTurn(ctx context.Context, input GenerateTurnInput, onDelta func(string)) (GenerateTurnOutput, error) {
+	s.streamCalls++
+	out, err := s.fakeLLM.GenerateTurn(ctx, input)
+	if err != nil {
+		return out, err
+	}
+	half := len(out.Content) / 2
+	onDelta(out.Content[:half])
+	onDelta(out.Content[half:])
+	return out, nil
+}
+
+func TestPersonaTurnsStreamDeltasBeforeEachTurn(t *testing.T) {
+	llm := &streamingTurnLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	var events []string
+	orch := New(llm, Config{
+		MaxTurns: 2,
+		OnTurnDelta: func(speakerID string, delta string) {
+			events = append(events, "delta:"+speakerID)
+		},
+	})
+	_, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), func(turn Turn) {
+		if turn.Type == TurnTypePersona {
+			events = append(events, "turn:"+turn.SpeakerID)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.streamCalls != 2 {
+		t.Fatalf("expected both persona turns streamed, got %d", llm.streamCalls)
+	}
+	want := "delta:a,delta:a,turn:a,delta:o,delta:o,turn:o"
+	if got := strings.Join(events, ","); got != want {
+		t.Fatalf("expected deltas ahead of each persona turn, got %s", got)
+	}
+}
+
+func TestPersonaTurnsWithoutDeltaCallbackOrStructuredUsePlainCall(t *testing.T) {
+	llm := &streamingTurnLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+	if _, err := New(llm, Config{MaxTurns: 2}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	structured := New(llm, Config{MaxTurns: 2, StructuredTurns: true, OnTurnDelta: func(string, string) {}})
+	if _, err := structured.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.streamCalls != 0 {
+		t.Fatalf("expected no streamed turns, got %d", llm.streamCalls)
+	}
+}
@@ -48,3 +48,11 @@ func reachedDurationLimit(started time.Time, maxDuration time.Duration) bool {
 func reachedTokenLimit(totalTokens int, maxTotalTokens int) bool {
 	return maxTotalTokens > 0 && totalTokens >= maxTotalTokens
 }
+
+func reachedCallLimit(calls int, maxCalls int) bool {
+	return maxCalls > 0 && calls >= maxCalls
+}
+
+func reachedCostLimit(estimatedCostUSD float64, maxEstimatedCostUSD float64) bool {
+	return maxEstimatedCostUSD > 0 && estimatedCostUSD >= maxEstimatedCostUSD
+}
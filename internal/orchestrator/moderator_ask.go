@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/textsim"
+)
+
+// moderatorAskPrefix labels the targeted next-speaker question in the
+// moderator's required line format.
+const moderatorAskPrefix = "ASK:"
+
+// extractModeratorAsk returns the targeted next-speaker question of a
+// moderator turn: the value of its ASK: line when present, otherwise the last
+// question-like sentence. It returns "" when the turn asks nothing.
+func extractModeratorAsk(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•>"))
+		if len(line) < len(moderatorAskPrefix) || !strings.EqualFold(line[:len(moderatorAskPrefix)], moderatorAskPrefix) {
+			continue
+		}
+		if ask := strings.TrimSpace(line[len(moderatorAskPrefix):]); ask != "" {
+			return ask
+		}
+	}
+	questions := textsim.Questions(content)
+	if len(questions) == 0 {
+		return ""
+	}
+	return questions[len(questions)-1]
+}
@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExtractModeratorAsk(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "ask line",
+			content: "SYNTHESIS: both want safety.\nTENSION: speed vs risk [2]\nASK: Operator, which metric blocks the canary?\nDECISION_CHECK: choose Option A or B",
+			want:    "Operator, which metric blocks the canary?",
+		},
+		{
+			name:    "ask line is case insensitive",
+			content: "- ask: Architect, what breaks first?",
+			want:    "Architect, what breaks first?",
+		},
+		{
+			name:    "last question without ask line",
+			content: "Both sides agree on rollback. Why now? Operator, what would change your mind?",
+			want:    "Operator, what would change your mind?",
+		},
+		{
+			name:    "no question",
+			content: "Both sides agree on rollback.",
+			want:    "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := extractModeratorAsk(tc.content); got != tc.want {
+				t.Fatalf("extractModeratorAsk()=%q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+type askingModeratorLLM struct {
+	fakeLLM
+}
+
+func (r *askingModeratorLLM) GenerateModerator(ctx context.Context, input GenerateModeratorInput) (GenerateModeratorOutput, error) {
+	out, err := r.fakeLLM.GenerateModerator(ctx, input)
+	out.Content = "SYNTHESIS: progress.\nTENSION: cost vs speed [1]\nASK: " + input.NextSpeaker.Name + ", what is your rollback trigger?\nDECISION_CHECK: choose Option A or B"
+	return out, err
+}
+
+func TestRunStoresModeratorAsk(t *testing.T) {
+	orch := New(&askingModeratorLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}, Config{MaxTurns: 4})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	asks := 0
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona && turn.ModeratorAsk != "" {
+			t.Fatalf("persona turn should not carry an ask: %+v", turn)
+		}
+		if turn.Type == TurnTypeModerator && turn.ModeratorAsk != "" {
+			asks++
+			if turn.ModeratorAsk != "Architect, what is your rollback trigger?" && turn.ModeratorAsk != "Operator, what is your rollback trigger?" {
+				t.Fatalf("unexpected ask: %q", turn.ModeratorAsk)
+			}
+		}
+	}
+	if asks == 0 {
+		t.Fatalf("expected a moderator ask, turns=%+v", result.Turns)
+	}
+}
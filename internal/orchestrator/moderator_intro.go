@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// TurnPhaseIntro marks the moderator's opening introduction of the panel.
+const TurnPhaseIntro = "intro"
+
+// ModeratorIntroGenerator is optional. When implemented and
+// Config.ModeratorIntro is set, the moderator opens the debate by framing the
+// problem and introducing the panel before the first persona speaks.
+type ModeratorIntroGenerator interface {
+	GenerateModeratorIntro(ctx context.Context, input GenerateModeratorIntroInput) (GenerateModeratorOutput, error)
+}
+
+type GenerateModeratorIntroInput struct {
+	Problem string
+	// Personas are the speaking personas; Observers are introduced as
+	// non-speaking stakeholders.
+	Personas         []persona.Persona
+	Observers        []persona.Persona
+	OpeningSpeaker   persona.Persona
+	AudienceMode     string
+	ResponseLanguage string
+	SharedContext    string
+}
+
+// addModeratorIntro records the intro turn when Config.ModeratorIntro is on
+// and the client can write one. It returns a stop status when the intro ran
+// out the duration or token budget.
+func (o *Orchestrator) addModeratorIntro(ctx context.Context, started time.Time, res *Result, speakers []persona.Persona, opening persona.Persona, onTurn func(Turn)) (string, bool, error) {
+	if !o.cfg.ModeratorIntro {
+		return "", false, nil
+	}
+	generator, ok := o.llm.(ModeratorIntroGenerator)
+	if !ok {
+		return "", false, nil
+	}
+
+	stepCtx, cancel := o.callContext(ctx, started)
+	out, err := generator.GenerateModeratorIntro(stepCtx, GenerateModeratorIntroInput{
+		Problem:          res.Problem,
+		Personas:         speakers,
+		Observers:        persona.Observers(res.Personas),
+		OpeningSpeaker:   opening,
+		AudienceMode:     o.cfg.AudienceMode,
+		ResponseLanguage: o.cfg.ResponseLanguage,
+		SharedContext:    o.cfg.SharedContext,
+	})
+	cancel()
+	if err != nil {
+		if status, isDurationStop := o.durationStatusOnLLMError(started, err); isDurationStop {
+			return status, true, nil
+		}
+		return "", false, fmt.Errorf("generate moderator intro: %w", err)
+	}
+	o.recordUsage(&res.Metrics, out.Model, out.Usage)
+	recordSpeakerUsage(res, ModeratorSpeakerID, out.Usage)
+
+	content := strings.TrimSpace(out.Content)
+	if content == "" {
+		return "", false, fmt.Errorf("moderator intro was empty")
+	}
+	turn := Turn{
+		Index:       nextTurnIndex(res.Turns),
+		SpeakerID:   ModeratorSpeakerID,
+		SpeakerName: o.cfg.ModeratorName,
+		Type:        TurnTypeModerator,
+		Content:     content,
+		Timestamp:   time.Now().UTC(),
+		Model:       strings.TrimSpace(out.Model),
+		Phase:       TurnPhaseIntro,
+	}
+	res.Turns = append(res.Turns, turn)
+	if onTurn != nil {
+		onTurn(turn)
+	}
+	if status, stop := o.budgetStatus(res); stop {
+		return status, true, nil
+	}
+	return "", false, nil
+}
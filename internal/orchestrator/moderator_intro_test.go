@@ -0,0 +1,68 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+type introLLM struct {
+	fakeLLM
+	introCalls int
+	introInput GenerateModeratorIntroInput
+}
+
+func (f *introLLM) GenerateModeratorIntro(_ context.Context, input GenerateModeratorIntroInput) (GenerateModeratorOutput, error) {
+	f.introCalls++
+	f.introInput = input
+	return GenerateModeratorOutput{Content: "Welcome. Today's panel weighs incident reduction.", Usage: Usage{TotalTokens: 5}}, nil
+}
+
+func TestModeratorIntroPrecedesFirstPersonaTurn(t *testing.T) {
+	llm := &introLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+
+	result, err := New(llm, Config{MaxTurns: 2, ModeratorIntro: true}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.introCalls != 1 {
+		t.Fatalf("expected one intro call, got %d", llm.introCalls)
+	}
+	if len(result.Turns) < 2 {
+		t.Fatalf("expected intro and persona turns, got %+v", result.Turns)
+	}
+	intro := result.Turns[0]
+	if intro.Type != TurnTypeModerator || intro.Phase != TurnPhaseIntro || intro.Index != 1 {
+		t.Fatalf("expected the first turn to be the moderator intro, got %+v", intro)
+	}
+	if result.Turns[1].Type != TurnTypePersona {
+		t.Fatalf("expected a persona turn right after the intro, got %+v", result.Turns[1])
+	}
+	if llm.introInput.OpeningSpeaker.ID != result.Turns[1].SpeakerID || len(llm.introInput.Personas) != len(testPersonas()) {
+		t.Fatalf("expected the intro to see the panel and opening speaker, got %+v", llm.introInput)
+	}
+	for _, turn := range result.Turns[1:] {
+		if turn.Phase != "" {
+			t.Fatalf("expected only the intro to carry a phase, got %+v", turn)
+		}
+	}
+}
+
+func TestModeratorIntroOffByDefault(t *testing.T) {
+	llm := &introLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}}
+
+	result, err := New(llm, Config{MaxTurns: 2}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.introCalls != 0 {
+		t.Fatalf("expected no intro call, got %d", llm.introCalls)
+	}
+	for _, turn := range result.Turns {
+		if turn.Phase == TurnPhaseIntro {
+			t.Fatalf("expected no intro turn, got %+v", turn)
+		}
+	}
+	if result.Turns[0].Type != TurnTypePersona {
+		t.Fatalf("expected a persona to open, got %+v", result.Turns[0])
+	}
+}
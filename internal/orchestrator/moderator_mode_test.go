@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestModeratorModeNeverSkipsInterstitialModeratorTurns(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, openingSpeakerID: "a"}
+	orch := New(llm, Config{MaxTurns: 6, ConsensusThreshold: 0.75, ModeratorMode: ModeratorModeNever})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusMaxTurnsReached {
+		t.Fatalf("expected a full MaxTurns run, got status %q", result.Status)
+	}
+	if llm.moderatorCalls != 0 {
+		t.Fatalf("expected zero moderator calls in never mode, got %d", llm.moderatorCalls)
+	}
+	if llm.generateCalls != 6 {
+		t.Fatalf("expected 6 persona turns, got %d", llm.generateCalls)
+	}
+	wantSpeakers := []string{"a", "o", "a", "o", "a", "o"}
+	for i, want := range wantSpeakers {
+		turn := result.Turns[i]
+		if turn.Type != TurnTypePersona || turn.SpeakerID != want {
+			t.Fatalf("turn %d = %s/%s, want persona %s", i, turn.Type, turn.SpeakerID, want)
+		}
+		if i < len(wantSpeakers)-1 && !strings.Contains(turn.Content, "NEXT:") {
+			t.Fatalf("expected canonical NEXT line on turn %d, got %q", i, turn.Content)
+		}
+	}
+	if last := result.Turns[len(result.Turns)-1]; last.Type != TurnTypeModerator || llm.finalCalls != 1 {
+		t.Fatalf("expected the final wrap-up to still run, got %s with %d final calls", last.Type, llm.finalCalls)
+	}
+}
+
+func TestModeratorModeAlwaysModeratesDirectHandoffs(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Name: "A", Role: "architecture"},
+		{ID: "b", Name: "B", Role: "operations"},
+		{ID: "x", Name: "X", Role: "analytics"},
+	}
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "a",
+		turnBySpeakerID:  map[string]string{"a": "Analytics should weigh in.\nNEXT: x"},
+	}
+	orch := New(llm, Config{MaxTurns: 2, ConsensusThreshold: 0.75, ModeratorMode: ModeratorModeAlways})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.moderatorCalls != 1 {
+		t.Fatalf("expected a moderator turn despite the direct handoff, got %d calls", llm.moderatorCalls)
+	}
+	if result.Turns[1].Type != TurnTypeModerator || result.Turns[2].SpeakerID != "x" {
+		t.Fatalf("expected A -> moderator -> X, got %+v", result.Turns)
+	}
+}
+
+func TestNormalizeConfigDefaultsModeratorModeToAuto(t *testing.T) {
+	for _, mode := range []string{"", "sometimes"} {
+		if got := NormalizeConfig(Config{ModeratorMode: mode}).ModeratorMode; got != ModeratorModeAuto {
+			t.Fatalf("mode %q normalized to %q, want %q", mode, got, ModeratorModeAuto)
+		}
+	}
+	if got := NormalizeConfig(Config{ModeratorMode: " Never "}).ModeratorMode; got != ModeratorModeNever {
+		t.Fatalf("expected never, got %q", got)
+	}
+}
@@ -0,0 +1,118 @@
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// TurnPhaseControl marks moderator notes that announce a runtime control,
+// such as muting a persona.
+const TurnPhaseControl = "control"
+
+// minActiveSpeakers is the fewest unmuted personas a debate keeps.
+const minActiveSpeakers = 2
+
+// SpeakerControl mutes or unmutes a persona while a debate runs. Muted
+// personas keep their earlier turns in context but are skipped by speaker
+// selection until unmuted.
+type SpeakerControl struct {
+	PersonaID string
+	Mute      bool
+}
+
+type muteTracker struct {
+	muted map[string]bool
+}
+
+func newMuteTracker() *muteTracker {
+	return &muteTracker{muted: make(map[string]bool)}
+}
+
+func (t *muteTracker) isMuted(p persona.Persona) bool {
+	return t != nil && t.muted[normalizeMatchKey(p.ID)]
+}
+
+// apply records control and returns the moderator announcement. It returns
+// false for unknown personas, no-op changes, and mutes that would leave
+// fewer than minActiveSpeakers personas speaking.
+func (t *muteTracker) apply(personas []persona.Persona, control SpeakerControl) (string, bool) {
+	index := findPersonaIndex(personas, control.PersonaID)
+	if index < 0 {
+		return "", false
+	}
+	target := personas[index]
+	key := normalizeMatchKey(target.ID)
+	if t.muted[key] == control.Mute {
+		return "", false
+	}
+	if control.Mute {
+		if len(personas)-len(t.muted) <= minActiveSpeakers {
+			return "", false
+		}
+		t.muted[key] = true
+		return fmt.Sprintf("%s has been muted and will not take further turns.", persona.DisplayName(target)), true
+	}
+	delete(t.muted, key)
+	return fmt.Sprintf("%s has been unmuted and may speak again.", persona.DisplayName(target)), true
+}
+
+// skip returns index, or the first unmuted persona after it in roster order.
+// avoid (-1 for none) is passed over as well unless it is the only choice,
+// so skipping a muted persona does not hand the floor straight back.
+func (t *muteTracker) skip(personas []persona.Persona, index int, avoid int) int {
+	if !t.isMuted(personas[index]) {
+		return index
+	}
+	fallback := index
+	for i := 1; i < len(personas); i++ {
+		candidate := (index + i) % len(personas)
+		if t.isMuted(personas[candidate]) {
+			continue
+		}
+		if candidate != avoid {
+			return candidate
+		}
+		fallback = candidate
+	}
+	return fallback
+}
+
+// drainSpeakerControls applies every control waiting on
+// Config.SpeakerControls and announces each change as a moderator note. It
+// never blocks; a closed or nil channel is ignored.
+func (o *Orchestrator) drainSpeakerControls(res *Result, personas []persona.Persona, mutes *muteTracker, onTurn func(Turn)) {
+	if o.cfg.SpeakerControls == nil {
+		return
+	}
+	for {
+		select {
+		case control, ok := <-o.cfg.SpeakerControls:
+			if !ok {
+				return
+			}
+			control.PersonaID = strings.TrimSpace(control.PersonaID)
+			note, changed := mutes.apply(personas, control)
+			if !changed {
+				continue
+			}
+			turn := Turn{
+				Index:       nextTurnIndex(res.Turns),
+				SpeakerID:   ModeratorSpeakerID,
+				SpeakerName: o.cfg.ModeratorName,
+				Type:        TurnTypeModerator,
+				Content:     note,
+				Timestamp:   time.Now().UTC(),
+				Phase:       TurnPhaseControl,
+			}
+			res.Turns = append(res.Turns, turn)
+			if onTurn != nil {
+				onTurn(turn)
+			}
+		default:
+			return
+		}
+	}
+}
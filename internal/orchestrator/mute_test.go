@@ -0,0 +1,79 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestMutedPersonaGetsNoFurtherTurns(t *testing.T) {
+	personas := append(testPersonas(), persona.Persona{ID: "s", Name: "Security", Role: "security"})
+	controls := make(chan SpeakerControl, 2)
+	orch := New(&fakeLLM{judgeAtTurn: 999}, Config{MaxTurns: 8, SpeakerControls: controls})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", personas, func(turn Turn) {
+		if turn.Index == 1 {
+			controls <- SpeakerControl{PersonaID: " S ", Mute: true}
+			// Muting a second persona would leave one speaker; it is ignored.
+			controls <- SpeakerControl{PersonaID: "o", Mute: true}
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	mutedAt := -1
+	notes := 0
+	for i, turn := range result.Turns {
+		if turn.Phase == TurnPhaseControl {
+			notes++
+			if turn.Type != TurnTypeModerator || turn.Content != "Security has been muted and will not take further turns." {
+				t.Fatalf("unexpected control note: %+v", turn)
+			}
+			mutedAt = i
+		}
+	}
+	if mutedAt < 0 || notes != 1 {
+		t.Fatalf("expected exactly one mute announcement, turns=%+v", result.Turns)
+	}
+
+	spoken := map[string]int{}
+	prev := ""
+	for _, turn := range result.Turns[mutedAt+1:] {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		if turn.SpeakerID == "s" {
+			t.Fatalf("expected the muted persona to stay silent, got %+v", turn)
+		}
+		if turn.SpeakerID == prev {
+			t.Fatalf("expected speakers to alternate after the mute, turns=%+v", result.Turns)
+		}
+		prev = turn.SpeakerID
+		spoken[turn.SpeakerID]++
+	}
+	if spoken["a"] == 0 || spoken["o"] == 0 {
+		t.Fatalf("expected the remaining personas to keep debating, got %v", spoken)
+	}
+}
+
+func TestUnmutedPersonaRejoinsRotation(t *testing.T) {
+	mutes := newMuteTracker()
+	personas := append(testPersonas(), persona.Persona{ID: "s", Name: "Security", Role: "security"})
+
+	if _, ok := mutes.apply(personas, SpeakerControl{PersonaID: "s", Mute: true}); !ok {
+		t.Fatal("expected mute to apply")
+	}
+	if got := mutes.skip(personas, 2, 1); got != 0 {
+		t.Fatalf("expected the muted persona to be skipped, got %d", got)
+	}
+	if _, ok := mutes.apply(personas, SpeakerControl{PersonaID: "unknown", Mute: true}); ok {
+		t.Fatal("expected unknown personas to be ignored")
+	}
+	if note, ok := mutes.apply(personas, SpeakerControl{PersonaID: "s"}); !ok || note != "Security has been unmuted and may speak again." {
+		t.Fatalf("expected unmute to apply, got %q %v", note, ok)
+	}
+	if got := mutes.skip(personas, 2, 1); got != 2 {
+		t.Fatalf("expected the unmuted persona back in rotation, got %d", got)
+	}
+}
@@ -0,0 +1,37 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/textsim"
+)
+
+const (
+	// nearDuplicateThreshold is the claim similarity above which a turn is
+	// treated as a restatement of the speaker's earlier turn.
+	nearDuplicateThreshold = 0.9
+	// nearDuplicateLookback is how many of the speaker's previous persona
+	// turns are compared.
+	nearDuplicateLookback = 3
+)
+
+// isNearDuplicate reports whether content restates one of the speaker's
+// recent persona turns. Directive lines are ignored on both sides.
+func isNearDuplicate(turns []Turn, speakerID string, content string) bool {
+	claim := claimText(content)
+	if strings.TrimSpace(claim) == "" {
+		return false
+	}
+	checked := 0
+	for i := len(turns) - 1; i >= 0 && checked < nearDuplicateLookback; i-- {
+		turn := turns[i]
+		if turn.Type != TurnTypePersona || !strings.EqualFold(turn.SpeakerID, speakerID) {
+			continue
+		}
+		checked++
+		if textsim.Similarity(claim, claimText(turn.Content)) > nearDuplicateThreshold {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIsNearDuplicate(t *testing.T) {
+	turns := []Turn{
+		{Index: 1, SpeakerID: "a", Type: TurnTypePersona, Content: "Keep the monolith and harden deploys before any migration.\nNEXT: b"},
+		{Index: 2, SpeakerID: "b", Type: TurnTypePersona, Content: "Migrate billing first to cut incident blast radius."},
+		{Index: 3, SpeakerID: ModeratorSpeakerID, Type: TurnTypeModerator, Content: "Keep the monolith and harden deploys before any migration."},
+	}
+
+	if !isNearDuplicate(turns, "a", "keep the monolith, and harden deploys before any migration!\nCLOSE: no") {
+		t.Fatal("expected restated claim to be a near duplicate")
+	}
+	if isNearDuplicate(turns, "a", "Given rollback data, pausing until observability gaps close is safer.") {
+		t.Fatal("expected new claim not to be a near duplicate")
+	}
+	if isNearDuplicate(turns, "b", "Keep the monolith and harden deploys before any migration.") {
+		t.Fatal("expected other speakers' turns to be ignored")
+	}
+}
+
+func TestRunFlagsNearDuplicateTurns(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, turnBySpeakerID: map[string]string{
+		"a": "Keep the monolith and harden deploys before any migration.",
+		"o": "Migrate billing first to cut incident blast radius.",
+	}}
+	orch := New(llm, Config{MaxTurns: 4})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, turn := range result.Turns {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		if turn.NearDuplicate != seen[turn.SpeakerID] {
+			t.Fatalf("turn %d near_duplicate=%v, want %v", turn.Index, turn.NearDuplicate, seen[turn.SpeakerID])
+		}
+		seen[turn.SpeakerID] = true
+	}
+}
@@ -21,6 +21,12 @@ func selectNextSpeakerIndex(personas []persona.Persona, currentSpeaker persona.P
 }
 
 func selectNextSpeaker(personas []persona.Persona, currentSpeaker persona.Persona, content string, fallbackIndex int) (int, bool) {
+	return selectNextSpeakerWithPolicy(personas, currentSpeaker, content, fallbackIndex, AmbiguousHandoffFallback)
+}
+
+// selectNextSpeakerWithPolicy is selectNextSpeaker with an explicit
+// AmbiguousHandoff* policy for closing lines that mention several personas.
+func selectNextSpeakerWithPolicy(personas []persona.Persona, currentSpeaker persona.Persona, content string, fallbackIndex int, policy string) (int, bool) {
 	if len(personas) == 0 {
 		return -1, false
 	}
@@ -29,8 +35,8 @@ func selectNextSpeaker(personas []persona.Persona, currentSpeaker persona.Person
 	}
 
 	currentSpeakerKey := normalizeMatchKey(currentSpeaker.ID)
-	if explicitID := extractExplicitNextSpeakerID(content); explicitID != "" {
-		if idx := findPersonaIndex(personas, explicitID); idx >= 0 {
+	if explicit := extractExplicitNextSpeakerValue(content); explicit != "" {
+		if idx := resolveExplicitNextSpeaker(personas, explicit); idx >= 0 {
 			if currentSpeakerKey == "" || normalizeMatchKey(personas[idx].ID) != currentSpeakerKey {
 				return idx, true
 			}
@@ -39,23 +45,103 @@ func selectNextSpeaker(personas []persona.Persona, currentSpeaker persona.Person
 
 	segments := handoffCandidateSegments(content)
 	for _, segment := range segments {
-		if idx := matchSinglePersonaIndex(personas, currentSpeakerKey, segment); idx >= 0 {
+		if idx := matchHandoffPersonaIndex(personas, currentSpeakerKey, segment, policy); idx >= 0 {
 			return idx, true
 		}
 	}
 	return fallbackIndex, false
 }
 
-func appendCanonicalNextSpeakerLine(content string, nextSpeaker persona.Persona) string {
+// weightedRotation replaces plain round-robin for fallback handoffs when
+// persona weights differ. schedule spreads each persona over one cycle in
+// proportion to its weight (smooth weighted round-robin), and pos is the
+// slot of the last fallback pick. Explicit handoffs never move the cursor.
+type weightedRotation struct {
+	schedule []int
+	pos      int
+}
+
+// newWeightedRotation returns nil when every persona has the default weight,
+// leaving the plain round-robin order untouched.
+func newWeightedRotation(personas []persona.Persona) *weightedRotation {
+	weights := make([]int, len(personas))
+	total := 0
+	uniform := true
+	for i, p := range personas {
+		weights[i] = 1
+		if p.Weight > 1 {
+			// Personas that skipped NormalizeAndValidate are clamped here so
+			// the schedule size stays bounded.
+			weights[i] = min(p.Weight, persona.MaxWeight)
+		}
+		total += weights[i]
+		if weights[i] != 1 {
+			uniform = false
+		}
+	}
+	if uniform || len(personas) < 2 {
+		return nil
+	}
+
+	schedule := make([]int, 0, total)
+	credit := make([]int, len(personas))
+	for n := 0; n < total; n++ {
+		best := 0
+		for i := range credit {
+			credit[i] += weights[i]
+			if credit[i] > credit[best] {
+				best = i
+			}
+		}
+		credit[best] -= total
+		schedule = append(schedule, best)
+	}
+	return &weightedRotation{schedule: schedule, pos: len(schedule) - 1}
+}
+
+// next returns the fallback speaker after current: the next scheduled slot
+// that is not current itself, and that slot for advance. A nil rotation
+// returns roundRobin.
+func (w *weightedRotation) next(current int, roundRobin int) (int, int) {
+	if w == nil {
+		return roundRobin, -1
+	}
+	for step := 1; step <= len(w.schedule); step++ {
+		slot := (w.pos + step) % len(w.schedule)
+		if w.schedule[slot] != current {
+			return w.schedule[slot], slot
+		}
+	}
+	return roundRobin, -1
+}
+
+// advance moves the cursor to slot once its pick was actually used.
+func (w *weightedRotation) advance(slot int) {
+	if w == nil || slot < 0 {
+		return
+	}
+	w.pos = slot
+}
+
+// appendCanonicalNextSpeakerLine only writes a NEXT line for a persona that is
+// part of the roster, so a bad upstream index never leaves a dangling handoff.
+// A NEXT line naming the same persona by name is rewritten to the ID form.
+func appendCanonicalNextSpeakerLine(content string, personas []persona.Persona, nextSpeaker persona.Persona) string {
 	nextID := strings.TrimSpace(nextSpeaker.ID)
-	if nextID == "" {
+	nextIndex := findPersonaIndex(personas, nextID)
+	if nextID == "" || nextIndex < 0 {
 		return strings.TrimSpace(content)
 	}
 
 	base := strings.TrimSpace(content)
 	line := canonicalNextSpeakerPrefix + " " + nextID
-	if existingID := extractExplicitNextSpeakerID(base); existingID != "" && strings.EqualFold(existingID, nextID) {
-		return base
+	if existing := extractExplicitNextSpeakerValue(base); existing != "" {
+		if strings.EqualFold(explicitNextSpeakerToken(existing), nextID) {
+			return base
+		}
+		if resolveExplicitNextSpeaker(personas, existing) == nextIndex {
+			return replaceExplicitNextSpeakerLine(base, line)
+		}
 	}
 	if base == "" {
 		return line
@@ -63,7 +149,32 @@ func appendCanonicalNextSpeakerLine(content string, nextSpeaker persona.Persona)
 	return base + "\n" + line
 }
 
+// replaceExplicitNextSpeakerLine swaps the NEXT line found by
+// extractExplicitNextSpeakerValue for line.
+func replaceExplicitNextSpeakerLine(content string, line string) string {
+	lines := strings.Split(content, "\n")
+	checked := 0
+	for i := len(lines) - 1; i >= 0 && checked < 3; i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" {
+			continue
+		}
+		checked++
+		if parseExplicitNextSpeakerValue(trimmed) != "" {
+			lines[i] = line
+			return strings.Join(lines, "\n")
+		}
+	}
+	return content
+}
+
 func extractExplicitNextSpeakerID(content string) string {
+	return explicitNextSpeakerToken(extractExplicitNextSpeakerValue(content))
+}
+
+// extractExplicitNextSpeakerValue returns the full value of the NEXT line
+// among the last three non-empty lines, which may be an ID or a name.
+func extractExplicitNextSpeakerValue(content string) string {
 	lines := strings.Split(strings.TrimSpace(content), "\n")
 	checked := 0
 	for i := len(lines) - 1; i >= 0 && checked < 3; i-- {
@@ -72,14 +183,14 @@ func extractExplicitNextSpeakerID(content string) string {
 			continue
 		}
 		checked++
-		if id := parseExplicitNextSpeakerLine(line); id != "" {
-			return id
+		if value := parseExplicitNextSpeakerValue(line); value != "" {
+			return value
 		}
 	}
 	return ""
 }
 
-func parseExplicitNextSpeakerLine(line string) string {
+func parseExplicitNextSpeakerValue(line string) string {
 	prefixes := []string{
 		"NEXT:",
 		"NEXT=",
@@ -98,17 +209,65 @@ func parseExplicitNextSpeakerLine(line string) string {
 		if ok {
 			rest = strings.TrimSpace(rest)
 		}
-		if rest == "" {
-			return ""
-		}
-		token := strings.Fields(rest)[0]
-		token = strings.TrimPrefix(token, "@")
-		token = strings.Trim(token, "\"'`.,;:!?)]}>")
-		return strings.TrimSpace(token)
+		rest = strings.TrimPrefix(rest, "@")
+		return strings.TrimSpace(strings.Trim(rest, "\"'`.,;:!?)]}>"))
 	}
 	return ""
 }
 
+// explicitNextSpeakerToken is the first word of a NEXT value, the form an ID
+// takes.
+func explicitNextSpeakerToken(value string) string {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return ""
+	}
+	token := strings.TrimPrefix(fields[0], "@")
+	return strings.TrimSpace(strings.Trim(token, "\"'`.,;:!?)]}>"))
+}
+
+// resolveExplicitNextSpeaker maps a NEXT value to a persona index, so
+// "NEXT: Growth Lead" still hands off to growth_lead. Matches are tried from
+// most to least specific: the whole value as an ID, the whole value as a name
+// or display name, then the longest ID or name the value starts with
+// ("pm (owner of pricing)", "Growth Lead, please"); an ID wins ties.
+func resolveExplicitNextSpeaker(personas []persona.Persona, value string) int {
+	key := normalizeMatchKey(value)
+	if key == "" {
+		return -1
+	}
+	if idx := findPersonaIndex(personas, key); idx >= 0 {
+		return idx
+	}
+	for i, p := range personas {
+		for _, alias := range personaMentionAliases(p) {
+			if normalizeMatchKey(alias) == key {
+				return i
+			}
+		}
+	}
+	// A leading ID competes with name prefixes by length, so "Growth Lead,
+	// please" picks the Growth Lead persona over one with ID "growth".
+	token := explicitNextSpeakerToken(value)
+	best, bestLen := findPersonaIndex(personas, token), 0
+	if best >= 0 {
+		bestLen = len(normalizeMatchKey(token))
+	}
+	for i, p := range personas {
+		for _, alias := range personaMentionAliases(p) {
+			aliasKey := normalizeMatchKey(alias)
+			if len(aliasKey) <= bestLen || !strings.HasPrefix(key, aliasKey) {
+				continue
+			}
+			if r, _ := utf8.DecodeRuneInString(key[len(aliasKey):]); isWordRune(r) {
+				continue
+			}
+			best, bestLen = i, len(aliasKey)
+		}
+	}
+	return best
+}
+
 func trimPrefixFold(text string, prefix string) (string, bool) {
 	if len(text) < len(prefix) {
 		return text, false
@@ -135,30 +294,56 @@ func handoffCandidateSegments(content string) []string {
 	return out
 }
 
-func matchSinglePersonaIndex(personas []persona.Persona, currentSpeakerKey string, text string) int {
+// matchHandoffPersonaIndex returns the persona text hands off to, skipping
+// the current speaker. When several personas are mentioned the fallback
+// policy returns -1; first_mentioned picks the earliest mention and priority
+// the highest HandoffPriority, breaking ties by earliest mention.
+func matchHandoffPersonaIndex(personas []persona.Persona, currentSpeakerKey string, text string, policy string) int {
 	matchIndex := -1
+	matchPos := -1
 	for i, p := range personas {
 		if currentSpeakerKey != "" && normalizeMatchKey(p.ID) == currentSpeakerKey {
 			continue
 		}
-		if !mentionsPersona(text, p) {
+		pos := personaMentionIndex(text, p)
+		if pos < 0 {
+			continue
+		}
+		if matchIndex < 0 {
+			matchIndex, matchPos = i, pos
 			continue
 		}
-		if matchIndex >= 0 {
+		switch policy {
+		case AmbiguousHandoffFirstMentioned:
+			if pos < matchPos {
+				matchIndex, matchPos = i, pos
+			}
+		case AmbiguousHandoffPriority:
+			best := personas[matchIndex].HandoffPriority
+			if p.HandoffPriority > best || (p.HandoffPriority == best && pos < matchPos) {
+				matchIndex, matchPos = i, pos
+			}
+		default:
 			return -1
 		}
-		matchIndex = i
 	}
 	return matchIndex
 }
 
 func mentionsPersona(text string, p persona.Persona) bool {
+	return personaMentionIndex(text, p) >= 0
+}
+
+// personaMentionIndex is the byte offset of the earliest mention of p in the
+// lowercased text, or -1.
+func personaMentionIndex(text string, p persona.Persona) int {
+	first := -1
 	for _, alias := range personaMentionAliases(p) {
-		if mentionsAlias(text, alias) {
-			return true
+		if idx := aliasMentionIndex(text, alias); idx >= 0 && (first < 0 || idx < first) {
+			first = idx
 		}
 	}
-	return false
+	return first
 }
 
 func personaMentionAliases(p persona.Persona) []string {
@@ -185,36 +370,41 @@ func personaMentionAliases(p persona.Persona) []string {
 }
 
 func mentionsAlias(text string, alias string) bool {
+	return aliasMentionIndex(text, alias) >= 0
+}
+
+// aliasMentionIndex is the byte offset of the earliest mention of alias in
+// the lowercased, trimmed text, or -1.
+func aliasMentionIndex(text string, alias string) int {
 	normalizedText := strings.ToLower(strings.TrimSpace(text))
 	normalizedAlias := strings.ToLower(strings.TrimSpace(alias))
 	if normalizedText == "" || normalizedAlias == "" {
-		return false
+		return -1
 	}
-	if strings.Contains(normalizedText, "@"+normalizedAlias) {
-		return true
+	first := -1
+	consider := func(idx int) {
+		if idx >= 0 && (first < 0 || idx < first) {
+			first = idx
+		}
 	}
+	consider(strings.Index(normalizedText, "@"+normalizedAlias))
 	for _, suffix := range koreanAddressingSuffixes {
-		if strings.Contains(normalizedText, normalizedAlias+suffix) {
-			return true
-		}
+		consider(strings.Index(normalizedText, normalizedAlias+suffix))
 	}
 	// One- or two-character aliases (for example "a", "x") are too ambiguous in free text.
 	// Require explicit markers (@alias or Korean addressing suffix) for these short aliases.
-	if utf8.RuneCountInString(normalizedAlias) <= 2 {
-		return false
-	}
-	if containsWithBoundary(normalizedText, normalizedAlias) {
-		return true
+	if utf8.RuneCountInString(normalizedAlias) > 2 {
+		consider(indexWithBoundary(normalizedText, normalizedAlias))
 	}
-	return false
+	return first
 }
 
-func containsWithBoundary(text string, alias string) bool {
+func indexWithBoundary(text string, alias string) int {
 	start := 0
 	for {
 		offset := strings.Index(text[start:], alias)
 		if offset < 0 {
-			return false
+			return -1
 		}
 		idx := start + offset
 		end := idx + len(alias)
@@ -232,11 +422,11 @@ func containsWithBoundary(text string, alias string) bool {
 		}
 
 		if beforeOK && afterOK {
-			return true
+			return idx
 		}
 		start = end
 		if start >= len(text) {
-			return false
+			return -1
 		}
 	}
 }
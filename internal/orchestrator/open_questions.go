@@ -0,0 +1,66 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/textsim"
+)
+
+const (
+	// questionAnsweredCoverage is the share of a question's tokens a later
+	// persona turn by someone else must repeat to count as addressing it.
+	questionAnsweredCoverage = 0.5
+	// maxOpenQuestions keeps the most recent unanswered questions.
+	maxOpenQuestions = 8
+)
+
+type raisedQuestion struct {
+	text  string
+	asker string
+}
+
+// collectOpenQuestions returns questions raised in turns that no later
+// persona turn by a different speaker addressed, oldest first. Near-identical
+// repeats are kept once.
+func collectOpenQuestions(turns []Turn) []string {
+	var open []raisedQuestion
+	for _, turn := range turns {
+		content := claimText(turn.Content)
+		speaker := strings.ToLower(strings.TrimSpace(turn.SpeakerID))
+		if turn.Type == TurnTypePersona {
+			kept := open[:0]
+			for _, q := range open {
+				if q.asker != speaker && textsim.Coverage(q.text, content) >= questionAnsweredCoverage {
+					continue
+				}
+				kept = append(kept, q)
+			}
+			open = kept
+		}
+		for _, question := range textsim.Questions(content) {
+			if !containsSimilarQuestion(open, question) {
+				open = append(open, raisedQuestion{text: question, asker: speaker})
+			}
+		}
+	}
+	if len(open) > maxOpenQuestions {
+		open = open[len(open)-maxOpenQuestions:]
+	}
+	if len(open) == 0 {
+		return nil
+	}
+	out := make([]string, len(open))
+	for i, q := range open {
+		out[i] = q.text
+	}
+	return out
+}
+
+func containsSimilarQuestion(open []raisedQuestion, question string) bool {
+	for _, q := range open {
+		if textsim.Similarity(q.text, question) > nearDuplicateThreshold {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package orchestrator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCollectOpenQuestionsDropsAnsweredQuestions(t *testing.T) {
+	turns := []Turn{
+		{Index: 1, SpeakerID: ModeratorSpeakerID, Type: TurnTypeModerator, Content: "Who owns the rollback runbook?"},
+		{Index: 2, SpeakerID: "a", Type: TurnTypePersona, Content: "Ops owns the rollback runbook today. What is our error budget for billing?\nNEXT: b"},
+		{Index: 3, SpeakerID: "a", Type: TurnTypePersona, Content: "Our error budget for billing matters here.\nNEXT: b"},
+		{Index: 4, SpeakerID: "b", Type: TurnTypePersona, Content: "Migrate billing first. What is our error budget for billing?\nCLOSE: no"},
+	}
+
+	got := collectOpenQuestions(turns)
+	want := []string{"What is our error budget for billing?"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("open questions = %v, want %v", got, want)
+	}
+}
+
+func TestRunSurfacesUnansweredQuestions(t *testing.T) {
+	llm := &openQuestionsLLM{fakeLLM: fakeLLM{judgeAtTurn: 999, turnBySpeakerID: map[string]string{
+		"a": "Keep the monolith for now. Who signs off on the billing cutover date?",
+		"o": "Harden deploys before any migration.",
+	}}}
+	orch := New(llm, Config{MaxTurns: 4})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := []string{"Who signs off on the billing cutover date?"}
+	if !reflect.DeepEqual(result.OpenQuestions, want) {
+		t.Fatalf("result open questions = %v, want %v", result.OpenQuestions, want)
+	}
+	if !reflect.DeepEqual(llm.finalInput.OpenQuestions, want) {
+		t.Fatalf("final moderator open questions = %v, want %v", llm.finalInput.OpenQuestions, want)
+	}
+}
+
+type openQuestionsLLM struct {
+	fakeLLM
+	finalInput GenerateFinalModeratorInput
+}
+
+func (f *openQuestionsLLM) GenerateFinalModerator(ctx context.Context, input GenerateFinalModeratorInput) (GenerateFinalModeratorOutput, error) {
+	f.finalInput = input
+	return f.fakeLLM.GenerateFinalModerator(ctx, input)
+}
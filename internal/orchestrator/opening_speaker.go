@@ -2,19 +2,26 @@ package orchestrator
 
 import (
 	"strings"
-	"unicode"
 
 	"debate/internal/persona"
+	"debate/internal/textsim"
 )
 
 func defaultOpeningSpeakerIndex(problem string, personas []persona.Persona) int {
+	index, _ := defaultOpeningSpeakerChoice(problem, personas)
+	return index
+}
+
+// defaultOpeningSpeakerChoice scores personas against the problem keywords and
+// falls back to roster order when nothing matches.
+func defaultOpeningSpeakerChoice(problem string, personas []persona.Persona) (int, string) {
 	if len(personas) == 0 {
-		return 0
+		return 0, OpeningSpeakerSourceIndex
 	}
 
-	problemSet := buildTokenSet(problem)
+	problemSet := textsim.TokenSet(problem)
 	if len(problemSet) == 0 {
-		return 0
+		return 0, OpeningSpeakerSourceIndex
 	}
 
 	problemCompact := compactLower(problem)
@@ -28,9 +35,9 @@ func defaultOpeningSpeakerIndex(problem string, personas []persona.Persona) int
 		}
 	}
 	if bestScore <= 0 {
-		return 0
+		return 0, OpeningSpeakerSourceIndex
 	}
-	return bestIdx
+	return bestIdx, OpeningSpeakerSourceKeywordFallback
 }
 
 func openingSpeakerScore(problemSet map[string]struct{}, problemCompact string, p persona.Persona) int {
@@ -66,7 +73,7 @@ func overlapScore(problemSet map[string]struct{}, fields []string, weight int) i
 	seen := make(map[string]struct{})
 	score := 0
 	for _, field := range fields {
-		for _, token := range tokenize(field) {
+		for _, token := range textsim.Tokens(field) {
 			if _, ok := problemSet[token]; !ok {
 				continue
 			}
@@ -94,42 +101,6 @@ func findPersonaIndex(personas []persona.Persona, raw string) int {
 	return -1
 }
 
-func buildTokenSet(text string) map[string]struct{} {
-	set := make(map[string]struct{})
-	for _, token := range tokenize(text) {
-		set[token] = struct{}{}
-	}
-	return set
-}
-
-func tokenize(text string) []string {
-	trimmed := strings.TrimSpace(strings.ToLower(text))
-	if trimmed == "" {
-		return nil
-	}
-
-	parts := strings.FieldsFunc(trimmed, func(r rune) bool {
-		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
-	})
-	out := make([]string, 0, len(parts))
-	seen := make(map[string]struct{}, len(parts))
-	for _, part := range parts {
-		p := strings.TrimSpace(part)
-		if p == "" {
-			continue
-		}
-		if runeLen(p) < 2 {
-			continue
-		}
-		if _, dup := seen[p]; dup {
-			continue
-		}
-		seen[p] = struct{}{}
-		out = append(out, p)
-	}
-	return out
-}
-
 func compactLower(text string) string {
 	return strings.ToLower(strings.Join(strings.Fields(strings.TrimSpace(text)), ""))
 }
@@ -137,7 +108,3 @@ func compactLower(text string) string {
 func normalizeMatchKey(text string) string {
 	return strings.ToLower(strings.TrimSpace(text))
 }
-
-func runeLen(s string) int {
-	return len([]rune(s))
-}
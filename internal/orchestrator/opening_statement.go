@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"strings"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// scriptedOpeningTurn returns the speaker's configured opening statement as
+// its turn when the speaker has not spoken yet in turns.
+func scriptedOpeningTurn(turns []Turn, speaker persona.Persona) (Turn, bool) {
+	statement := strings.TrimSpace(speaker.OpeningStatement)
+	if statement == "" {
+		return Turn{}, false
+	}
+	for _, turn := range turns {
+		if turn.Type == TurnTypePersona && strings.EqualFold(turn.SpeakerID, speaker.ID) {
+			return Turn{}, false
+		}
+	}
+	return Turn{
+		Index:       nextTurnIndex(turns),
+		SpeakerID:   speaker.ID,
+		SpeakerName: persona.DisplayName(speaker),
+		Type:        TurnTypePersona,
+		Content:     statement,
+		Timestamp:   time.Now().UTC(),
+		Scripted:    true,
+	}, true
+}
@@ -0,0 +1,45 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestOpeningStatementIsScriptedOnlyOnFirstTurn(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	personas := testPersonas()
+	personas[0].OpeningStatement = "Constraint: no new vendors this quarter."
+
+	result, err := New(llm, Config{MaxTurns: 4}).Run(context.Background(), "How do we reduce incidents?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	scripted, generated, architectTurns := 0, 0, 0
+	for _, turn := range result.Turns {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		if turn.Scripted {
+			scripted++
+			if turn.SpeakerID != "a" || !strings.HasPrefix(turn.Content, "Constraint: no new vendors this quarter.") {
+				t.Fatalf("unexpected scripted turn: %+v", turn)
+			}
+		} else {
+			generated++
+		}
+		if turn.SpeakerID == "a" {
+			architectTurns++
+			if architectTurns > 1 && turn.Scripted {
+				t.Fatalf("expected later turns to be generated, got %+v", turn)
+			}
+		}
+	}
+	if scripted != 1 || architectTurns < 2 {
+		t.Fatalf("expected one scripted opening and a later generated architect turn, turns=%+v", result.Turns)
+	}
+	if llm.generateCalls != generated {
+		t.Fatalf("expected the scripted turn to skip the model: calls=%d generated=%d", llm.generateCalls, generated)
+	}
+}
@@ -16,9 +16,20 @@ const (
 	StatusMaxTurnsReached   = "max_turns_reached"
 	StatusDurationReached   = "duration_limit_reached"
 	StatusTokenLimitReached = "token_limit_reached"
+	StatusCostLimitReached  = "cost_limit_reached"
+	StatusCallLimitReached  = "call_limit_reached"
 	StatusNoProgressReached = "no_progress_reached"
+	StatusIrreconcilable    = "irreconcilable"
 	StatusError             = "error"
 
+	// StopReasonNoProgress* record which check ended a debate with
+	// StatusNoProgressReached: judge scores that stopped improving, CLOSE
+	// and NEW_POINT signals from the personas, or a direct-handoff stretch
+	// whose judges saw no progress.
+	StopReasonNoProgressFlatScores   = "no_progress:flat_judge_scores"
+	StopReasonNoProgressCloseSignals = "no_progress:close_signals"
+	StopReasonNoProgressHandoffLoop  = "no_progress:direct_handoff_loop"
+
 	TurnTypePersona   = "persona"
 	TurnTypeModerator = "moderator"
 
@@ -27,6 +38,30 @@ const (
 
 	AudienceModeGeneral = "general"
 	AudienceModeExpert  = "expert"
+
+	// AmbiguousHandoff* choose the next speaker when a turn's closing line
+	// mentions more than one persona.
+	AmbiguousHandoffFallback       = "fallback"
+	AmbiguousHandoffFirstMentioned = "first_mentioned"
+	AmbiguousHandoffPriority       = "priority"
+
+	// ModeratorMode* set how often the moderator speaks between persona
+	// turns: auto skips it on direct handoffs, always never skips it, and
+	// never drops interstitial moderator turns entirely.
+	ModeratorModeAuto   = "auto"
+	ModeratorModeAlways = "always"
+	ModeratorModeNever  = "never"
+
+	// OpeningSpeakerSource* record how the first persona speaker was chosen.
+	OpeningSpeakerSourceModel           = "model"
+	OpeningSpeakerSourceKeywordFallback = "keyword_fallback"
+	OpeningSpeakerSourceIndex           = "index"
+	OpeningSpeakerSourcePinned          = "pinned"
+
+	EventOpeningSpeakerSelected = "opening_speaker_selected"
+	// EventSlowTurn reports a persona or moderator call still running after
+	// Config.SlowTurnNudge.
+	EventSlowTurn = "slow_turn"
 )
 
 const (
@@ -39,6 +74,7 @@ const (
 	defaultUnlimitedHardMaxTurns  = 400
 	defaultDirectJudgeEvery       = 2
 	defaultLLMHistoryTurnWindow   = 120
+	defaultPollConcurrency        = 1
 )
 
 type Usage struct {
@@ -54,6 +90,32 @@ type Turn struct {
 	Type        string    `json:"type"`
 	Content     string    `json:"content"`
 	Timestamp   time.Time `json:"timestamp"`
+	// Structured holds schema fields (claim, evidence, next_step, ...) when
+	// StructuredTurns is enabled; Content is their plain rendering.
+	Structured map[string]any `json:"structured,omitempty"`
+	// Model is the model that produced the turn, as reported by the client.
+	Model string `json:"model,omitempty"`
+	// NearDuplicate marks a persona turn that restates one of the same
+	// speaker's recent turns almost verbatim.
+	NearDuplicate bool `json:"near_duplicate,omitempty"`
+	// MissedConsideration marks a persona turn that mentions none of the
+	// speaker's required considerations.
+	MissedConsideration bool `json:"missed_consideration,omitempty"`
+	// Injected marks a moderator turn carrying a question supplied from
+	// outside the debate through Config.Interjections.
+	Injected bool `json:"injected,omitempty"`
+	// Scripted marks a persona turn taken verbatim from the persona's
+	// OpeningStatement; no model call was made for it.
+	Scripted bool `json:"scripted,omitempty"`
+	// Interrupt marks a persona turn taken out of order because the previous
+	// turn hit one of the speaker's interrupt triggers.
+	Interrupt bool `json:"interrupt,omitempty"`
+	// Phase labels turns outside the regular debate flow, such as
+	// TurnPhaseIntro; empty for ordinary turns.
+	Phase string `json:"phase,omitempty"`
+	// ModeratorAsk is the targeted next-speaker question of a moderator
+	// turn, taken from its ASK: line or last question.
+	ModeratorAsk string `json:"moderator_ask,omitempty"`
 }
 
 type Consensus struct {
@@ -66,6 +128,14 @@ type Consensus struct {
 	NextActionTrigger       string   `json:"next_action_trigger_or_deadline,omitempty"`
 	NextActionSuccessMetric string   `json:"next_action_success_metric,omitempty"`
 	RequiredNextAction      string   `json:"required_next_action,omitempty"`
+	// Threshold is the score this verdict had to reach, which differs from
+	// Config.ConsensusThreshold when a ThresholdSchedule is active.
+	Threshold float64 `json:"threshold,omitempty"`
+	// OwnerMissing is set when Config.RequireActionOwner is on and the next
+	// action names no persona as its owner.
+	OwnerMissing bool `json:"owner_missing,omitempty"`
+	// Model is the judge model that produced this verdict.
+	Model string `json:"model,omitempty"`
 }
 
 type Metrics struct {
@@ -73,6 +143,12 @@ type Metrics struct {
 	PromptTokens     int   `json:"prompt_tokens"`
 	CompletionTokens int   `json:"completion_tokens"`
 	TotalTokens      int   `json:"total_tokens"`
+	// EstimatedCostUSD prices the token usage with Config.CostModel; it
+	// stays 0 when no prices are configured.
+	EstimatedCostUSD float64 `json:"estimated_cost_usd,omitempty"`
+	// LLMCalls counts model calls whose usage was recorded, including the
+	// opening speaker selection and the final wrap-up.
+	LLMCalls int `json:"llm_calls,omitempty"`
 }
 
 type Result struct {
@@ -84,55 +160,131 @@ type Result struct {
 	Metrics   Metrics           `json:"metrics"`
 	StartedAt time.Time         `json:"started_at"`
 	EndedAt   time.Time         `json:"ended_at"`
+	// OpeningSpeakerSource is model|keyword_fallback|index|pinned.
+	OpeningSpeakerSource string `json:"opening_speaker_source,omitempty"`
+	// OpeningSpeakerRetried is set when the model's opening choice needed a
+	// parse retry.
+	OpeningSpeakerRetried bool `json:"opening_speaker_retried,omitempty"`
+	// StanceDrift maps persona ID to how far its latest claim moved from its
+	// first one (0 = unchanged, 1 = no overlap).
+	StanceDrift map[string]float64 `json:"stance_drift,omitempty"`
+	// Project and Tags are caller-supplied labels used to group saved debates.
+	Project string   `json:"project,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	// StopReason explains early terminations that need more context than
+	// Status, such as the deadlocked pair behind StatusIrreconcilable.
+	StopReason string `json:"stop_reason,omitempty"`
+	// RedTeamStances maps speaker ID to the camp (RedTeamSupport or
+	// RedTeamOppose) assigned when Config.RedTeam is set; RedTeamSeed
+	// reproduces the split.
+	RedTeamStances map[string]string `json:"red_team_stances,omitempty"`
+	RedTeamSeed    int64             `json:"red_team_seed,omitempty"`
+	// OpenQuestions are questions raised during the debate that no later
+	// turn by another speaker appears to have addressed.
+	OpenQuestions []string `json:"open_questions,omitempty"`
+	// SpeakerColors maps persona IDs and the moderator to display colors so
+	// the web UI and exports render speakers consistently.
+	SpeakerColors map[string]string `json:"speaker_colors,omitempty"`
+	// PerSpeaker breaks persona and moderator turns and their token usage
+	// down by speaker ID; judge and selection calls only count in Metrics.
+	PerSpeaker map[string]SpeakerMetrics `json:"per_speaker,omitempty"`
+}
+
+// Event reports orchestration decisions that are not turns themselves.
+type Event struct {
+	Type      string    `json:"type"`
+	SpeakerID string    `json:"speaker_id,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 type GenerateTurnInput struct {
-	Problem      string
-	Personas     []persona.Persona
-	Turns        []Turn
-	Speaker      persona.Persona
-	AudienceMode string
+	Problem          string
+	Personas         []persona.Persona
+	Turns            []Turn
+	Speaker          persona.Persona
+	AudienceMode     string
+	ResponseLanguage string
+	// Structured requests a JSON turn matching the structured turn schema.
+	Structured bool
+	// SharedContext summarizes earlier debates in the same session.
+	SharedContext string
+	// RedTeamStance, when set, is the camp assigned to Speaker for
+	// adversarial testing; it replaces the persona's configured stance.
+	RedTeamStance string
 }
 
 type GenerateTurnOutput struct {
-	Content string
-	Usage   Usage
+	Content    string
+	Structured map[string]any
+	Model      string
+	Usage      Usage
 }
 
 type GenerateModeratorInput struct {
-	Problem       string
-	Personas      []persona.Persona
-	Turns         []Turn
-	PreviousTurn  Turn
-	NextSpeaker   persona.Persona
-	CurrentTurnNo int
-	AudienceMode  string
+	Problem          string
+	Personas         []persona.Persona
+	Turns            []Turn
+	PreviousTurn     Turn
+	NextSpeaker      persona.Persona
+	CurrentTurnNo    int
+	AudienceMode     string
+	ResponseLanguage string
+	// FocusPersona is set (non-empty ID) when Config.FocusPersonaID is active.
+	FocusPersona persona.Persona
+	// Observers are non-speaking stakeholder personas.
+	Observers []persona.Persona
+	// SharedContext summarizes earlier debates in the same session.
+	SharedContext string
+	// RequestActionOwner asks the moderator to get an owner assigned because
+	// the latest judged next action named none.
+	RequestActionOwner bool
 }
 
 type GenerateModeratorOutput struct {
 	Content string
+	Model   string
 	Usage   Usage
 }
 
 type GenerateFinalModeratorInput struct {
-	Problem      string
-	Personas     []persona.Persona
-	Turns        []Turn
-	Consensus    Consensus
-	FinalStatus  string
-	AudienceMode string
+	Problem          string
+	Personas         []persona.Persona
+	Turns            []Turn
+	Consensus        Consensus
+	FinalStatus      string
+	AudienceMode     string
+	ResponseLanguage string
+	// OpenQuestions are still-unanswered questions the wrap-up should close
+	// or explicitly defer.
+	OpenQuestions []string
 }
 
 type GenerateFinalModeratorOutput struct {
 	Content string
+	Model   string
 	Usage   Usage
 }
 
 type JudgeConsensusInput struct {
-	Problem      string
-	Personas     []persona.Persona
-	Turns        []Turn
-	AudienceMode string
+	Problem          string
+	Personas         []persona.Persona
+	Turns            []Turn
+	AudienceMode     string
+	ResponseLanguage string
+	// Observers are non-speaking stakeholder personas.
+	Observers []persona.Persona
+	// SharedContext summarizes earlier debates in the same session.
+	SharedContext string
+	// RecencyWeighting splits the log into summarized earlier context and
+	// verbatim latest exchanges.
+	RecencyWeighting bool
+	// RequestActionOwner asks the judge to name a persona as next action
+	// owner because the previous verdict named none.
+	RequestActionOwner bool
+	// RedTeamStances is set when stances were assigned for adversarial
+	// testing rather than held by the personas (see Result.RedTeamStances).
+	RedTeamStances map[string]string
 }
 
 type JudgeConsensusOutput struct {
@@ -147,7 +299,13 @@ type SelectOpeningSpeakerInput struct {
 
 type SelectOpeningSpeakerOutput struct {
 	PersonaID string
-	Usage     Usage
+	// Model prices Usage with Config.CostModel; set it on errors too.
+	Model string
+	// Usage may be set alongside an error when failed attempts spent tokens.
+	Usage Usage
+	// Retried reports that the first reply was unusable and a retry
+	// produced PersonaID.
+	Retried bool
 }
 
 type LLMClient interface {
@@ -163,13 +321,61 @@ type OpeningSpeakerSelector interface {
 	SelectOpeningSpeaker(ctx context.Context, input SelectOpeningSpeakerInput) (SelectOpeningSpeakerOutput, error)
 }
 
+// FinalModeratorStreamer is optional. When implemented and
+// Config.OnFinalModeratorDelta is set, the final wrap-up is generated through
+// it so callers can show the conclusion as it is written. onDelta receives
+// text fragments in order; the returned Content is the complete wrap-up.
+type FinalModeratorStreamer interface {
+	StreamFinalModerator(ctx context.Context, input GenerateFinalModeratorInput, onDelta func(string)) (GenerateFinalModeratorOutput, error)
+}
+
+// TurnStreamer is optional. When implemented and Config.OnTurnDelta is set,
+// plain (non-structured) persona turns are generated through it so callers
+// can show each turn as it is written. onDelta receives text fragments in
+// order; the returned Content is the complete turn.
+type TurnStreamer interface {
+	StreamTurn(ctx context.Context, input GenerateTurnInput, onDelta func(string)) (GenerateTurnOutput, error)
+}
+
 type Config struct {
-	MaxTurns            int
-	ConsensusThreshold  float64
-	MaxDuration         time.Duration
-	MaxTotalTokens      int
+	MaxTurns           int
+	ConsensusThreshold float64
+	MaxDuration        time.Duration
+	// DurationGrace lets a run stopped by MaxDuration make one more judge
+	// call, never a persona turn, within this extra time so consensus reached
+	// on the last turns is not reported as duration_reached. 0 disables it;
+	// values are capped at maxDurationGrace.
+	DurationGrace  time.Duration
+	MaxTotalTokens int
+	// MaxEstimatedCostUSD stops the run with StatusCostLimitReached once the
+	// usage priced by CostModel reaches it; 0 disables the ceiling.
+	MaxEstimatedCostUSD float64
+	CostModel           CostModel
+	// MaxLLMCalls stops the run with StatusCallLimitReached once this many
+	// model calls were made, for APIs that bill a fixed minimum per call;
+	// 0 disables the cap.
+	MaxLLMCalls         int
 	MaxNoProgressJudges int
 	NoProgressEpsilon   float64
+	// ThresholdSchedule, when set, replaces ConsensusThreshold with a bar
+	// that ramps over the debate; nil keeps the threshold constant.
+	ThresholdSchedule *ThresholdSchedule
+	// RedTeam ignores configured persona stances and assigns half the
+	// speakers to support and half to oppose, for stress-testing a decision.
+	// RedTeamSeed fixes the split; 0 picks a random seed.
+	RedTeam     bool
+	RedTeamSeed int64
+	// DisableFinalJudgePass skips the extra judge call made when a debate
+	// hits the turn cap with turns the latest verdict has not seen.
+	DisableFinalJudgePass bool
+	// JudgePreScreenThreshold, when > 0, skips scheduled judge calls until
+	// HeuristicConsensus over the transcript reaches it. Judges triggered by
+	// CLOSE signals and the final judge pass are not screened. 0 disables.
+	JudgePreScreenThreshold float64
+	// IrreconcilableAfterJudges ends the debate with StatusIrreconcilable once
+	// this many consecutive judges score below the deadlock floor while the
+	// same two personas remain the active tension. 0 disables the check.
+	IrreconcilableAfterJudges int
 	// UnlimitedHardMaxTurns applies only when MaxTurns == 0.
 	UnlimitedHardMaxTurns int
 	// DirectHandoffJudgeEvery controls judge cadence in direct-handoff mode.
@@ -179,6 +385,80 @@ type Config struct {
 	LLMHistoryTurnWindow int
 	// AudienceMode controls explanation depth in prompts: general|expert.
 	AudienceMode string
+	// ResponseLanguage forces a reply language (BCP 47-style tag such as
+	// "en" or "ko"). Empty keeps the same-language-as-problem rule.
+	ResponseLanguage string
+	// ModeratorName is the display name on moderator turns; empty means
+	// ModeratorSpeakerName.
+	ModeratorName string
+	// OpeningSpeakerID pins the first persona speaker. When it matches a
+	// speaking persona ID, the opening speaker selector is not called; an
+	// unknown ID falls back to the usual selection.
+	OpeningSpeakerID string
+	// FocusPersonaID gives one persona extra airtime: fallback handoffs from
+	// other personas return to it. Explicit NEXT handoffs are still honored.
+	FocusPersonaID string
+	// FocusBias is the share (0..1] of fallback handoffs from other personas
+	// that return to the focus persona. 1 means every other turn; <= 0
+	// defaults to 1.
+	FocusBias float64
+	// AmbiguousHandoffPolicy resolves handoffs that mention several
+	// personas: fallback (rotation, the default), first_mentioned, or
+	// priority (highest persona HandoffPriority, then first mentioned).
+	AmbiguousHandoffPolicy string
+	// ModeratorMode is auto (the default), always or never; see
+	// ModeratorModeAuto. The intro and final wrap-up are not affected.
+	ModeratorMode string
+	// JudgeRecencyWeighting shows the judge earlier turns as summaries and
+	// the latest exchanges verbatim, asking it to weigh late alignment more.
+	JudgeRecencyWeighting bool
+	// RequireActionOwner flags consensus next actions that name no persona
+	// (Consensus.OwnerMissing) and nudges the moderator and judge to assign
+	// one on the next cycle.
+	RequireActionOwner bool
+	// MinDistinctSpeakersForConsensus withholds consensus until at least this
+	// many distinct personas have spoken. 0 disables the gate; values above
+	// the speaker count are capped to it.
+	MinDistinctSpeakersForConsensus int
+	// StructuredTurns asks personas for JSON turns (claim, evidence,
+	// next_step) and stores the parsed fields on Turn.Structured.
+	StructuredTurns bool
+	// SharedContext is a compact summary of earlier debates in the same
+	// session (see RunSequence). It is passed to turn, moderator and judge
+	// prompts; empty means a standalone debate.
+	SharedContext string
+	// ModeratorIntro has the moderator introduce the problem and panel
+	// before the first persona turn. It needs an LLM client implementing
+	// ModeratorIntroGenerator; other clients skip the intro.
+	ModeratorIntro bool
+	// Interjections delivers outside questions (e.g. from a web client) into
+	// a running debate. Pending questions are drained before each persona
+	// turn and recorded as moderator turns the next speaker must answer.
+	Interjections <-chan string `json:"-"`
+	// SpeakerControls mutes and unmutes personas mid-run. Controls are
+	// applied before each persona turn and announced as moderator notes.
+	SpeakerControls <-chan SpeakerControl `json:"-"`
+	// PollConcurrency bounds parallel GenerateTurn calls in Poll.
+	// Values <= 0 fall back to sequential polling.
+	PollConcurrency int
+	// OnEvent, when set, receives orchestration events such as the opening
+	// speaker decision. It is called synchronously from the debate loop,
+	// except for EventSlowTurn, which comes from a watchdog goroutine while
+	// the loop waits on the slow call.
+	OnEvent func(Event) `json:"-"`
+	// SlowTurnNudge, when positive, emits EventSlowTurn through OnEvent once
+	// a single persona or moderator call has run this long, so clients can
+	// tell a slow model from a stuck one. It does not affect the debate.
+	SlowTurnNudge time.Duration
+	// OnFinalModeratorDelta, when set, receives the final wrap-up in
+	// fragments as it streams, ahead of the completed moderator turn. It is
+	// only used when the LLM client implements FinalModeratorStreamer.
+	OnFinalModeratorDelta func(string) `json:"-"`
+	// OnTurnDelta, when set, receives each persona turn in fragments as it
+	// streams, tagged with the speaker ID, ahead of the completed turn. It
+	// is only used when the LLM client implements TurnStreamer; otherwise
+	// turns arrive whole through onTurn as before.
+	OnTurnDelta func(speakerID string, delta string) `json:"-"`
 }
 
 type Orchestrator struct {
@@ -192,9 +472,18 @@ type judgeProgress struct {
 	prevScore        float64
 	// Consecutive confirmations reduce false positives from a single optimistic judge call.
 	consecutiveConsensusJudges int
+	deadlock                   deadlockTracker
+	// judgedTurnNo is the persona turn the latest verdict was given after.
+	judgedTurnNo int
 }
 
 func New(llm LLMClient, cfg Config) *Orchestrator {
+	return &Orchestrator{llm: llm, cfg: NormalizeConfig(cfg)}
+}
+
+// NormalizeConfig fills zero or out-of-range fields with the defaults a run
+// would use, so callers can report the effective config without running.
+func NormalizeConfig(cfg Config) Config {
 	// MaxTurns == 0 means unbounded rounds with safety guards.
 	if cfg.MaxTurns < 0 {
 		cfg.MaxTurns = 0
@@ -202,15 +491,23 @@ func New(llm LLMClient, cfg Config) *Orchestrator {
 	if cfg.ConsensusThreshold < 0 || cfg.ConsensusThreshold > 1 {
 		cfg.ConsensusThreshold = defaultConsensusThreshold
 	}
+	cfg.ThresholdSchedule = normalizeThresholdSchedule(cfg.ThresholdSchedule, cfg.ConsensusThreshold)
 	if cfg.MaxDuration <= 0 {
 		cfg.MaxDuration = defaultMaxDuration
 	}
+	cfg.DurationGrace = normalizeDurationGrace(cfg.DurationGrace)
 	if cfg.MaxTotalTokens <= 0 {
 		cfg.MaxTotalTokens = defaultMaxTotalTokens
 	}
 	if cfg.MaxNoProgressJudges <= 0 {
 		cfg.MaxNoProgressJudges = defaultMaxNoProgress
 	}
+	if cfg.IrreconcilableAfterJudges < 0 {
+		cfg.IrreconcilableAfterJudges = 0
+	}
+	if cfg.JudgePreScreenThreshold < 0 {
+		cfg.JudgePreScreenThreshold = 0
+	}
 	if cfg.NoProgressEpsilon <= 0 {
 		cfg.NoProgressEpsilon = defaultNoProgressEpsilon
 	}
@@ -223,8 +520,19 @@ func New(llm LLMClient, cfg Config) *Orchestrator {
 	if cfg.LLMHistoryTurnWindow <= 0 {
 		cfg.LLMHistoryTurnWindow = defaultLLMHistoryTurnWindow
 	}
+	if cfg.PollConcurrency <= 0 {
+		cfg.PollConcurrency = defaultPollConcurrency
+	}
 	cfg.AudienceMode = normalizeAudienceMode(cfg.AudienceMode)
-	return &Orchestrator{llm: llm, cfg: cfg}
+	cfg.AmbiguousHandoffPolicy = normalizeAmbiguousHandoffPolicy(cfg.AmbiguousHandoffPolicy)
+	cfg.ModeratorMode = normalizeModeratorMode(cfg.ModeratorMode)
+	cfg.ResponseLanguage = strings.TrimSpace(cfg.ResponseLanguage)
+	cfg.SharedContext = strings.TrimSpace(cfg.SharedContext)
+	cfg.ModeratorName = strings.TrimSpace(cfg.ModeratorName)
+	if cfg.ModeratorName == "" {
+		cfg.ModeratorName = ModeratorSpeakerName
+	}
+	return cfg
 }
 
 // RunWithConfig runs a single debate using the provided runtime config.
@@ -246,6 +554,28 @@ func normalizeAudienceMode(mode string) string {
 	}
 }
 
+func normalizeAmbiguousHandoffPolicy(policy string) string {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case AmbiguousHandoffFirstMentioned:
+		return AmbiguousHandoffFirstMentioned
+	case AmbiguousHandoffPriority:
+		return AmbiguousHandoffPriority
+	default:
+		return AmbiguousHandoffFallback
+	}
+}
+
+func normalizeModeratorMode(mode string) string {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case ModeratorModeAlways:
+		return ModeratorModeAlways
+	case ModeratorModeNever:
+		return ModeratorModeNever
+	default:
+		return ModeratorModeAuto
+	}
+}
+
 func (o *Orchestrator) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(Turn)) (Result, error) {
 	started := time.Now().UTC()
 	res := Result{
@@ -268,24 +598,42 @@ func (o *Orchestrator) Run(ctx context.Context, problem string, personas []perso
 		return res, fmt.Errorf("invalid personas: %w", err)
 	}
 	res.Personas = normalized
+	// Observers stay in res.Personas but never enter the speaking rotation.
+	speakers := persona.Speakers(normalized)
+	res.SpeakerColors = AssignSpeakerColors(normalized)
+	if o.cfg.RedTeam {
+		res.RedTeamStances, res.RedTeamSeed = assignRedTeamStances(speakers, o.cfg.RedTeamSeed)
+	}
 
-	openingSpeakerIndex, openingStopStatus, openingShouldStop := o.chooseOpeningSpeakerIndex(ctx, started, &res, normalized)
+	openingSpeakerIndex, openingStopStatus, openingShouldStop := o.chooseOpeningSpeakerIndex(ctx, started, &res, speakers)
 	if openingShouldStop {
 		return o.finalizeWithModerator(ctx, &res, started, openingStopStatus, onTurn)
 	}
-	return o.runDebateLoop(ctx, started, &res, normalized, openingSpeakerIndex, onTurn)
+	introStopStatus, introShouldStop, err := o.addModeratorIntro(ctx, started, &res, speakers, speakers[openingSpeakerIndex], onTurn)
+	if err != nil {
+		finalizeResult(&res, started, StatusError)
+		return res, err
+	}
+	if introShouldStop {
+		return o.finalizeWithModerator(ctx, &res, started, introStopStatus, onTurn)
+	}
+	return o.runDebateLoop(ctx, started, &res, speakers, openingSpeakerIndex, onTurn)
 }
 
 func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res *Result, normalized []persona.Persona, openingSpeakerIndex int, onTurn func(Turn)) (Result, error) {
-	effectiveMaxTurns := o.cfg.MaxTurns
-	if effectiveMaxTurns <= 0 {
-		effectiveMaxTurns = o.cfg.UnlimitedHardMaxTurns
-	}
+	effectiveMaxTurns := o.effectiveMaxTurns()
 
 	progress := judgeProgress{}
 	terminationSignals := newTerminationSignalTracker()
+	terminationSignals.ignoreCloseVotesFrom(normalized)
+	voterCount := len(persona.ConsensusParties(normalized))
 	currentSpeakerIndex := openingSpeakerIndex
 	directHandoffMode := false
+	focus := newFocusRouter(normalized, o.cfg.FocusPersonaID, o.cfg.FocusBias)
+	rotation := newWeightedRotation(normalized)
+	interrupts := newInterruptTracker()
+	interrupting := false
+	mutes := newMuteTracker()
 
 	for i := 0; ; i++ {
 		if err := ctx.Err(); err != nil {
@@ -294,9 +642,17 @@ func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res
 		}
 
 		if status, shouldStop := o.preTurnStatus(started, i, effectiveMaxTurns); shouldStop {
+			if status == StatusMaxTurnsReached {
+				o.finalJudgePass(ctx, started, res, normalized, i, &progress)
+			}
+			status = o.durationGraceStatus(ctx, started, res, normalized, i, &progress, status)
 			return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 		}
 
+		o.drainSpeakerControls(res, normalized, mutes, onTurn)
+		o.drainInterjections(res, onTurn)
+		currentSpeakerIndex = mutes.skip(normalized, currentSpeakerIndex, -1)
+
 		turnNo := i + 1
 		speaker := normalized[currentSpeakerIndex]
 		stepCtx, cancel := o.callContext(ctx, started)
@@ -304,23 +660,26 @@ func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res
 		cancel()
 		if err != nil {
 			if status, isDurationStop := o.durationStatusOnLLMError(started, err); isDurationStop {
+				status = o.durationGraceStatus(ctx, started, res, normalized, i, &progress, status)
 				return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 			}
 			finalizeResult(res, started, StatusError)
 			return *res, fmt.Errorf("generate turn %d: %w", turnNo, err)
 		}
+		personaTurn.Interrupt = interrupting
+		interrupting = false
 		res.Turns = append(res.Turns, personaTurn)
 		if onTurn != nil {
 			onTurn(personaTurn)
 		}
 		terminationSignals.observe(personaTurn)
 
-		if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-			return o.finalizeWithModerator(ctx, res, started, StatusTokenLimitReached, onTurn)
+		if status, stop := o.budgetStatus(res); stop {
+			return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 		}
 
 		judgedThisTurn := false
-		if o.shouldJudgeAtTurn(i, len(normalized), directHandoffMode) {
+		if o.shouldJudgeAtTurn(i, len(normalized), directHandoffMode) && o.passesJudgePreScreen(res.Turns) {
 			judgedThisTurn = true
 			status, done, err := o.judgeTurn(ctx, started, res, normalized, turnNo, &progress)
 			if err != nil {
@@ -328,13 +687,15 @@ func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res
 				return *res, err
 			}
 			if done {
+				status = o.durationGraceStatus(ctx, started, res, normalized, turnNo, &progress, status)
 				return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 			}
 			if directHandoffMode && progress.noProgressJudges >= directHandoffNoProgressLimit(len(normalized), o.cfg.MaxNoProgressJudges) {
+				res.StopReason = StopReasonNoProgressHandoffLoop
 				return o.finalizeWithModerator(ctx, res, started, StatusNoProgressReached, onTurn)
 			}
 		}
-		if terminationSignals.shouldSuggestStop(len(normalized)) {
+		if terminationSignals.shouldSuggestStop(voterCount) {
 			if !judgedThisTurn {
 				status, done, err := o.judgeTurn(ctx, started, res, normalized, turnNo, &progress)
 				if err != nil {
@@ -342,33 +703,60 @@ func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res
 					return *res, err
 				}
 				if done {
+					status = o.durationGraceStatus(ctx, started, res, normalized, turnNo, &progress, status)
 					return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 				}
 			}
+			res.StopReason = StopReasonNoProgressCloseSignals
 			return o.finalizeWithModerator(ctx, res, started, StatusNoProgressReached, onTurn)
 		}
 
 		if !hasNextPersonaTurn(i, effectiveMaxTurns) {
+			o.finalJudgePass(ctx, started, res, normalized, turnNo, &progress)
 			return o.finalizeWithModerator(ctx, res, started, StatusMaxTurnsReached, onTurn)
 		}
 
-		fallbackNextSpeakerIndex := (currentSpeakerIndex + 1) % len(normalized)
-		nextSpeakerIndex, directHandoff := selectNextSpeaker(normalized, speaker, personaTurn.Content, fallbackNextSpeakerIndex)
+		rotationIndex, rotationSlot := rotation.next(currentSpeakerIndex, (currentSpeakerIndex+1)%len(normalized))
+		fallbackNextSpeakerIndex := focus.fallback(currentSpeakerIndex, rotationIndex, len(normalized))
+		nextSpeakerIndex, directHandoff := selectNextSpeakerWithPolicy(normalized, speaker, personaTurn.Content, fallbackNextSpeakerIndex, o.cfg.AmbiguousHandoffPolicy)
+		if !directHandoff && nextSpeakerIndex == rotationIndex {
+			rotation.advance(rotationSlot)
+		}
+		nextSpeakerIndex = mutes.skip(normalized, nextSpeakerIndex, currentSpeakerIndex)
+		interrupterIndex, interrupted := interrupts.claim(normalized, speaker, personaTurn.Content, mutes)
+		// The canonical NEXT line names whoever actually speaks next, so the
+		// transcript, later prompts and resume agree with an interruption.
+		actualNextIndex := nextSpeakerIndex
+		if interrupted {
+			actualNextIndex = interrupterIndex
+		}
 		res.Turns[len(res.Turns)-1].Content = appendCanonicalNextSpeakerLine(
 			res.Turns[len(res.Turns)-1].Content,
-			normalized[nextSpeakerIndex],
+			normalized,
+			normalized[actualNextIndex],
 		)
-		if directHandoff {
+		if interrupted {
+			// An urgent objection skips the moderator and the handoff above.
+			currentSpeakerIndex = interrupterIndex
+			interrupting = true
+			continue
+		}
+		if directHandoff && o.cfg.ModeratorMode != ModeratorModeAlways {
 			currentSpeakerIndex = nextSpeakerIndex
 			directHandoffMode = true
 			continue
 		}
+		if o.cfg.ModeratorMode == ModeratorModeNever {
+			currentSpeakerIndex = nextSpeakerIndex
+			continue
+		}
 		nextSpeaker := normalized[nextSpeakerIndex]
 		stepCtx, cancel = o.callContext(ctx, started)
-		moderatorTurn, err := o.generateModeratorTurn(stepCtx, res, normalized, personaTurn, nextSpeaker, turnNo)
+		moderatorTurn, err := o.generateModeratorTurn(stepCtx, res, normalized, personaTurn, nextSpeaker, focus.persona(normalized), turnNo)
 		cancel()
 		if err != nil {
 			if status, isDurationStop := o.durationStatusOnLLMError(started, err); isDurationStop {
+				status = o.durationGraceStatus(ctx, started, res, normalized, turnNo, &progress, status)
 				return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 			}
 			finalizeResult(res, started, StatusError)
@@ -378,8 +766,8 @@ func (o *Orchestrator) runDebateLoop(ctx context.Context, started time.Time, res
 		if onTurn != nil {
 			onTurn(moderatorTurn)
 		}
-		if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-			return o.finalizeWithModerator(ctx, res, started, StatusTokenLimitReached, onTurn)
+		if status, stop := o.budgetStatus(res); stop {
+			return o.finalizeWithModerator(ctx, res, started, status, onTurn)
 		}
 		currentSpeakerIndex = nextSpeakerIndex
 		directHandoffMode = false
@@ -400,7 +788,22 @@ func isNilLLMClient(llm LLMClient) bool {
 }
 
 func (o *Orchestrator) chooseOpeningSpeakerIndex(ctx context.Context, started time.Time, res *Result, personas []persona.Persona) (int, string, bool) {
-	index := defaultOpeningSpeakerIndex(res.Problem, personas)
+	index, source := defaultOpeningSpeakerChoice(res.Problem, personas)
+	defer func() {
+		res.OpeningSpeakerSource = source
+		o.emitEvent(Event{
+			Type:      EventOpeningSpeakerSelected,
+			SpeakerID: personas[index].ID,
+			Detail:    source,
+		})
+	}()
+
+	if pinned := findPersonaIndex(personas, o.cfg.OpeningSpeakerID); pinned >= 0 {
+		index = pinned
+		source = OpeningSpeakerSourcePinned
+		return index, "", false
+	}
+
 	selector, ok := o.llm.(OpeningSpeakerSelector)
 	if !ok {
 		return index, "", false
@@ -412,6 +815,7 @@ func (o *Orchestrator) chooseOpeningSpeakerIndex(ctx context.Context, started ti
 		Personas: personas,
 	})
 	cancel()
+	o.recordUsage(&res.Metrics, out.Model, out.Usage)
 	if err != nil {
 		if status, isDurationStop := o.durationStatusOnLLMError(started, err); isDurationStop {
 			return index, status, true
@@ -419,16 +823,27 @@ func (o *Orchestrator) chooseOpeningSpeakerIndex(ctx context.Context, started ti
 		return index, "", false
 	}
 
-	addUsage(&res.Metrics, out.Usage)
 	if idx := findPersonaIndex(personas, out.PersonaID); idx >= 0 {
 		index = idx
+		source = OpeningSpeakerSourceModel
+		res.OpeningSpeakerRetried = out.Retried
 	}
-	if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-		return index, StatusTokenLimitReached, true
+	if status, stop := o.budgetStatus(res); stop {
+		return index, status, true
 	}
 	return index, "", false
 }
 
+func (o *Orchestrator) emitEvent(event Event) {
+	if o.cfg.OnEvent == nil {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	o.cfg.OnEvent(event)
+}
+
 func (o *Orchestrator) preTurnStatus(started time.Time, turnIndex int, maxTurns int) (string, bool) {
 	if maxTurns > 0 && turnIndex >= maxTurns {
 		return StatusMaxTurnsReached, true
@@ -440,29 +855,43 @@ func (o *Orchestrator) preTurnStatus(started time.Time, turnIndex int, maxTurns
 }
 
 func (o *Orchestrator) generatePersonaTurn(ctx context.Context, res *Result, personas []persona.Persona, speaker persona.Persona, turnNo int) (Turn, error) {
-	out, err := o.llm.GenerateTurn(ctx, GenerateTurnInput{
-		Problem:      res.Problem,
-		Personas:     personas,
-		Turns:        o.llmTurns(res.Turns),
-		Speaker:      speaker,
-		AudienceMode: o.cfg.AudienceMode,
+	if turn, ok := scriptedOpeningTurn(res.Turns, speaker); ok {
+		return turn, nil
+	}
+	stopWatch := o.watchSlowCall(speaker.ID, persona.DisplayName(speaker))
+	out, err := o.generateTurn(ctx, GenerateTurnInput{
+		Problem:          res.Problem,
+		Personas:         personas,
+		Turns:            o.llmTurns(res.Turns),
+		Speaker:          speaker,
+		AudienceMode:     o.cfg.AudienceMode,
+		ResponseLanguage: o.cfg.ResponseLanguage,
+		Structured:       o.cfg.StructuredTurns,
+		SharedContext:    o.cfg.SharedContext,
+		RedTeamStance:    res.RedTeamStances[speaker.ID],
 	})
+	stopWatch()
 	if err != nil {
 		return Turn{}, err
 	}
-	addUsage(&res.Metrics, out.Usage)
+	o.recordUsage(&res.Metrics, out.Model, out.Usage)
+	recordSpeakerUsage(res, speaker.ID, out.Usage)
 
 	content := strings.TrimSpace(out.Content)
 	if content == "" {
 		return Turn{}, fmt.Errorf("turn %d was empty", turnNo)
 	}
 	return Turn{
-		Index:       nextTurnIndex(res.Turns),
-		SpeakerID:   speaker.ID,
-		SpeakerName: persona.DisplayName(speaker),
-		Type:        TurnTypePersona,
-		Content:     content,
-		Timestamp:   time.Now().UTC(),
+		Index:               nextTurnIndex(res.Turns),
+		SpeakerID:           speaker.ID,
+		SpeakerName:         persona.DisplayName(speaker),
+		Type:                TurnTypePersona,
+		Content:             content,
+		Timestamp:           time.Now().UTC(),
+		Structured:          out.Structured,
+		Model:               strings.TrimSpace(out.Model),
+		NearDuplicate:       isNearDuplicate(res.Turns, speaker.ID, content),
+		MissedConsideration: missedConsideration(speaker, content),
 	}, nil
 }
 
@@ -477,22 +906,20 @@ func (o *Orchestrator) shouldJudgeAtTurn(turnIndex int, personaCount int, direct
 }
 
 func (o *Orchestrator) evaluateConsensus(ctx context.Context, res *Result, personas []persona.Persona, turnNo int, progress *judgeProgress) (string, bool, error) {
-	judgeOut, err := o.llm.JudgeConsensus(ctx, JudgeConsensusInput{
-		Problem:      res.Problem,
-		Personas:     personas,
-		Turns:        o.llmTurns(res.Turns),
-		AudienceMode: o.cfg.AudienceMode,
-	})
+	judgeOut, err := o.llm.JudgeConsensus(ctx, o.judgeInput(res, personas))
 	if err != nil {
 		return "", false, err
 	}
-	addUsage(&res.Metrics, judgeOut.Usage)
+	progress.judgedTurnNo = turnNo
+	o.recordUsage(&res.Metrics, judgeOut.Consensus.Model, judgeOut.Usage)
 	res.Consensus = judgeOut.Consensus
+	res.Consensus.Threshold = o.consensusThresholdAt(turnNo)
+	o.markActionOwner(&res.Consensus, personas)
 
-	if reachedTokenLimit(res.Metrics.TotalTokens, o.cfg.MaxTotalTokens) {
-		return StatusTokenLimitReached, true, nil
+	if status, stop := o.budgetStatus(res); stop {
+		return status, true, nil
 	}
-	if consensusSatisfied(res.Consensus, o.cfg.ConsensusThreshold) {
+	if consensusSatisfied(res.Consensus, res.Consensus.Threshold) && o.speakerCoverageMet(res.Turns, persona.ConsensusParties(personas)) {
 		progress.consecutiveConsensusJudges++
 	} else {
 		progress.consecutiveConsensusJudges = 0
@@ -501,13 +928,35 @@ func (o *Orchestrator) evaluateConsensus(ctx context.Context, res *Result, perso
 		return StatusConsensusReached, true, nil
 	}
 
+	progress.deadlock.observe(res.Consensus.Score, res.Turns)
+	if progress.deadlock.deadlocked(o.cfg.IrreconcilableAfterJudges) {
+		res.StopReason = progress.deadlock.reason()
+		return StatusIrreconcilable, true, nil
+	}
+
 	progress.update(res.Consensus.Score, o.cfg.NoProgressEpsilon)
 	if progress.noProgressJudges >= o.cfg.MaxNoProgressJudges {
+		res.StopReason = StopReasonNoProgressFlatScores
 		return StatusNoProgressReached, true, nil
 	}
 	return "", false, nil
 }
 
+func (o *Orchestrator) judgeInput(res *Result, personas []persona.Persona) JudgeConsensusInput {
+	return JudgeConsensusInput{
+		Problem:            res.Problem,
+		Personas:           personas,
+		Turns:              o.llmTurns(res.Turns),
+		AudienceMode:       o.cfg.AudienceMode,
+		ResponseLanguage:   o.cfg.ResponseLanguage,
+		Observers:          persona.Observers(res.Personas),
+		SharedContext:      o.cfg.SharedContext,
+		RecencyWeighting:   o.cfg.JudgeRecencyWeighting,
+		RequestActionOwner: res.Consensus.OwnerMissing,
+		RedTeamStances:     res.RedTeamStances,
+	}
+}
+
 func (o *Orchestrator) judgeTurn(ctx context.Context, started time.Time, res *Result, personas []persona.Persona, turnNo int, progress *judgeProgress) (string, bool, error) {
 	stepCtx, cancel := o.callContext(ctx, started)
 	status, done, err := o.evaluateConsensus(stepCtx, res, personas, turnNo, progress)
@@ -558,20 +1007,28 @@ func (o *Orchestrator) llmTurns(turns []Turn) []Turn {
 	return turns[len(turns)-limit:]
 }
 
-func (o *Orchestrator) generateModeratorTurn(ctx context.Context, res *Result, personas []persona.Persona, previousTurn Turn, nextSpeaker persona.Persona, turnNo int) (Turn, error) {
+func (o *Orchestrator) generateModeratorTurn(ctx context.Context, res *Result, personas []persona.Persona, previousTurn Turn, nextSpeaker persona.Persona, focusPersona persona.Persona, turnNo int) (Turn, error) {
+	stopWatch := o.watchSlowCall(ModeratorSpeakerID, o.cfg.ModeratorName)
 	out, err := o.llm.GenerateModerator(ctx, GenerateModeratorInput{
-		Problem:       res.Problem,
-		Personas:      personas,
-		Turns:         o.llmTurns(res.Turns),
-		PreviousTurn:  previousTurn,
-		NextSpeaker:   nextSpeaker,
-		CurrentTurnNo: turnNo,
-		AudienceMode:  o.cfg.AudienceMode,
+		Problem:            res.Problem,
+		Personas:           personas,
+		Turns:              o.llmTurns(res.Turns),
+		PreviousTurn:       previousTurn,
+		NextSpeaker:        nextSpeaker,
+		CurrentTurnNo:      turnNo,
+		AudienceMode:       o.cfg.AudienceMode,
+		ResponseLanguage:   o.cfg.ResponseLanguage,
+		FocusPersona:       focusPersona,
+		Observers:          persona.Observers(res.Personas),
+		SharedContext:      o.cfg.SharedContext,
+		RequestActionOwner: res.Consensus.OwnerMissing,
 	})
+	stopWatch()
 	if err != nil {
 		return Turn{}, err
 	}
-	addUsage(&res.Metrics, out.Usage)
+	o.recordUsage(&res.Metrics, out.Model, out.Usage)
+	recordSpeakerUsage(res, ModeratorSpeakerID, out.Usage)
 
 	content := strings.TrimSpace(out.Content)
 	if content == "" {
@@ -579,12 +1036,14 @@ func (o *Orchestrator) generateModeratorTurn(ctx context.Context, res *Result, p
 	}
 
 	return Turn{
-		Index:       nextTurnIndex(res.Turns),
-		SpeakerID:   ModeratorSpeakerID,
-		SpeakerName: ModeratorSpeakerName,
-		Type:        TurnTypeModerator,
-		Content:     content,
-		Timestamp:   time.Now().UTC(),
+		Index:        nextTurnIndex(res.Turns),
+		SpeakerID:    ModeratorSpeakerID,
+		SpeakerName:  o.cfg.ModeratorName,
+		Type:         TurnTypeModerator,
+		Content:      content,
+		Timestamp:    time.Now().UTC(),
+		Model:        strings.TrimSpace(out.Model),
+		ModeratorAsk: extractModeratorAsk(content),
 	}, nil
 }
 
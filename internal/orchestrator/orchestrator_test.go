@@ -18,6 +18,7 @@ type fakeLLM struct {
 	judgeCalls       int
 	judgeAtTurn      int
 	openingSpeakerID string
+	selectModel      string
 	selectDelay      time.Duration
 	turnDelay        time.Duration
 	judgeDelay       time.Duration
@@ -95,6 +96,7 @@ func (f *fakeLLM) SelectOpeningSpeaker(ctx context.Context, input SelectOpeningS
 	}
 	return SelectOpeningSpeakerOutput{
 		PersonaID: selectedID,
+		Model:     f.selectModel,
 		Usage: Usage{
 			PromptTokens:     1,
 			CompletionTokens: 1,
@@ -326,6 +328,9 @@ func TestRunUnlimitedStopsOnNoProgress(t *testing.T) {
 	if result.Status != StatusNoProgressReached {
 		t.Fatalf("unexpected status: %s", result.Status)
 	}
+	if result.StopReason != StopReasonNoProgressFlatScores {
+		t.Fatalf("unexpected stop reason: %q", result.StopReason)
+	}
 	if llm.generateCalls != 6 {
 		t.Fatalf("expected 6 persona turns, got %d", llm.generateCalls)
 	}
@@ -606,6 +611,40 @@ func TestSelectNextSpeakerPrefersExplicitDirective(t *testing.T) {
 	}
 }
 
+func TestSelectNextSpeakerResolvesNameInNextLine(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "pm", Name: "PM", Role: "product"},
+		{ID: "growth_lead", Name: "Growth Lead", Role: "growth"},
+		{ID: "growth", Name: "Growth Analyst", Role: "analytics"},
+	}
+	got, direct := selectNextSpeaker(personas, personas[0], "실험 설계를 넘깁니다.\nNEXT: Growth Lead", 0)
+	if !direct || got != 1 {
+		t.Fatalf("expected name to resolve to growth_lead (1), got %d direct=%v", got, direct)
+	}
+
+	// An exact ID still wins over a name the value merely starts with.
+	got, _ = selectNextSpeaker(personas, personas[0], "NEXT: growth", 0)
+	if got != 2 {
+		t.Fatalf("expected exact id growth (2), got %d", got)
+	}
+
+	got, _ = selectNextSpeaker(personas, personas[0], "NEXT: @growth lead, please", 0)
+	if got != 1 {
+		t.Fatalf("expected name prefix to resolve to growth_lead (1), got %d", got)
+	}
+}
+
+func TestAppendCanonicalNextSpeakerLineRepairsNameLine(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "pm", Name: "PM", Role: "product"},
+		{ID: "growth_lead", Name: "Growth Lead", Role: "growth"},
+	}
+	got := appendCanonicalNextSpeakerLine("실험을 제안합니다.\nNEXT: Growth Lead\nCLOSE: no", personas, personas[1])
+	if got != "실험을 제안합니다.\nNEXT: growth_lead\nCLOSE: no" {
+		t.Fatalf("expected name NEXT line rewritten to id, got %q", got)
+	}
+}
+
 func TestSelectNextSpeakerIgnoresAmbiguousShortAliasInPlainSentence(t *testing.T) {
 	personas := []persona.Persona{
 		{ID: "a", Name: "A", Role: "architecture"},
@@ -646,6 +685,39 @@ func TestSelectNextSpeakerAllowsShortAliasWithExplicitAtMention(t *testing.T) {
 	}
 }
 
+func TestSelectNextSpeakerWithPolicyResolvesAmbiguousHandoff(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "lead", Name: "Lead", Role: "coordination"},
+		{ID: "operator", Name: "Operator", Role: "operations", HandoffPriority: 1},
+		{ID: "security", Name: "Security", Role: "security", HandoffPriority: 5},
+		{ID: "analyst", Name: "Analyst", Role: "analytics"},
+	}
+	content := "Operator and Security, which risk blocks the rollout?"
+
+	tests := []struct {
+		policy     string
+		wantIndex  int
+		wantDirect bool
+	}{
+		{policy: AmbiguousHandoffFallback, wantIndex: 3, wantDirect: false},
+		{policy: AmbiguousHandoffFirstMentioned, wantIndex: 1, wantDirect: true},
+		{policy: AmbiguousHandoffPriority, wantIndex: 2, wantDirect: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.policy, func(t *testing.T) {
+			got, direct := selectNextSpeakerWithPolicy(personas, personas[0], content, 3, tc.policy)
+			if got != tc.wantIndex || direct != tc.wantDirect {
+				t.Fatalf("got (%d,%v), want (%d,%v)", got, direct, tc.wantIndex, tc.wantDirect)
+			}
+		})
+	}
+
+	personas[2].HandoffPriority = personas[1].HandoffPriority
+	if got, _ := selectNextSpeakerWithPolicy(personas, personas[0], content, 3, AmbiguousHandoffPriority); got != 1 {
+		t.Fatalf("expected priority tie to go to first mentioned persona, got %d", got)
+	}
+}
+
 func TestRunAppendsCanonicalNextSpeakerLineOnFallback(t *testing.T) {
 	personas := []persona.Persona{
 		{ID: "a", Name: "A", Role: "architecture"},
@@ -672,6 +744,23 @@ func TestRunAppendsCanonicalNextSpeakerLineOnFallback(t *testing.T) {
 	}
 }
 
+func TestAppendCanonicalNextSpeakerLineSkipsUnknownPersona(t *testing.T) {
+	personas := testPersonas()
+
+	got := appendCanonicalNextSpeakerLine("open question for the team", personas, persona.Persona{Name: "Ghost"})
+	if got != "open question for the team" {
+		t.Fatalf("expected no NEXT line for empty persona id, got %q", got)
+	}
+	got = appendCanonicalNextSpeakerLine("open question for the team", personas, persona.Persona{ID: "ghost"})
+	if strings.Contains(got, "NEXT:") {
+		t.Fatalf("expected no NEXT line for persona outside roster, got %q", got)
+	}
+	got = appendCanonicalNextSpeakerLine("open question for the team", personas, personas[1])
+	if !strings.HasSuffix(got, "\nNEXT: o") {
+		t.Fatalf("expected NEXT line for roster persona, got %q", got)
+	}
+}
+
 func TestRunDirectHandoffModeJudgesEveryTurn(t *testing.T) {
 	personas := []persona.Persona{
 		{ID: "a", Name: "A", Role: "architecture"},
@@ -702,6 +791,9 @@ func TestRunDirectHandoffModeJudgesEveryTurn(t *testing.T) {
 	if result.Status != StatusNoProgressReached {
 		t.Fatalf("expected status=%s, got %s", StatusNoProgressReached, result.Status)
 	}
+	if result.StopReason != StopReasonNoProgressFlatScores {
+		t.Fatalf("unexpected stop reason: %q", result.StopReason)
+	}
 	if llm.generateCalls != 4 {
 		t.Fatalf("expected 4 persona turns with per-turn judging in direct mode, got %d", llm.generateCalls)
 	}
@@ -710,6 +802,38 @@ func TestRunDirectHandoffModeJudgesEveryTurn(t *testing.T) {
 	}
 }
 
+func TestRunDirectHandoffRecordsHandoffLoopStopReason(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Name: "A", Role: "architecture"},
+		{ID: "b", Name: "B", Role: "operations"},
+		{ID: "c", Name: "C", Role: "analytics"},
+	}
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "a",
+		turnBySpeakerID: map[string]string{
+			"a": "의견 A\nNEXT: b\nCLOSE: no\nNEW_POINT: yes",
+			"b": "의견 B\nNEXT: c\nCLOSE: no\nNEW_POINT: yes",
+			"c": "의견 C\nNEXT: a\nCLOSE: no\nNEW_POINT: yes",
+		},
+	}
+	orch := New(llm, Config{
+		MaxTurns:                0,
+		ConsensusThreshold:      0.75,
+		MaxNoProgressJudges:     10,
+		NoProgressEpsilon:       0.0001,
+		DirectHandoffJudgeEvery: 1,
+	})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusNoProgressReached || result.StopReason != StopReasonNoProgressHandoffLoop {
+		t.Fatalf("expected handoff-loop stop, got status=%s reason=%q", result.Status, result.StopReason)
+	}
+}
+
 func TestRunDirectHandoffDefaultJudgeCadenceIsEveryOtherTurn(t *testing.T) {
 	personas := []persona.Persona{
 		{ID: "a", Name: "A", Role: "architecture"},
@@ -776,6 +900,9 @@ func TestRunDirectHandoffCanStopByCloseSignals(t *testing.T) {
 	if result.Status != StatusNoProgressReached {
 		t.Fatalf("expected status=%s from close/new-point stop, got %s", StatusNoProgressReached, result.Status)
 	}
+	if result.StopReason != StopReasonNoProgressCloseSignals {
+		t.Fatalf("unexpected stop reason: %q", result.StopReason)
+	}
 	if llm.generateCalls != 3 {
 		t.Fatalf("expected early stop after 3 persona turns, got %d", llm.generateCalls)
 	}
@@ -803,6 +930,152 @@ func TestRunIgnoresOpeningSpeakerSelectionByName(t *testing.T) {
 	}
 }
 
+func TestRunRecordsKeywordFallbackWhenSelectorReturnsUnknownID(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "mkt", Name: "Marketing Lead", Role: "growth messaging and user acquisition"},
+		{ID: "sec", Name: "Security Analyst", Role: "security incident response and threat modeling"},
+	}
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "ghost",
+	}
+	var events []Event
+	orch := New(llm, Config{
+		MaxTurns:           1,
+		ConsensusThreshold: 0.75,
+		OnEvent:            func(e Event) { events = append(events, e) },
+	})
+
+	result, err := orch.Run(context.Background(), "How should we design security incident response playbooks?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.OpeningSpeakerSource != OpeningSpeakerSourceKeywordFallback {
+		t.Fatalf("expected opening speaker source %q, got %q", OpeningSpeakerSourceKeywordFallback, result.OpeningSpeakerSource)
+	}
+	if result.Turns[0].SpeakerID != "sec" {
+		t.Fatalf("expected keyword default opening speaker 'sec', got %q", result.Turns[0].SpeakerID)
+	}
+	if len(events) != 1 || events[0].Type != EventOpeningSpeakerSelected || events[0].Detail != OpeningSpeakerSourceKeywordFallback {
+		t.Fatalf("expected one opening speaker event with keyword_fallback, got %+v", events)
+	}
+}
+
+func TestRunPinnedOpeningSpeakerSkipsSelector(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "a",
+	}
+	orch := New(llm, Config{MaxTurns: 1, ConsensusThreshold: 0.75, OpeningSpeakerID: " O "})
+
+	result, err := orch.Run(context.Background(), "generic topic", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.selectCalls != 0 {
+		t.Fatalf("expected no selector calls with a pinned opener, got %d", llm.selectCalls)
+	}
+	if result.Turns[0].SpeakerID != "o" || result.OpeningSpeakerSource != OpeningSpeakerSourcePinned {
+		t.Fatalf("expected pinned opener 'o', got %q (source %q)", result.Turns[0].SpeakerID, result.OpeningSpeakerSource)
+	}
+}
+
+func TestRunUnknownPinnedOpeningSpeakerFallsBackToSelector(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "o",
+	}
+	orch := New(llm, Config{MaxTurns: 1, ConsensusThreshold: 0.75, OpeningSpeakerID: "ghost"})
+
+	result, err := orch.Run(context.Background(), "generic topic", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.selectCalls != 1 || result.OpeningSpeakerSource != OpeningSpeakerSourceModel {
+		t.Fatalf("expected selector to choose the opener, calls=%d source=%q", llm.selectCalls, result.OpeningSpeakerSource)
+	}
+}
+
+func TestWeightedRotationFavorsHeavierPersona(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Name: "A", Role: "r1", Weight: 3},
+		{ID: "b", Name: "B", Role: "r2"},
+		{ID: "c", Name: "C", Role: "r3"},
+	}
+	rotation := newWeightedRotation(personas)
+	counts := make(map[string]int)
+	current := 0
+	for i := 0; i < 12; i++ {
+		next, slot := rotation.next(current, (current+1)%len(personas))
+		if next == current {
+			t.Fatalf("rotation repeated speaker %d at step %d", current, i)
+		}
+		rotation.advance(slot)
+		current = next
+		counts[personas[current].ID]++
+	}
+	if counts["a"] != 6 || counts["b"] != 3 || counts["c"] != 3 {
+		t.Fatalf("unexpected fallback turn counts: %v", counts)
+	}
+
+	if newWeightedRotation(testPersonas()) != nil {
+		t.Fatal("expected nil rotation for default weights")
+	}
+}
+
+func TestWeightedRotationClampsOversizedWeights(t *testing.T) {
+	rotation := newWeightedRotation([]persona.Persona{
+		{ID: "a", Name: "A", Role: "r1", Weight: 1 << 62},
+		{ID: "b", Name: "B", Role: "r2", Weight: 1 << 62},
+	})
+	if got, want := len(rotation.schedule), 2*persona.MaxWeight; got != want {
+		t.Fatalf("expected schedule of %d slots, got %d", want, got)
+	}
+}
+
+func TestRunWeightedFallbackKeepsExplicitHandoffs(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Name: "A", Role: "r1", Weight: 3},
+		{ID: "b", Name: "B", Role: "r2"},
+		{ID: "c", Name: "C", Role: "r3"},
+	}
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "b",
+		turnBySpeakerID:  map[string]string{"b": "의견 B\nNEXT: c"},
+	}
+	orch := New(llm, Config{MaxTurns: 8, ModeratorMode: ModeratorModeNever})
+	result, err := orch.Run(context.Background(), "generic topic", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	var order []string
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona {
+			order = append(order, turn.SpeakerID)
+		}
+	}
+	if got := strings.Join(order, ","); got != "b,c,a,b,c,a,c,a" {
+		t.Fatalf("unexpected speaker order: %s", got)
+	}
+}
+
+func TestRunRecordsModelOpeningSpeakerSource(t *testing.T) {
+	llm := &fakeLLM{
+		judgeAtTurn:      999,
+		openingSpeakerID: "o",
+	}
+	orch := New(llm, Config{MaxTurns: 1, ConsensusThreshold: 0.75})
+
+	result, err := orch.Run(context.Background(), "generic topic", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.OpeningSpeakerSource != OpeningSpeakerSourceModel {
+		t.Fatalf("expected opening speaker source %q, got %q", OpeningSpeakerSourceModel, result.OpeningSpeakerSource)
+	}
+}
+
 func TestFinalizeStatusDowngradesToTokenLimitWhenFinalModeratorExceedsCap(t *testing.T) {
 	llm := &fakeLLM{
 		judgeAtTurn: 999,
@@ -857,3 +1130,85 @@ func TestShouldJudgeDirectHandoff(t *testing.T) {
 		t.Fatal("expected fallback to every-turn when cadence is invalid")
 	}
 }
+
+func TestRunUsesConfiguredModeratorName(t *testing.T) {
+	for _, tc := range []struct {
+		configured string
+		want       string
+	}{
+		{configured: "", want: ModeratorSpeakerName},
+		{configured: " Moderator ", want: "Moderator"},
+	} {
+		llm := &fakeLLM{judgeAtTurn: 999}
+		orch := New(llm, Config{MaxTurns: 2, ModeratorName: tc.configured})
+		result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		moderatorTurns := 0
+		for _, turn := range result.Turns {
+			if turn.Type != TurnTypeModerator {
+				continue
+			}
+			moderatorTurns++
+			if turn.SpeakerName != tc.want {
+				t.Fatalf("expected moderator name %q, got %q", tc.want, turn.SpeakerName)
+			}
+		}
+		if moderatorTurns == 0 {
+			t.Fatal("expected at least one moderator turn")
+		}
+	}
+}
+
+type observerRecordingLLM struct {
+	fakeLLM
+	moderatorObservers []persona.Persona
+	judgeObservers     []persona.Persona
+}
+
+func (r *observerRecordingLLM) GenerateModerator(ctx context.Context, input GenerateModeratorInput) (GenerateModeratorOutput, error) {
+	r.moderatorObservers = input.Observers
+	for _, p := range input.Personas {
+		if p.Observer {
+			return GenerateModeratorOutput{}, fmt.Errorf("observer %s passed as speaker", p.ID)
+		}
+	}
+	return r.fakeLLM.GenerateModerator(ctx, input)
+}
+
+func (r *observerRecordingLLM) JudgeConsensus(ctx context.Context, input JudgeConsensusInput) (JudgeConsensusOutput, error) {
+	r.judgeObservers = input.Observers
+	return r.fakeLLM.JudgeConsensus(ctx, input)
+}
+
+func TestRunNeverGivesObserversATurn(t *testing.T) {
+	personas := append(testPersonas(), persona.Persona{ID: "customer", Name: "Customer", Role: "stakeholder", Observer: true})
+	llm := &observerRecordingLLM{fakeLLM: fakeLLM{judgeAtTurn: 999, openingSpeakerID: "customer"}}
+	orch := New(llm, Config{MaxTurns: 6})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	personaTurns := 0
+	for _, turn := range result.Turns {
+		if turn.SpeakerID == "customer" {
+			t.Fatalf("observer took a turn: %+v", turn)
+		}
+		if turn.Type == TurnTypePersona {
+			personaTurns++
+		}
+	}
+	if personaTurns != 6 {
+		t.Fatalf("expected 6 persona turns, got %d", personaTurns)
+	}
+	if len(result.Personas) != len(personas) {
+		t.Fatalf("expected observers to stay in result personas, got %d", len(result.Personas))
+	}
+	if len(llm.moderatorObservers) != 1 || llm.moderatorObservers[0].ID != "customer" {
+		t.Fatalf("expected observer in moderator input, got %+v", llm.moderatorObservers)
+	}
+	if len(llm.judgeObservers) != 1 || llm.judgeObservers[0].ID != "customer" {
+		t.Fatalf("expected observer in judge input, got %+v", llm.judgeObservers)
+	}
+}
@@ -0,0 +1,132 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// PollResult holds independent persona answers collected without a debate.
+// Answers follow persona order regardless of completion order.
+type PollResult struct {
+	Problem   string            `json:"problem"`
+	Personas  []persona.Persona `json:"personas"`
+	Answers   []Turn            `json:"answers"`
+	Metrics   Metrics           `json:"metrics"`
+	StartedAt time.Time         `json:"started_at"`
+	EndedAt   time.Time         `json:"ended_at"`
+}
+
+// Poll asks every persona for an independent answer to the problem. Calls do
+// not see each other's output, so up to Config.PollConcurrency of them run at once.
+func (o *Orchestrator) Poll(ctx context.Context, problem string, personas []persona.Persona) (PollResult, error) {
+	started := time.Now().UTC()
+	res := PollResult{
+		Problem:   strings.TrimSpace(problem),
+		StartedAt: started,
+	}
+	finish := func() {
+		res.EndedAt = time.Now().UTC()
+		res.Metrics.LatencyMS = time.Since(started).Milliseconds()
+	}
+	if o == nil || isNilLLMClient(o.llm) {
+		finish()
+		return res, errors.New("llm client is required")
+	}
+	if res.Problem == "" {
+		finish()
+		return res, errors.New("problem must not be empty")
+	}
+
+	normalized, err := persona.NormalizeAndValidate(personas)
+	if err != nil {
+		finish()
+		return res, fmt.Errorf("invalid personas: %w", err)
+	}
+	// Observers never speak, so they do not answer polls either.
+	normalized = persona.Speakers(normalized)
+	res.Personas = normalized
+
+	pollCtx, cancel := o.callContext(ctx, started)
+	defer cancel()
+	pollCtx, cancelPoll := context.WithCancel(pollCtx)
+	defer cancelPoll()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	answers := make([]Turn, len(normalized))
+	sem := make(chan struct{}, o.cfg.PollConcurrency)
+
+	for i, speaker := range normalized {
+		select {
+		case sem <- struct{}{}:
+		case <-pollCtx.Done():
+		}
+		if pollCtx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, speaker persona.Persona) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			out, err := o.llm.GenerateTurn(pollCtx, GenerateTurnInput{
+				Problem:          res.Problem,
+				Personas:         normalized,
+				Speaker:          speaker,
+				AudienceMode:     o.cfg.AudienceMode,
+				ResponseLanguage: o.cfg.ResponseLanguage,
+			})
+			content := ""
+			if err == nil {
+				content = strings.TrimSpace(out.Content)
+				if content == "" {
+					err = fmt.Errorf("poll answer from %s was empty", speaker.ID)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			o.recordUsage(&res.Metrics, out.Model, out.Usage)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("poll %s: %w", speaker.ID, err)
+					cancelPoll()
+				}
+				return
+			}
+			answers[i] = Turn{
+				Index:       i + 1,
+				SpeakerID:   speaker.ID,
+				SpeakerName: persona.DisplayName(speaker),
+				Type:        TurnTypePersona,
+				Content:     content,
+				Timestamp:   time.Now().UTC(),
+			}
+		}(i, speaker)
+	}
+	wg.Wait()
+
+	if firstErr == nil {
+		if err := pollCtx.Err(); err != nil {
+			firstErr = fmt.Errorf("poll canceled: %w", err)
+		}
+	}
+	res.Answers = make([]Turn, 0, len(answers))
+	for _, answer := range answers {
+		if answer.SpeakerID != "" {
+			res.Answers = append(res.Answers, answer)
+		}
+	}
+	finish()
+	return res, firstErr
+}
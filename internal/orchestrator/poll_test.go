@@ -0,0 +1,110 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"debate/internal/persona"
+)
+
+type pollLLM struct {
+	fakeLLM
+	mu          sync.Mutex
+	calls       int
+	inFlight    int
+	maxInFlight int
+	delay       time.Duration
+}
+
+func (p *pollLLM) GenerateTurn(ctx context.Context, input GenerateTurnInput) (GenerateTurnOutput, error) {
+	p.mu.Lock()
+	p.calls++
+	p.inFlight++
+	if p.inFlight > p.maxInFlight {
+		p.maxInFlight = p.inFlight
+	}
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.inFlight--
+		p.mu.Unlock()
+	}()
+
+	if err := waitWithContext(ctx, p.delay); err != nil {
+		return GenerateTurnOutput{}, err
+	}
+	if len(input.Turns) != 0 {
+		return GenerateTurnOutput{}, errors.New("poll answers must not see other turns")
+	}
+	return GenerateTurnOutput{
+		Content: "answer from " + input.Speaker.ID,
+		Usage:   Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+	}, nil
+}
+
+func pollPersonas() []persona.Persona {
+	return []persona.Persona{
+		{ID: "a", Name: "A", Role: "architecture"},
+		{ID: "b", Name: "B", Role: "operations"},
+		{ID: "c", Name: "C", Role: "security"},
+		{ID: "d", Name: "D", Role: "finance"},
+		{ID: "e", Name: "E", Role: "product"},
+	}
+}
+
+func TestPollCollectsAnswersConcurrentlyInPersonaOrder(t *testing.T) {
+	llm := &pollLLM{delay: 20 * time.Millisecond}
+	orch := New(llm, Config{PollConcurrency: 3})
+
+	result, err := orch.Poll(context.Background(), "topic", pollPersonas())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(result.Answers) != 5 {
+		t.Fatalf("expected 5 answers, got %d", len(result.Answers))
+	}
+	for i, p := range pollPersonas() {
+		if result.Answers[i].SpeakerID != p.ID || result.Answers[i].Index != i+1 {
+			t.Fatalf("expected answer %d from %q, got %+v", i, p.ID, result.Answers[i])
+		}
+	}
+	if result.Metrics.TotalTokens != 75 || result.Metrics.PromptTokens != 50 || result.Metrics.CompletionTokens != 25 {
+		t.Fatalf("expected summed usage 50/25/75, got %+v", result.Metrics)
+	}
+	if llm.maxInFlight < 2 || llm.maxInFlight > 3 {
+		t.Fatalf("expected 2..3 concurrent calls, got %d", llm.maxInFlight)
+	}
+}
+
+func TestPollDefaultsToSequentialCalls(t *testing.T) {
+	llm := &pollLLM{delay: time.Millisecond}
+	orch := New(llm, Config{})
+
+	if _, err := orch.Poll(context.Background(), "topic", pollPersonas()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.maxInFlight != 1 {
+		t.Fatalf("expected sequential polling, got %d concurrent calls", llm.maxInFlight)
+	}
+}
+
+func TestPollStopsIssuingCallsAfterCancel(t *testing.T) {
+	llm := &pollLLM{delay: time.Second}
+	orch := New(llm, Config{PollConcurrency: 2})
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	result, err := orch.Poll(ctx, "topic", pollPersonas())
+	if err == nil {
+		t.Fatal("expected cancellation error")
+	}
+	if llm.calls != 2 {
+		t.Fatalf("expected only the first 2 calls to be issued, got %d", llm.calls)
+	}
+	if len(result.Answers) != 0 {
+		t.Fatalf("expected no answers, got %d", len(result.Answers))
+	}
+}
@@ -0,0 +1,68 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sort"
+
+	"debate/internal/persona"
+	"debate/internal/textsim"
+)
+
+// MaxPanelPersonasPerRole caps how many personas sharing one role (compared
+// case-insensitively) RecommendPanel picks while other roles remain.
+const MaxPanelPersonasPerRole = 2
+
+// RecommendPanel picks targetSize speaking personas from available for
+// problem, offline. Personas are ranked with the same keyword relevance
+// score used for the opening speaker, ties keeping roster order, and no more
+// than MaxPanelPersonasPerRole share a role. When the cap leaves the panel
+// short, the best remaining personas fill it regardless of role. Observers
+// are never recommended. The result keeps the ranking order.
+func RecommendPanel(problem string, available []persona.Persona, targetSize int) ([]persona.Persona, error) {
+	speakers := persona.Speakers(available)
+	if targetSize < persona.MinPersonas {
+		return nil, fmt.Errorf("panel size must be at least %d, got %d", persona.MinPersonas, targetSize)
+	}
+	if targetSize > len(speakers) {
+		return nil, fmt.Errorf("panel size %d exceeds the %d speaking personas available", targetSize, len(speakers))
+	}
+
+	problemSet := textsim.TokenSet(problem)
+	problemCompact := compactLower(problem)
+	type ranked struct {
+		persona persona.Persona
+		score   int
+	}
+	candidates := make([]ranked, len(speakers))
+	for i, p := range speakers {
+		candidates[i] = ranked{persona: p, score: openingSpeakerScore(problemSet, problemCompact, p)}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	panel := make([]persona.Persona, 0, targetSize)
+	picked := make([]bool, len(candidates))
+	perRole := make(map[string]int)
+	for i, c := range candidates {
+		if len(panel) == targetSize {
+			break
+		}
+		role := normalizeMatchKey(c.persona.Role)
+		if perRole[role] >= MaxPanelPersonasPerRole {
+			continue
+		}
+		perRole[role]++
+		picked[i] = true
+		panel = append(panel, c.persona)
+	}
+	for i, c := range candidates {
+		if len(panel) == targetSize {
+			break
+		}
+		if !picked[i] {
+			panel = append(panel, c.persona)
+		}
+	}
+	return panel, nil
+}
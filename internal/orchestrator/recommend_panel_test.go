@@ -0,0 +1,74 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func recommendTestLibrary() []persona.Persona {
+	return []persona.Persona{
+		{ID: "pm", Name: "PM", Role: "product", Expertise: []string{"roadmap"}},
+		{ID: "sre1", Name: "SRE One", Role: "reliability", Expertise: []string{"outage", "latency"}},
+		{ID: "sre2", Name: "SRE Two", Role: "reliability", Expertise: []string{"outage", "paging"}},
+		{ID: "sre3", Name: "SRE Three", Role: "reliability", Expertise: []string{"outage", "latency"}},
+		{ID: "sec", Name: "Security", Role: "security", Expertise: []string{"latency"}},
+		{ID: "note", Name: "Note Taker", Role: "reliability", Expertise: []string{"outage"}, Observer: true},
+		{ID: "ux", Name: "UX", Role: "design"},
+	}
+}
+
+func panelIDs(panel []persona.Persona) string {
+	ids := make([]string, len(panel))
+	for i, p := range panel {
+		ids[i] = p.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestRecommendPanelPrefersRelevantPersonasWithRoleDiversity(t *testing.T) {
+	panel, err := RecommendPanel("checkout outage and latency spikes", recommendTestLibrary(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(panel) != 3 {
+		t.Fatalf("expected 3 personas, got %d", len(panel))
+	}
+	// sre2 outranks sec by roster order but would be a third reliability
+	// persona, so the role cap lets the security persona in.
+	if got := panelIDs(panel); got != "sre1,sre3,sec" {
+		t.Fatalf("unexpected panel order: %s", got)
+	}
+	roles := map[string]int{}
+	for _, p := range panel {
+		roles[p.Role]++
+		if p.Observer {
+			t.Fatalf("observer %q must not be recommended", p.ID)
+		}
+	}
+	if roles["reliability"] > MaxPanelPersonasPerRole {
+		t.Fatalf("expected at most %d reliability personas, got %v", MaxPanelPersonasPerRole, roles)
+	}
+	if roles["security"] != 1 {
+		t.Fatalf("expected the relevant security persona, got %s", panelIDs(panel))
+	}
+}
+
+func TestRecommendPanelFillsBeyondRoleCapAndValidatesSize(t *testing.T) {
+	library := recommendTestLibrary()
+	panel, err := RecommendPanel("outage", library, 6)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(panel) != 6 {
+		t.Fatalf("expected the panel to be filled to 6, got %s", panelIDs(panel))
+	}
+
+	if _, err := RecommendPanel("outage", library, 1); err == nil {
+		t.Fatal("expected an error below the minimum panel size")
+	}
+	if _, err := RecommendPanel("outage", library, 7); err == nil || !strings.Contains(err.Error(), "6 speaking personas") {
+		t.Fatalf("expected an error above the speaking persona count, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package orchestrator
+
+import (
+	"math/rand"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// Red-team camps recorded in Result.RedTeamStances.
+const (
+	RedTeamSupport = "support"
+	RedTeamOppose  = "oppose"
+)
+
+// assignRedTeamStances splits speakers into two camps by a seeded shuffle:
+// the first half (rounded up) supports, the rest oppose. A zero seed is
+// replaced by a time-based one; the seed used is returned so the split can
+// be reproduced.
+func assignRedTeamStances(speakers []persona.Persona, seed int64) (map[string]string, int64) {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	order := make([]int, len(speakers))
+	for i := range order {
+		order[i] = i
+	}
+	rand.New(rand.NewSource(seed)).Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	supporters := (len(speakers) + 1) / 2
+	stances := make(map[string]string, len(speakers))
+	for rank, index := range order {
+		camp := RedTeamOppose
+		if rank < supporters {
+			camp = RedTeamSupport
+		}
+		stances[speakers[index].ID] = camp
+	}
+	return stances, seed
+}
@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+type stanceRecordingLLM struct {
+	fakeLLM
+	stances      map[string]string
+	judgeStances map[string]string
+}
+
+func (f *stanceRecordingLLM) GenerateTurn(ctx context.Context, input GenerateTurnInput) (GenerateTurnOutput, error) {
+	f.stances[input.Speaker.ID] = input.RedTeamStance
+	return f.fakeLLM.GenerateTurn(ctx, input)
+}
+
+func (f *stanceRecordingLLM) JudgeConsensus(ctx context.Context, input JudgeConsensusInput) (JudgeConsensusOutput, error) {
+	f.judgeStances = input.RedTeamStances
+	return f.fakeLLM.JudgeConsensus(ctx, input)
+}
+
+func TestRedTeamAssignsOpposingStancesAndRecordsThem(t *testing.T) {
+	personas := append(testPersonas(),
+		persona.Persona{ID: "s", Name: "Security", Role: "security"},
+		persona.Persona{ID: "f", Name: "Finance", Role: "finance"},
+	)
+	llm := &stanceRecordingLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}, stances: map[string]string{}}
+
+	result, err := New(llm, Config{MaxTurns: 4, RedTeam: true, RedTeamSeed: 7}).Run(context.Background(), "Should we adopt the new queue?", personas, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.RedTeamSeed != 7 || len(result.RedTeamStances) != 4 {
+		t.Fatalf("expected the assignment to be recorded, got seed=%d stances=%v", result.RedTeamSeed, result.RedTeamStances)
+	}
+	camps := map[string]int{}
+	for _, camp := range result.RedTeamStances {
+		camps[camp]++
+	}
+	if camps[RedTeamSupport] != 2 || camps[RedTeamOppose] != 2 {
+		t.Fatalf("expected two personas per camp, got %v", result.RedTeamStances)
+	}
+	for id, camp := range llm.stances {
+		if camp != result.RedTeamStances[id] {
+			t.Fatalf("expected turn input for %s to carry %q, got %q", id, result.RedTeamStances[id], camp)
+		}
+	}
+	if len(llm.judgeStances) != 4 {
+		t.Fatalf("expected the judge to see the assignment, got %v", llm.judgeStances)
+	}
+
+	again, _ := assignRedTeamStances(persona.Speakers(result.Personas), 7)
+	for id, camp := range again {
+		if result.RedTeamStances[id] != camp {
+			t.Fatalf("expected the same seed to reproduce the split, got %v vs %v", again, result.RedTeamStances)
+		}
+	}
+}
+
+func TestRedTeamOffKeepsConfiguredStances(t *testing.T) {
+	llm := &stanceRecordingLLM{fakeLLM: fakeLLM{judgeAtTurn: 999}, stances: map[string]string{}}
+	result, err := New(llm, Config{MaxTurns: 2}).Run(context.Background(), "Should we adopt the new queue?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.RedTeamStances != nil || result.RedTeamSeed != 0 {
+		t.Fatalf("expected no red-team assignment, got %v", result.RedTeamStances)
+	}
+	for id, camp := range llm.stances {
+		if camp != "" {
+			t.Fatalf("expected no stance override for %s, got %q", id, camp)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// ResumeFromResult continues a finished debate, typically one that stopped
+// at max_turns_reached, for up to additionalTurns more persona turns
+// (<= 0 keeps Config.MaxTurns). The prior transcript, personas, consensus
+// and usage carry over: new turns are numbered after the prior ones, token
+// and cost limits count the prior usage, and the duration limit counts the
+// prior latency. The next speaker is picked from the last persona turn's
+// handoff, as the live loop would have. The prior wrap-up is dropped, since
+// the resumed run ends with a new one; its token usage still counts.
+func (o *Orchestrator) ResumeFromResult(ctx context.Context, prior Result, additionalTurns int, onTurn func(Turn)) (Result, error) {
+	started := time.Now().UTC().Add(-time.Duration(prior.Metrics.LatencyMS) * time.Millisecond)
+	res := prior
+	res.Problem = strings.TrimSpace(prior.Problem)
+	res.Turns = append([]Turn(nil), prior.Turns...)
+	if prior.PerSpeaker != nil {
+		res.PerSpeaker = make(map[string]SpeakerMetrics, len(prior.PerSpeaker))
+		for id, m := range prior.PerSpeaker {
+			res.PerSpeaker[id] = m
+		}
+	}
+	dropFinalModeratorTurn(&res)
+	res.Status = ""
+	res.StopReason = ""
+	if res.StartedAt.IsZero() {
+		res.StartedAt = started
+	}
+	if o == nil || isNilLLMClient(o.llm) {
+		finalizeResult(&res, started, StatusError)
+		return res, errors.New("llm client is required")
+	}
+	if res.Problem == "" {
+		finalizeResult(&res, started, StatusError)
+		return res, errors.New("prior result has no problem")
+	}
+
+	normalized, err := persona.NormalizeAndValidate(prior.Personas)
+	if err != nil {
+		finalizeResult(&res, started, StatusError)
+		return res, fmt.Errorf("invalid personas: %w", err)
+	}
+	res.Personas = normalized
+	speakers := persona.Speakers(normalized)
+	if len(res.SpeakerColors) == 0 {
+		res.SpeakerColors = AssignSpeakerColors(normalized)
+	}
+
+	resumed := o
+	if additionalTurns > 0 {
+		resumed = New(o.llm, o.cfg)
+		resumed.cfg.MaxTurns = additionalTurns
+	}
+
+	nextIndex, ok := resumeSpeakerIndex(res.Turns, speakers, resumed.cfg.AmbiguousHandoffPolicy)
+	if !ok {
+		var stopStatus string
+		var shouldStop bool
+		nextIndex, stopStatus, shouldStop = resumed.chooseOpeningSpeakerIndex(ctx, started, &res, speakers)
+		if shouldStop {
+			return resumed.finalizeWithModerator(ctx, &res, started, stopStatus, onTurn)
+		}
+	}
+	return resumed.runDebateLoop(ctx, started, &res, speakers, nextIndex, onTurn)
+}
+
+// resumeSpeakerIndex applies the handoff in the last persona turn of turns;
+// false means no persona has spoken yet.
+func resumeSpeakerIndex(turns []Turn, speakers []persona.Persona, policy string) (int, bool) {
+	for i := len(turns) - 1; i >= 0; i-- {
+		turn := turns[i]
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		current := findPersonaIndex(speakers, turn.SpeakerID)
+		if current < 0 {
+			continue
+		}
+		next, _ := selectNextSpeakerWithPolicy(speakers, speakers[current], turn.Content, (current+1)%len(speakers), policy)
+		return next, next >= 0
+	}
+	return 0, false
+}
+
+// dropFinalModeratorTurn removes the trailing wrap-up that finalizeWithModerator
+// appended to a finished result. A model-written wrap-up also stops counting
+// as a moderator turn in PerSpeaker.
+func dropFinalModeratorTurn(res *Result) {
+	if len(res.Turns) == 0 {
+		return
+	}
+	last := res.Turns[len(res.Turns)-1]
+	if last.Type != TurnTypeModerator {
+		return
+	}
+	res.Turns = res.Turns[:len(res.Turns)-1]
+	if last.Model == "" {
+		return
+	}
+	if m, ok := res.PerSpeaker[last.SpeakerID]; ok && m.Turns > 0 {
+		m.Turns--
+		res.PerSpeaker[last.SpeakerID] = m
+	}
+}
@@ -0,0 +1,108 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestResumeFromResultContinuesNumberingAndMetrics(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Name: "A", Role: "architecture"},
+		{ID: "b", Name: "B", Role: "operations"},
+		{ID: "x", Name: "X", Role: "analytics"},
+	}
+	prior := Result{
+		Problem:  "How do we reduce incidents?",
+		Personas: personas,
+		Turns: []Turn{
+			{Index: 1, Type: TurnTypePersona, SpeakerID: "a", SpeakerName: "A", Content: "Start with alerts.\nNEXT: x"},
+			{Index: 2, Type: TurnTypeModerator, SpeakerID: ModeratorSpeakerID, SpeakerName: "Moderator", Content: "wrap-up"},
+		},
+		Status: StatusMaxTurnsReached,
+		Metrics: Metrics{
+			PromptTokens:     100,
+			CompletionTokens: 50,
+			TotalTokens:      150,
+			LatencyMS:        5000,
+		},
+	}
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{MaxTurns: 10, ConsensusThreshold: 0.75})
+
+	var streamed []Turn
+	result, err := orch.ResumeFromResult(context.Background(), prior, 1, func(turn Turn) {
+		streamed = append(streamed, turn)
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if llm.selectCalls != 0 {
+		t.Fatalf("expected no opening-speaker selection on resume, got %d calls", llm.selectCalls)
+	}
+	if llm.generateCalls != 1 {
+		t.Fatalf("expected additionalTurns=1 to cap the run at one persona turn, got %d", llm.generateCalls)
+	}
+	if len(result.Turns) != 3 {
+		t.Fatalf("expected prior persona turn + persona + final moderator, got %d", len(result.Turns))
+	}
+	if len(prior.Turns) != 2 {
+		t.Fatalf("expected prior result to be left untouched, got %d turns", len(prior.Turns))
+	}
+	for _, turn := range result.Turns {
+		if turn.Content == "wrap-up" {
+			t.Fatalf("expected the prior wrap-up to be dropped, got %+v", result.Turns)
+		}
+	}
+	if last := result.Turns[2]; last.Type != TurnTypeModerator {
+		t.Fatalf("expected the new wrap-up to end the transcript, got %+v", last)
+	}
+	resumed := result.Turns[1]
+	if resumed.SpeakerID != "x" {
+		t.Fatalf("expected NEXT handoff from last persona turn to pick 'x', got %q", resumed.SpeakerID)
+	}
+	if resumed.Index != 2 || result.Turns[2].Index != 3 {
+		t.Fatalf("expected indices to continue at 2 and 3, got %d and %d", resumed.Index, result.Turns[2].Index)
+	}
+	if len(streamed) != 2 || streamed[0].Index != 2 {
+		t.Fatalf("expected only new turns to be streamed, got %+v", streamed)
+	}
+	// 15 (persona) + 4 (final judge pass) + 8 (final moderator) on top of the prior 150.
+	if result.Metrics.TotalTokens != 150+15+4+8 {
+		t.Fatalf("expected tokens to accumulate onto prior metrics, got %d", result.Metrics.TotalTokens)
+	}
+	if result.Metrics.LatencyMS < 5000 {
+		t.Fatalf("expected latency to include prior duration, got %d", result.Metrics.LatencyMS)
+	}
+	if result.Status != StatusMaxTurnsReached {
+		t.Fatalf("expected status %q, got %q", StatusMaxTurnsReached, result.Status)
+	}
+}
+
+func TestResumeFromResultCountsPriorTokensAgainstLimit(t *testing.T) {
+	prior := Result{
+		Problem:  "How do we reduce incidents?",
+		Personas: testPersonas(),
+		Turns: []Turn{
+			{Index: 1, Type: TurnTypePersona, SpeakerID: "a", SpeakerName: "Architect", Content: "opening"},
+		},
+		Metrics: Metrics{TotalTokens: 95},
+	}
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{MaxTurns: 10, MaxTotalTokens: 100, ConsensusThreshold: 0.75})
+
+	result, err := orch.ResumeFromResult(context.Background(), prior, 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if result.Status != StatusTokenLimitReached {
+		t.Fatalf("expected status %q, got %q", StatusTokenLimitReached, result.Status)
+	}
+	if llm.generateCalls != 1 {
+		t.Fatalf("expected the prior usage to stop the run after one turn, got %d", llm.generateCalls)
+	}
+	if result.Turns[1].SpeakerID != "o" {
+		t.Fatalf("expected rotation to continue with 'o', got %q", result.Turns[1].SpeakerID)
+	}
+}
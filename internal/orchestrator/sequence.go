@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"debate/internal/persona"
+)
+
+// sharedContextSummaryRunes caps each prior-debate field in the shared context.
+const sharedContextSummaryRunes = 240
+
+// SequenceOptions configures RunSequence.
+type SequenceOptions struct {
+	// MaxTotalTokens and MaxDuration cap the whole sequence when > 0. Each
+	// debate then gets whatever budget remains; otherwise the per-debate
+	// Config caps apply to every debate independently.
+	MaxTotalTokens int
+	MaxDuration    time.Duration
+	// OnTurn receives every turn together with the index of its problem.
+	OnTurn func(problemIndex int, turn Turn)
+}
+
+// RunSequence debates problems one after another with the same personas.
+// Each debate after the first sees a compact summary of the earlier
+// debates' outcomes as shared context. It stops early, without error, when
+// a sequence-wide cap is exhausted, and returns the results gathered so far
+// alongside the first run error.
+func (o *Orchestrator) RunSequence(ctx context.Context, problems []string, personas []persona.Persona, opts SequenceOptions) ([]Result, error) {
+	if o == nil || isNilLLMClient(o.llm) {
+		return nil, errors.New("llm client is required")
+	}
+	if len(problems) == 0 {
+		return nil, errors.New("at least one problem is required")
+	}
+
+	started := time.Now()
+	usedTokens := 0
+	results := make([]Result, 0, len(problems))
+	for i, problem := range problems {
+		cfg := o.cfg
+		cfg.SharedContext = buildSharedContext(results)
+		if opts.MaxTotalTokens > 0 {
+			remaining := opts.MaxTotalTokens - usedTokens
+			if remaining <= 0 {
+				break
+			}
+			cfg.MaxTotalTokens = remaining
+		}
+		if opts.MaxDuration > 0 {
+			remaining := opts.MaxDuration - time.Since(started)
+			if remaining <= 0 {
+				break
+			}
+			cfg.MaxDuration = remaining
+		}
+
+		var onTurn func(Turn)
+		if opts.OnTurn != nil {
+			problemIndex := i
+			onTurn = func(turn Turn) { opts.OnTurn(problemIndex, turn) }
+		}
+		res, err := New(o.llm, cfg).Run(ctx, problem, personas, onTurn)
+		results = append(results, res)
+		usedTokens += res.Metrics.TotalTokens
+		if err != nil {
+			return results, fmt.Errorf("problem %d: %w", i+1, err)
+		}
+	}
+	return results, nil
+}
+
+// buildSharedContext summarizes finished debates for the next one.
+func buildSharedContext(results []Result) string {
+	if len(results) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for i, res := range results {
+		b.WriteString(fmt.Sprintf("- debate %d: %s\n", i+1, clipRunes(res.Problem, sharedContextSummaryRunes)))
+		b.WriteString(fmt.Sprintf("  - status: %s, consensus score: %.2f\n", res.Status, res.Consensus.Score))
+		if summary := clipRunes(res.Consensus.Summary, sharedContextSummaryRunes); summary != "" {
+			b.WriteString("  - conclusion: " + summary + "\n")
+		}
+		if owner := strings.TrimSpace(res.Consensus.NextActionOwner); owner != "" {
+			b.WriteString("  - next action owner: " + clipRunes(owner, sharedContextSummaryRunes) + "\n")
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func clipRunes(text string, limit int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit]) + "…"
+}
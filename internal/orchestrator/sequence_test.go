@@ -0,0 +1,79 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type sharedContextRecordingLLM struct {
+	fakeLLM
+	turnContexts map[string][]string
+}
+
+func (r *sharedContextRecordingLLM) GenerateTurn(ctx context.Context, input GenerateTurnInput) (GenerateTurnOutput, error) {
+	if r.turnContexts == nil {
+		r.turnContexts = map[string][]string{}
+	}
+	r.turnContexts[input.Problem] = append(r.turnContexts[input.Problem], input.SharedContext)
+	return r.fakeLLM.GenerateTurn(ctx, input)
+}
+
+func TestRunSequenceSharesPriorConsensus(t *testing.T) {
+	llm := &sharedContextRecordingLLM{fakeLLM: fakeLLM{
+		judgeAtTurn:           3,
+		useCustomJudgeSummary: true,
+		judgeSummary:          "adopt canary releases with a 1% cohort",
+	}}
+	orch := New(llm, Config{MaxTurns: 8, ConsensusThreshold: 0.75})
+	problems := []string{"How do we reduce incidents?", "How do we staff the on-call rotation?"}
+
+	var turnProblems []int
+	results, err := orch.RunSequence(context.Background(), problems, testPersonas(), SequenceOptions{
+		OnTurn: func(problemIndex int, _ Turn) { turnProblems = append(turnProblems, problemIndex) },
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, ctx := range llm.turnContexts[problems[0]] {
+		if ctx != "" {
+			t.Fatalf("expected no shared context in the first debate, got %q", ctx)
+		}
+	}
+	second := llm.turnContexts[problems[1]]
+	if len(second) == 0 {
+		t.Fatal("expected turns in the second debate")
+	}
+	for _, ctx := range second {
+		if !strings.Contains(ctx, problems[0]) || !strings.Contains(ctx, "adopt canary releases") {
+			t.Fatalf("expected first debate's consensus in shared context, got %q", ctx)
+		}
+	}
+	if len(turnProblems) == 0 || turnProblems[0] != 0 || turnProblems[len(turnProblems)-1] != 1 {
+		t.Fatalf("unexpected problem indexes on turns: %v", turnProblems)
+	}
+}
+
+func TestRunSequenceStopsWhenTotalTokenCapIsSpent(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999}
+	orch := New(llm, Config{MaxTurns: 4})
+	results, err := orch.RunSequence(context.Background(), []string{"first", "second"}, testPersonas(), SequenceOptions{MaxTotalTokens: 20})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected the sequence to stop after the first debate, got %d results", len(results))
+	}
+	if results[0].Status != StatusTokenLimitReached {
+		t.Fatalf("expected first debate to hit the shared token cap, got %s", results[0].Status)
+	}
+}
+
+func TestRunSequenceRequiresProblems(t *testing.T) {
+	if _, err := New(&fakeLLM{}, Config{}).RunSequence(context.Background(), nil, testPersonas(), SequenceOptions{}); err == nil {
+		t.Fatal("expected error for empty problem list")
+	}
+}
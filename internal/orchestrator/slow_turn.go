@@ -0,0 +1,40 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// watchSlowCall starts a watchdog for one turn-producing LLM call. When the
+// call is still running after Config.SlowTurnNudge, an EventSlowTurn for
+// speakerID is emitted from the watchdog's goroutine; the debate itself is
+// not changed. The returned stop must be called once the call returns; no
+// event is emitted after stop returns.
+func (o *Orchestrator) watchSlowCall(speakerID string, speakerName string) (stop func()) {
+	nudge := o.cfg.SlowTurnNudge
+	if nudge <= 0 || o.cfg.OnEvent == nil {
+		return func() {}
+	}
+
+	var mu sync.Mutex
+	stopped := false
+	timer := time.AfterFunc(nudge, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		o.emitEvent(Event{
+			Type:      EventSlowTurn,
+			SpeakerID: speakerID,
+			Detail:    fmt.Sprintf("%s is taking longer than usual (over %s)", speakerName, nudge),
+		})
+	})
+	return func() {
+		timer.Stop()
+		mu.Lock()
+		stopped = true
+		mu.Unlock()
+	}
+}
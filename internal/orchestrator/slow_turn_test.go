@@ -0,0 +1,63 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunEmitsSlowTurnEventBeforeTurnCompletes(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	record := func(entry string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, entry)
+	}
+	llm := &fakeLLM{judgeAtTurn: 999, openingSpeakerID: "a", turnDelay: 50 * time.Millisecond}
+	orch := New(llm, Config{
+		MaxTurns:      1,
+		SlowTurnNudge: 5 * time.Millisecond,
+		OnEvent: func(e Event) {
+			if e.Type == EventSlowTurn {
+				record("slow:" + e.SpeakerID + ":" + e.Detail)
+			}
+		},
+	})
+
+	_, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), func(turn Turn) {
+		if turn.Type == TurnTypePersona {
+			record("turn:" + turn.SpeakerID)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) < 2 || !strings.HasPrefix(order[0], "slow:a:Architect is taking longer than usual") || order[1] != "turn:a" {
+		t.Fatalf("expected slow-turn event before the turn, got %v", order)
+	}
+}
+
+func TestRunSkipsSlowTurnEventForFastCalls(t *testing.T) {
+	var slow int
+	orch := New(&fakeLLM{judgeAtTurn: 999}, Config{
+		MaxTurns:      2,
+		SlowTurnNudge: time.Minute,
+		OnEvent: func(e Event) {
+			if e.Type == EventSlowTurn {
+				slow++
+			}
+		},
+	})
+	if _, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if slow != 0 {
+		t.Fatalf("expected no slow-turn events, got %d", slow)
+	}
+}
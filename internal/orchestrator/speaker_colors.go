@@ -0,0 +1,32 @@
+package orchestrator
+
+import "debate/internal/persona"
+
+// ModeratorColor is reserved for moderator turns; no persona is assigned it.
+const ModeratorColor = "#9a3412"
+
+// speakerPalette holds visually distinct colors handed out in persona order.
+var speakerPalette = []string{
+	"#2456d3", // cobalt
+	"#167d69", // emerald
+	"#b9682f", // amber
+	"#7b3fa0", // violet
+	"#c0392b", // red
+	"#0f7c99", // teal
+	"#5f7f1f", // olive
+	"#c2417f", // pink
+}
+
+// AssignSpeakerColors maps each speaking persona's ID to a palette color in
+// roster order, plus ModeratorSpeakerID to ModeratorColor. Colors only
+// repeat when there are more speakers than palette entries, and the mapping
+// depends on roster order alone so every renderer agrees on it.
+func AssignSpeakerColors(personas []persona.Persona) map[string]string {
+	speakers := persona.Speakers(personas)
+	colors := make(map[string]string, len(speakers)+1)
+	for i, p := range speakers {
+		colors[p.ID] = speakerPalette[i%len(speakerPalette)]
+	}
+	colors[ModeratorSpeakerID] = ModeratorColor
+	return colors
+}
@@ -0,0 +1,63 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func TestAssignSpeakerColorsGivesDistinctColorsAndReservesModerator(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "pm", Name: "PM"},
+		{ID: "risk", Name: "Risk"},
+		{ID: "watcher", Name: "Watcher", Observer: true},
+		{ID: "data", Name: "Data"},
+		{ID: "ops", Name: "Ops"},
+	}
+
+	colors := AssignSpeakerColors(personas)
+	if colors[ModeratorSpeakerID] != ModeratorColor {
+		t.Fatalf("moderator color = %q, want %q", colors[ModeratorSpeakerID], ModeratorColor)
+	}
+	if _, ok := colors["watcher"]; ok {
+		t.Fatal("expected observers to get no color")
+	}
+	seen := make(map[string]string)
+	for _, id := range []string{"pm", "risk", "data", "ops"} {
+		color := colors[id]
+		if color == "" || color == ModeratorColor {
+			t.Fatalf("persona %s got color %q", id, color)
+		}
+		if other, dup := seen[color]; dup {
+			t.Fatalf("personas %s and %s share color %s", other, id, color)
+		}
+		seen[color] = id
+	}
+}
+
+func TestAssignSpeakerColorsCyclesPastPalette(t *testing.T) {
+	personas := make([]persona.Persona, len(speakerPalette)+1)
+	for i := range personas {
+		personas[i] = persona.Persona{ID: fmt.Sprintf("p%d", i)}
+	}
+	colors := AssignSpeakerColors(personas)
+	if colors["p0"] != colors[fmt.Sprintf("p%d", len(speakerPalette))] {
+		t.Fatal("expected colors to cycle once the palette is exhausted")
+	}
+}
+
+func TestRunRecordsSpeakerColors(t *testing.T) {
+	orch := New(&fakeLLM{judgeAtTurn: 999}, Config{MaxTurns: 2})
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	want := AssignSpeakerColors(result.Personas)
+	for _, turn := range result.Turns {
+		if result.SpeakerColors[turn.SpeakerID] != want[turn.SpeakerID] || want[turn.SpeakerID] == "" {
+			t.Fatalf("turn %d speaker %s has color %q, want %q", turn.Index, turn.SpeakerID, result.SpeakerColors[turn.SpeakerID], want[turn.SpeakerID])
+		}
+	}
+}
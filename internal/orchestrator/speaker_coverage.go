@@ -0,0 +1,41 @@
+package orchestrator
+
+import (
+	"strings"
+
+	"debate/internal/persona"
+)
+
+// distinctPersonaSpeakers counts the parties that have taken a turn. Speakers
+// outside parties (personas excluded from consensus) are not counted.
+func distinctPersonaSpeakers(turns []Turn, parties []persona.Persona) int {
+	counted := make(map[string]struct{}, len(parties))
+	for _, p := range parties {
+		counted[strings.ToLower(strings.TrimSpace(p.ID))] = struct{}{}
+	}
+	seen := make(map[string]struct{})
+	for _, turn := range turns {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(turn.SpeakerID))
+		if _, ok := counted[key]; ok {
+			seen[key] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+// speakerCoverageMet reports whether enough distinct parties have spoken for
+// a consensus verdict to count. The minimum is capped at the party count so
+// a misconfigured value cannot block consensus forever.
+func (o *Orchestrator) speakerCoverageMet(turns []Turn, parties []persona.Persona) bool {
+	required := o.cfg.MinDistinctSpeakersForConsensus
+	if required > len(parties) {
+		required = len(parties)
+	}
+	if required <= 0 {
+		return true
+	}
+	return distinctPersonaSpeakers(turns, parties) >= required
+}
@@ -0,0 +1,68 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"debate/internal/persona"
+)
+
+func fivePersonas() []persona.Persona {
+	return []persona.Persona{
+		{ID: "a", Name: "Architect", Role: "architecture"},
+		{ID: "b", Name: "Builder", Role: "delivery"},
+		{ID: "c", Name: "Critic", Role: "review"},
+		{ID: "d", Name: "DBA", Role: "data"},
+		{ID: "e", Name: "Economist", Role: "cost"},
+	}
+}
+
+func TestRunWithholdsConsensusUntilMinDistinctSpeakers(t *testing.T) {
+	// Direct handoffs walk a -> b -> c -> d and back to a with a judge after
+	// every turn; e never speaks.
+	handoffs := map[string]string{
+		"a": "Ship the canary.\nNEXT: b",
+		"b": "Add a rollback drill.\nNEXT: c",
+		"c": "Gate on error budget.\nNEXT: d",
+		"d": "Snapshot the schema first.\nNEXT: a",
+	}
+
+	baseline, err := New(&fakeLLM{turnBySpeakerID: handoffs}, Config{MaxTurns: 12, DirectHandoffJudgeEvery: 1}).
+		Run(context.Background(), "How do we ship safely?", fivePersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if baseline.Status != StatusConsensusReached || distinctPersonaSpeakers(baseline.Turns, fivePersonas()) >= 4 {
+		t.Fatalf("expected baseline consensus before four speakers, got status=%s speakers=%d", baseline.Status, distinctPersonaSpeakers(baseline.Turns, fivePersonas()))
+	}
+
+	gated, err := New(&fakeLLM{turnBySpeakerID: handoffs}, Config{MaxTurns: 12, DirectHandoffJudgeEvery: 1, MinDistinctSpeakersForConsensus: 4}).
+		Run(context.Background(), "How do we ship safely?", fivePersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if gated.Status != StatusConsensusReached {
+		t.Fatalf("expected consensus once four personas spoke, got %s", gated.Status)
+	}
+	if got := distinctPersonaSpeakers(gated.Turns, fivePersonas()); got < 4 {
+		t.Fatalf("consensus declared after only %d distinct speakers", got)
+	}
+	if len(gated.Turns) <= len(baseline.Turns) {
+		t.Fatalf("expected gated run to last longer than baseline (%d <= %d turns)", len(gated.Turns), len(baseline.Turns))
+	}
+}
+
+func TestSpeakerCoverageMetCapsMinimumAtSpeakerCount(t *testing.T) {
+	orch := New(&fakeLLM{}, Config{MinDistinctSpeakersForConsensus: 9})
+	turns := []Turn{
+		{SpeakerID: "a", Type: TurnTypePersona},
+		{SpeakerID: ModeratorSpeakerID, Type: TurnTypeModerator},
+		{SpeakerID: "o", Type: TurnTypePersona},
+	}
+	if !orch.speakerCoverageMet(turns, testPersonas()) {
+		t.Fatal("expected minimum above speaker count to be capped")
+	}
+	if orch.speakerCoverageMet(turns[:2], testPersonas()) {
+		t.Fatal("expected coverage to fail with one distinct persona")
+	}
+}
@@ -0,0 +1,23 @@
+package orchestrator
+
+// SpeakerMetrics attributes turns and token usage to one speaker.
+type SpeakerMetrics struct {
+	Turns            int `json:"turns"`
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// recordSpeakerUsage counts one model-generated turn and its usage for speakerID
+// in res.PerSpeaker. The aggregate res.Metrics is recorded separately.
+func recordSpeakerUsage(res *Result, speakerID string, usage Usage) {
+	if res.PerSpeaker == nil {
+		res.PerSpeaker = make(map[string]SpeakerMetrics)
+	}
+	m := res.PerSpeaker[speakerID]
+	m.Turns++
+	m.PromptTokens += usage.PromptTokens
+	m.CompletionTokens += usage.CompletionTokens
+	m.TotalTokens += usage.TotalTokens
+	res.PerSpeaker[speakerID] = m
+}
@@ -0,0 +1,49 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRunAccumulatesPerSpeakerMetrics(t *testing.T) {
+	llm := &fakeLLM{judgeAtTurn: 999, openingSpeakerID: "a"}
+	orch := New(llm, Config{MaxTurns: 3, ConsensusThreshold: 0.75})
+
+	result, err := orch.Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	personaTurns := map[string]int{}
+	for _, turn := range result.Turns {
+		if turn.Type == TurnTypePersona {
+			personaTurns[turn.SpeakerID]++
+		}
+	}
+	for _, id := range []string{"a", "o"} {
+		got := result.PerSpeaker[id]
+		if got.Turns != personaTurns[id] || got.Turns == 0 {
+			t.Fatalf("speaker %q turns = %d, want %d", id, got.Turns, personaTurns[id])
+		}
+		if got.PromptTokens != 10*got.Turns || got.CompletionTokens != 5*got.Turns || got.TotalTokens != 15*got.Turns {
+			t.Fatalf("speaker %q usage = %+v, want 10/5/15 per turn", id, got)
+		}
+	}
+
+	moderator := result.PerSpeaker[ModeratorSpeakerID]
+	if moderator.Turns != llm.moderatorCalls+llm.finalCalls {
+		t.Fatalf("moderator turns = %d, want %d", moderator.Turns, llm.moderatorCalls+llm.finalCalls)
+	}
+	if want := 6*llm.moderatorCalls + 8*llm.finalCalls; moderator.TotalTokens != want {
+		t.Fatalf("moderator total tokens = %d, want %d", moderator.TotalTokens, want)
+	}
+
+	attributed := 0
+	for _, m := range result.PerSpeaker {
+		attributed += m.TotalTokens
+	}
+	// Opening selection and judge calls stay in the aggregate only.
+	if attributed >= result.Metrics.TotalTokens {
+		t.Fatalf("expected aggregate metrics (%d) to also include unattributed calls, got %d attributed", result.Metrics.TotalTokens, attributed)
+	}
+}
@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"math"
+	"strings"
+
+	"debate/internal/textsim"
+)
+
+// computeStanceDrift compares each persona's first and latest claim and
+// returns 1 - token overlap, keyed by speaker ID. Personas with fewer than two
+// turns are omitted since there is nothing to compare.
+func computeStanceDrift(turns []Turn) map[string]float64 {
+	first := make(map[string]string)
+	latest := make(map[string]string)
+	counts := make(map[string]int)
+	for _, turn := range turns {
+		if turn.Type != TurnTypePersona {
+			continue
+		}
+		id := strings.TrimSpace(turn.SpeakerID)
+		claim := claimText(turn.Content)
+		if id == "" || claim == "" {
+			continue
+		}
+		if _, ok := first[id]; !ok {
+			first[id] = claim
+		}
+		latest[id] = claim
+		counts[id]++
+	}
+
+	var drift map[string]float64
+	for id, count := range counts {
+		if count < 2 {
+			continue
+		}
+		if drift == nil {
+			drift = make(map[string]float64)
+		}
+		value := 1 - textsim.Similarity(first[id], latest[id])
+		drift[id] = math.Round(value*1000) / 1000
+	}
+	return drift
+}
+
+// claimText drops trailing machine directives (NEXT:, CLOSE:, NEW_POINT: ...)
+// so drift reflects what the persona argued, not the handoff bookkeeping.
+func claimText(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || isDirectiveLine(trimmed) {
+			continue
+		}
+		kept = append(kept, trimmed)
+	}
+	return strings.Join(kept, "\n")
+}
+
+func isDirectiveLine(line string) bool {
+	if strings.EqualFold(line, "HANDOFF_ASK") {
+		return true
+	}
+	end := strings.IndexAny(line, ":=")
+	if end <= 0 {
+		return false
+	}
+	for i := 0; i < end; i++ {
+		ch := line[i]
+		if (ch < 'A' || ch > 'Z') && ch != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package orchestrator
+
+import "testing"
+
+func TestComputeStanceDriftFlagsChangedPosition(t *testing.T) {
+	turns := []Turn{
+		{Index: 1, SpeakerID: "a", Type: TurnTypePersona, Content: "We should migrate the billing service to microservices now.\nNEXT: b"},
+		{Index: 2, SpeakerID: "b", Type: TurnTypePersona, Content: "Keep the monolith and harden deploys first.\nCLOSE: no"},
+		{Index: 3, SpeakerID: ModeratorSpeakerID, Type: TurnTypeModerator, Content: "What evidence would change your mind?"},
+		{Index: 4, SpeakerID: "a", Type: TurnTypePersona, Content: "Given rollback data, pausing until observability gaps close is safer.\nNEXT: b"},
+		{Index: 5, SpeakerID: "b", Type: TurnTypePersona, Content: "Keep the monolith and harden deploys first, then revisit.\nCLOSE: yes"},
+	}
+
+	drift := computeStanceDrift(turns)
+	if drift["a"] < 0.8 {
+		t.Fatalf("expected high drift for persona a, got %.3f", drift["a"])
+	}
+	if drift["b"] > 0.3 {
+		t.Fatalf("expected low drift for persona b, got %.3f", drift["b"])
+	}
+	if _, ok := drift[ModeratorSpeakerID]; ok {
+		t.Fatal("expected moderator turns to be ignored")
+	}
+}
+
+func TestComputeStanceDriftSkipsSingleTurnPersonas(t *testing.T) {
+	drift := computeStanceDrift([]Turn{
+		{Index: 1, SpeakerID: "a", Type: TurnTypePersona, Content: "only one claim"},
+	})
+	if drift != nil {
+		t.Fatalf("expected no drift entries, got %v", drift)
+	}
+}
@@ -1,6 +1,10 @@
 package orchestrator
 
-import "strings"
+import (
+	"strings"
+
+	"debate/internal/persona"
+)
 
 type turnTerminationSignal struct {
 	closeVote         *bool
@@ -15,11 +19,24 @@ type terminationSignalTracker struct {
 	observedPersonaTurns int
 	hasPersuasionSignal  bool
 	hasExperimentSignal  bool
+	// nonVoters holds speaker keys whose CLOSE votes are ignored (personas
+	// excluded from consensus).
+	nonVoters map[string]struct{}
 }
 
 func newTerminationSignalTracker() terminationSignalTracker {
 	return terminationSignalTracker{
 		latestCloseBySpeaker: make(map[string]bool),
+		nonVoters:            make(map[string]struct{}),
+	}
+}
+
+// ignoreCloseVotesFrom drops CLOSE votes by personas excluded from consensus.
+func (t *terminationSignalTracker) ignoreCloseVotesFrom(personas []persona.Persona) {
+	for _, p := range personas {
+		if p.ExcludeFromConsensus {
+			t.nonVoters[normalizeMatchKey(p.ID)] = struct{}{}
+		}
 	}
 }
 
@@ -32,7 +49,7 @@ func (t *terminationSignalTracker) observe(turn Turn) {
 	signal := parseTurnTerminationSignal(turn.Content)
 	if signal.closeVote != nil {
 		key := normalizeTurnSpeakerKey(turn)
-		if key != "" {
+		if _, ignored := t.nonVoters[key]; key != "" && !ignored {
 			t.latestCloseBySpeaker[key] = *signal.closeVote
 		}
 	}
@@ -92,6 +109,16 @@ func requiredCloseVotes(personaCount int) int {
 	return (2*personaCount + 2) / 3 // ceil(2n/3)
 }
 
+// CloseVote reports the CLOSE directive in a persona turn; ok is false when
+// the turn casts no vote.
+func CloseVote(content string) (vote bool, ok bool) {
+	signal := parseTurnTerminationSignal(content)
+	if signal.closeVote == nil {
+		return false, false
+	}
+	return *signal.closeVote, true
+}
+
 func parseTurnTerminationSignal(content string) turnTerminationSignal {
 	lines := nonEmptyAllLines(content)
 	var signal turnTerminationSignal
@@ -3,6 +3,8 @@ package orchestrator
 import (
 	"strings"
 	"testing"
+
+	"debate/internal/persona"
 )
 
 func TestParseTurnTerminationSignal(t *testing.T) {
@@ -97,3 +99,26 @@ func TestTerminationSignalTrackerKeepsStreakWhenSignalMissing(t *testing.T) {
 		t.Fatalf("missing NEW_POINT should keep streak, got %d", tracker.noNewPointStreak)
 	}
 }
+
+func TestTerminationSignalTrackerIgnoresCloseVotesFromExcludedPersonas(t *testing.T) {
+	tracker := newTerminationSignalTracker()
+	tracker.ignoreCloseVotesFrom([]persona.Persona{
+		{ID: "a", Name: "A"},
+		{ID: "b", Name: "B"},
+		{ID: "f", Name: "Facts", ExcludeFromConsensus: true},
+	})
+	turns := []Turn{
+		{Type: TurnTypePersona, SpeakerID: "a", SpeakerName: "A", Content: "PERSUASION_UPDATE: changed=yes; adopted=B의 롤백 계획; rationale=근거; remaining_gap=none\nCLOSE: yes\nNEW_POINT: no"},
+		{Type: TurnTypePersona, SpeakerID: "f", SpeakerName: "Facts", Content: "CLOSE: yes\nNEW_POINT: no"},
+		{Type: TurnTypePersona, SpeakerID: "b", SpeakerName: "B", Content: "CLOSE: no\nNEW_POINT: no"},
+	}
+	for _, turn := range turns {
+		tracker.observe(turn)
+	}
+	if got := tracker.closeYesCount(); got != 1 {
+		t.Fatalf("expected only party votes to count, got %d yes votes", got)
+	}
+	if tracker.shouldSuggestStop(2) {
+		t.Fatal("excluded persona's CLOSE vote must not complete the quorum")
+	}
+}
@@ -0,0 +1,51 @@
+package orchestrator
+
+// ThresholdSchedule ramps the consensus threshold linearly from Start on the
+// first turn to End at the turn limit, so a long, genuinely hard debate can
+// close late at a lower, documented bar.
+type ThresholdSchedule struct {
+	// Start is the threshold at the beginning; 0 means ConsensusThreshold.
+	Start float64 `json:"start,omitempty"`
+	// End is the threshold once the debate reaches its turn limit.
+	End float64 `json:"end"`
+}
+
+// normalizeThresholdSchedule drops schedules without a usable End and fills
+// a missing Start from the constant threshold.
+func normalizeThresholdSchedule(schedule *ThresholdSchedule, threshold float64) *ThresholdSchedule {
+	if schedule == nil || schedule.End <= 0 || schedule.End > 1 {
+		return nil
+	}
+	normalized := *schedule
+	if normalized.Start <= 0 || normalized.Start > 1 {
+		normalized.Start = threshold
+	}
+	return &normalized
+}
+
+// effectiveMaxTurns is the turn limit the debate loop enforces.
+func (o *Orchestrator) effectiveMaxTurns() int {
+	if o.cfg.MaxTurns > 0 {
+		return o.cfg.MaxTurns
+	}
+	return o.cfg.UnlimitedHardMaxTurns
+}
+
+// consensusThresholdAt returns the threshold a judge verdict after turnNo
+// must meet: ConsensusThreshold, or the scheduled value for that point of
+// the debate.
+func (o *Orchestrator) consensusThresholdAt(turnNo int) float64 {
+	schedule := o.cfg.ThresholdSchedule
+	maxTurns := o.effectiveMaxTurns()
+	if schedule == nil || maxTurns <= 0 {
+		return o.cfg.ConsensusThreshold
+	}
+	progress := float64(turnNo) / float64(maxTurns)
+	if progress < 0 {
+		progress = 0
+	}
+	if progress > 1 {
+		progress = 1
+	}
+	return schedule.Start + (schedule.End-schedule.Start)*progress
+}
@@ -0,0 +1,71 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConsensusThresholdAtRampsOverTurns(t *testing.T) {
+	o := New(&fakeLLM{}, Config{
+		MaxTurns:           10,
+		ConsensusThreshold: 0.95,
+		ThresholdSchedule:  &ThresholdSchedule{End: 0.85},
+	})
+	prev := o.consensusThresholdAt(0)
+	if prev != 0.95 {
+		t.Fatalf("expected the schedule to start at ConsensusThreshold, got %v", prev)
+	}
+	for turn := 1; turn <= 12; turn++ {
+		got := o.consensusThresholdAt(turn)
+		if got > prev {
+			t.Fatalf("threshold rose at turn %d: %v -> %v", turn, prev, got)
+		}
+		prev = got
+	}
+	if prev < 0.8499 || prev > 0.8501 {
+		t.Fatalf("expected the threshold to settle at End, got %v", prev)
+	}
+
+	constant := New(&fakeLLM{}, Config{MaxTurns: 10, ConsensusThreshold: 0.95})
+	if constant.consensusThresholdAt(1) != 0.95 || constant.consensusThresholdAt(10) != 0.95 {
+		t.Fatal("expected a constant threshold without a schedule")
+	}
+}
+
+func TestThresholdScheduleClosesAtRelaxedBarLate(t *testing.T) {
+	run := func(schedule *ThresholdSchedule) Result {
+		t.Helper()
+		// The fake judge reports consensus at score 0.9 from the first verdict.
+		llm := &fakeLLM{judgeAtTurn: 1}
+		result, err := New(llm, Config{
+			MaxTurns:           10,
+			ConsensusThreshold: 0.97,
+			ThresholdSchedule:  schedule,
+		}).Run(context.Background(), "How do we reduce incidents?", testPersonas(), nil)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		return result
+	}
+
+	if strict := run(nil); strict.Status != StatusMaxTurnsReached {
+		t.Fatalf("expected a constant 0.97 bar to never close, got %s", strict.Status)
+	}
+
+	relaxed := run(&ThresholdSchedule{End: 0.85})
+	if relaxed.Status != StatusConsensusReached {
+		t.Fatalf("expected consensus at the relaxed bar, got %s", relaxed.Status)
+	}
+	if relaxed.Consensus.Threshold >= 0.97 || relaxed.Consensus.Threshold > relaxed.Consensus.Score {
+		t.Fatalf("expected a recorded relaxed threshold at or below the score, got %+v", relaxed.Consensus)
+	}
+	personaTurns := 0
+	for _, turn := range relaxed.Turns {
+		if turn.Type == TurnTypePersona {
+			personaTurns++
+		}
+	}
+	if personaTurns < 5 {
+		t.Fatalf("expected consensus only late in the debate, got it after %d persona turns", personaTurns)
+	}
+}
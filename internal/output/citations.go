@@ -0,0 +1,59 @@
+package output
+
+import (
+	"regexp"
+	"strconv"
+
+	"debate/internal/orchestrator"
+)
+
+var turnCitationPattern = regexp.MustCompile(`\[(\d+)\]`)
+
+// citationAnchors maps each turn index to the anchor of its rendered block.
+func citationAnchors(turns []orchestrator.Turn) map[int]string {
+	anchors := make(map[int]string, len(turns))
+	for i, turn := range turns {
+		if turn.Index <= 0 {
+			continue
+		}
+		// With duplicate indices the first turn wins: citations point back
+		// at earlier turns.
+		if _, ok := anchors[turn.Index]; !ok {
+			anchors[turn.Index] = turnAnchor(i + 1)
+		}
+	}
+	return anchors
+}
+
+// linkTurnCitations rewrites `[N]` turn citations into links to the cited
+// turn's anchor. Citations of unknown turns and existing links stay literal.
+func linkTurnCitations(text string, anchors map[int]string) string {
+	if len(anchors) == 0 {
+		return text
+	}
+	matches := turnCitationPattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text
+	}
+	out := make([]byte, 0, len(text)+len(matches)*12)
+	last := 0
+	for _, m := range matches {
+		end := m[1]
+		if end < len(text) && text[end] == '(' {
+			continue
+		}
+		idx, err := strconv.Atoi(text[m[2]:m[3]])
+		if err != nil {
+			continue
+		}
+		anchor, ok := anchors[idx]
+		if !ok {
+			continue
+		}
+		out = append(out, text[last:end]...)
+		out = append(out, "(#"+anchor+")"...)
+		last = end
+	}
+	out = append(out, text[last:]...)
+	return string(out)
+}
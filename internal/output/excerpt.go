@@ -0,0 +1,131 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"debate/internal/orchestrator"
+)
+
+// TurnFilter selects the turns kept in an excerpt. Zero bounds are open, and
+// an empty SpeakerIDs matches every speaker, so the zero filter keeps all
+// turns.
+type TurnFilter struct {
+	FromIndex  int
+	ToIndex    int
+	SpeakerIDs []string
+}
+
+// Empty reports whether the filter keeps every turn.
+func (f TurnFilter) Empty() bool {
+	return f.FromIndex <= 0 && f.ToIndex <= 0 && len(f.speakerSet()) == 0
+}
+
+// Match reports whether turn falls inside the filter's index range and
+// speaker set. A reversed range is treated as if its bounds were swapped.
+func (f TurnFilter) Match(turn orchestrator.Turn) bool {
+	from, to := f.bounds()
+	if from > 0 && turn.Index < from {
+		return false
+	}
+	if to > 0 && turn.Index > to {
+		return false
+	}
+	speakers := f.speakerSet()
+	if len(speakers) == 0 {
+		return true
+	}
+	_, ok := speakers[strings.ToLower(strings.TrimSpace(turn.SpeakerID))]
+	return ok
+}
+
+func (f TurnFilter) bounds() (int, int) {
+	from, to := f.FromIndex, f.ToIndex
+	if from > 0 && to > 0 && from > to {
+		from, to = to, from
+	}
+	return from, to
+}
+
+func (f TurnFilter) speakerSet() map[string]struct{} {
+	set := make(map[string]struct{}, len(f.SpeakerIDs))
+	for _, id := range f.SpeakerIDs {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id != "" {
+			set[id] = struct{}{}
+		}
+	}
+	return set
+}
+
+// String describes the filter for the excerpt header, e.g.
+// "turns 10-20, speakers: pm, sre".
+func (f TurnFilter) String() string {
+	from, to := f.bounds()
+	var parts []string
+	switch {
+	case from > 0 && to > 0:
+		parts = append(parts, fmt.Sprintf("turns %d-%d", from, to))
+	case from > 0:
+		parts = append(parts, fmt.Sprintf("turns %d-", from))
+	case to > 0:
+		parts = append(parts, fmt.Sprintf("turns -%d", to))
+	}
+	if ids := normalizeSpeakerIDs(f.SpeakerIDs); len(ids) > 0 {
+		parts = append(parts, "speakers: "+strings.Join(ids, ", "))
+	}
+	if len(parts) == 0 {
+		return "all turns"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func normalizeSpeakerIDs(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// FilterTurns returns the turns matching filter, in their original order.
+func FilterTurns(turns []orchestrator.Turn, filter TurnFilter) []orchestrator.Turn {
+	if filter.Empty() {
+		return turns
+	}
+	kept := make([]orchestrator.Turn, 0, len(turns))
+	for _, t := range turns {
+		if filter.Match(t) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+// FormatResultFiltered renders result as Markdown keeping the header,
+// problem, consensus and personas but only the turns matching filter. An
+// empty filter produces the full export.
+func FormatResultFiltered(result orchestrator.Result, filter TurnFilter) string {
+	if filter.Empty() {
+		return formatResultMarkdown(result)
+	}
+	turns := FilterTurns(result.Turns, filter)
+
+	var b strings.Builder
+	b.WriteString("# Debate Result (excerpt)\n\n")
+	writeResultMetadata(&b, result)
+	b.WriteString(fmt.Sprintf("- excerpt: %s (%d of %d turns)\n", safeText(filter.String()), len(turns), len(result.Turns)))
+	b.WriteString("\n## Problem\n\n")
+	b.WriteString(markdownBulletedText(result.Problem, "") + "\n\n")
+
+	writeConsensusSection(&b, result.Consensus)
+	writeOpenQuestionsSection(&b, result.OpenQuestions)
+	writePersonasSection(&b, result.Personas)
+
+	b.WriteString("\n## Turns\n\n")
+	b.WriteString(formatTurnsBySpeaker(turns))
+	b.WriteString("\n")
+	return b.String()
+}
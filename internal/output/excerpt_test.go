@@ -0,0 +1,79 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"debate/internal/orchestrator"
+)
+
+func excerptTestResult() orchestrator.Result {
+	turns := make([]orchestrator.Turn, 0, 6)
+	for i := 1; i <= 6; i++ {
+		id, name := "a", "Architect"
+		if i%2 == 0 {
+			id, name = "o", "Operator"
+		}
+		turns = append(turns, orchestrator.Turn{
+			Index:       i,
+			SpeakerID:   id,
+			SpeakerName: name,
+			Type:        orchestrator.TurnTypePersona,
+			Content:     fmt.Sprintf("발언 번호 %d", i),
+		})
+	}
+	return orchestrator.Result{
+		Problem: "캐시 계층을 도입할까?",
+		Status:  orchestrator.StatusConsensusReached,
+		Consensus: orchestrator.Consensus{
+			Reached: true,
+			Summary: "읽기 캐시부터 도입",
+		},
+		Turns: turns,
+	}
+}
+
+func TestFormatResultFilteredKeepsOnlyRequestedRange(t *testing.T) {
+	md := FormatResultFiltered(excerptTestResult(), TurnFilter{FromIndex: 2, ToIndex: 4})
+
+	for i := 2; i <= 4; i++ {
+		if !strings.Contains(md, fmt.Sprintf("발언 번호 %d", i)) {
+			t.Fatalf("expected turn %d in excerpt:\n%s", i, md)
+		}
+	}
+	for _, i := range []int{1, 5, 6} {
+		if strings.Contains(md, fmt.Sprintf("발언 번호 %d", i)) {
+			t.Fatalf("did not expect turn %d in excerpt:\n%s", i, md)
+		}
+	}
+	if !strings.Contains(md, "읽기 캐시부터 도입") {
+		t.Fatalf("expected consensus to be kept:\n%s", md)
+	}
+	if !strings.Contains(md, "- excerpt: turns 2-4 (3 of 6 turns)") {
+		t.Fatalf("expected excerpt note:\n%s", md)
+	}
+}
+
+func TestFormatResultFilteredBySpeakerAndBounds(t *testing.T) {
+	result := excerptTestResult()
+
+	md := FormatResultFiltered(result, TurnFilter{SpeakerIDs: []string{" O "}})
+	if strings.Contains(md, "발언 번호 1") || !strings.Contains(md, "발언 번호 6") {
+		t.Fatalf("expected only operator turns:\n%s", md)
+	}
+
+	if got := FormatResultFiltered(result, TurnFilter{}); got != formatResultMarkdown(result) {
+		t.Fatalf("expected empty filter to produce the full export")
+	}
+
+	reversed := FilterTurns(result.Turns, TurnFilter{FromIndex: 5, ToIndex: 3})
+	if len(reversed) != 3 || reversed[0].Index != 3 {
+		t.Fatalf("expected reversed bounds to be swapped, got %+v", reversed)
+	}
+
+	md = FormatResultFiltered(result, TurnFilter{FromIndex: 40, ToIndex: 50})
+	if !strings.Contains(md, "- no turns") || !strings.Contains(md, "(0 of 6 turns)") {
+		t.Fatalf("expected out-of-range excerpt to render no turns:\n%s", md)
+	}
+}
@@ -0,0 +1,192 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+// Format names one artifact file written for a saved debate.
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "md"
+	FormatHTML     Format = "html"
+	FormatText     Format = "txt"
+	FormatJSONL    Format = "jsonl"
+	// FormatScript and FormatSSML are speaker-labelled scripts for TTS.
+	FormatScript Format = "script"
+	FormatSSML   Format = "ssml"
+)
+
+var supportedFormats = []Format{FormatJSON, FormatMarkdown, FormatHTML, FormatText, FormatJSONL, FormatScript, FormatSSML}
+
+// DefaultFormats is what SaveResult writes.
+var DefaultFormats = []Format{FormatJSON, FormatMarkdown}
+
+// formatsAliasBoth expands to DefaultFormats in ParseFormats.
+const formatsAliasBoth = "both"
+
+// ParseFormats parses a comma-separated format list such as "json,md,txt".
+// Names are case-insensitive and duplicates are dropped; "both" stands for
+// json and md.
+func ParseFormats(raw string) ([]Format, error) {
+	formats := make([]Format, 0, len(supportedFormats))
+	for _, part := range strings.Split(raw, ",") {
+		name := Format(strings.ToLower(strings.TrimSpace(part)))
+		if name == "" {
+			continue
+		}
+		names := []Format{name}
+		if name == formatsAliasBoth {
+			names = DefaultFormats
+		} else if !HasFormat(supportedFormats, name) {
+			return nil, fmt.Errorf("unknown output format %q (supported: %s, %s)", name, joinFormats(supportedFormats), formatsAliasBoth)
+		}
+		for _, format := range names {
+			if !HasFormat(formats, format) {
+				formats = append(formats, format)
+			}
+		}
+	}
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("at least one output format is required (supported: %s)", joinFormats(supportedFormats))
+	}
+	return formats, nil
+}
+
+func HasFormat(formats []Format, format Format) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatPath returns the artifact path for format next to the JSON result path.
+func FormatPath(path string, format Format) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + string(format)
+}
+
+func joinFormats(formats []Format) string {
+	names := make([]string, 0, len(formats))
+	for _, f := range formats {
+		names = append(names, string(f))
+	}
+	return strings.Join(names, ", ")
+}
+
+func renderResult(result orchestrator.Result, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal result: %w", err)
+		}
+		return data, nil
+	case FormatMarkdown:
+		return []byte(formatResultMarkdown(result)), nil
+	case FormatHTML:
+		return []byte(formatResultHTML(result)), nil
+	case FormatText:
+		return []byte(formatResultText(result)), nil
+	case FormatJSONL:
+		return formatResultJSONL(result)
+	case FormatScript:
+		return []byte(RenderScript(result)), nil
+	case FormatSSML:
+		return []byte(RenderSSML(result)), nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// formatResultJSONL writes one turn per line for log pipelines.
+func formatResultJSONL(result orchestrator.Result) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, turn := range result.Turns {
+		if err := enc.Encode(turn); err != nil {
+			return nil, fmt.Errorf("marshal turn %d: %w", turn.Index, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func formatResultText(result orchestrator.Result) string {
+	var b strings.Builder
+	b.WriteString("Debate Result\n\n")
+	b.WriteString("status: " + result.Status + "\n")
+	b.WriteString(fmt.Sprintf("consensus_score: %.2f\n", result.Consensus.Score))
+	if !result.StartedAt.IsZero() {
+		b.WriteString("started_at: " + result.StartedAt.UTC().Format(time.RFC3339) + "\n")
+	}
+	if !result.EndedAt.IsZero() {
+		b.WriteString("ended_at: " + result.EndedAt.UTC().Format(time.RFC3339) + "\n")
+	}
+	b.WriteString("\nProblem:\n" + strings.TrimSpace(result.Problem) + "\n")
+	if summary := strings.TrimSpace(result.Consensus.Summary); summary != "" {
+		b.WriteString("\nConsensus:\n" + summary + "\n")
+	}
+	b.WriteString("\nTurns:\n")
+	for _, turn := range result.Turns {
+		b.WriteString(fmt.Sprintf("\n[%d] %s (%s)\n", turn.Index, displaySpeaker(turn), turn.Type))
+		b.WriteString(sanitizeTurnContentForDisplay(turn.Content) + "\n")
+	}
+	return b.String()
+}
+
+func formatResultHTML(result orchestrator.Result) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>Debate Result</title>\n</head>\n<body>\n")
+	b.WriteString("<h1>Debate Result</h1>\n<ul>\n")
+	b.WriteString("<li>status: " + html.EscapeString(result.Status) + "</li>\n")
+	b.WriteString(fmt.Sprintf("<li>consensus_score: %.2f</li>\n", result.Consensus.Score))
+	if !result.StartedAt.IsZero() {
+		b.WriteString("<li>started_at: " + result.StartedAt.UTC().Format(time.RFC3339) + "</li>\n")
+	}
+	if !result.EndedAt.IsZero() {
+		b.WriteString("<li>ended_at: " + result.EndedAt.UTC().Format(time.RFC3339) + "</li>\n")
+	}
+	b.WriteString("</ul>\n<h2>Problem</h2>\n")
+	b.WriteString(htmlParagraph(result.Problem))
+	if strings.TrimSpace(result.Consensus.Summary) != "" {
+		b.WriteString("<h2>Consensus</h2>\n")
+		b.WriteString(htmlParagraph(result.Consensus.Summary))
+	}
+	b.WriteString("<h2>Turns</h2>\n")
+	byPosition := !turnIndicesMonotonic(result.Turns)
+	colors := result.SpeakerColors
+	if len(colors) == 0 {
+		colors = orchestrator.AssignSpeakerColors(result.Personas)
+	}
+	for i, turn := range result.Turns {
+		if color, ok := colors[turn.SpeakerID]; ok {
+			b.WriteString(fmt.Sprintf("<section id=\"%s\" style=\"border-left: 4px solid %s; padding-left: 8px\">\n", turnAnchor(i+1), html.EscapeString(color)))
+		} else {
+			b.WriteString(fmt.Sprintf("<section id=\"%s\">\n", turnAnchor(i+1)))
+		}
+		b.WriteString(fmt.Sprintf("<h3>%s · %s (%s)</h3>\n", turnLabel(i+1, turn, byPosition), html.EscapeString(displaySpeaker(turn)), html.EscapeString(turn.Type)))
+		b.WriteString(htmlParagraph(sanitizeTurnContentForDisplay(turn.Content)))
+		b.WriteString("</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+func htmlParagraph(text string) string {
+	lines := strings.Split(strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n")), "\n")
+	for i, line := range lines {
+		lines[i] = html.EscapeString(strings.TrimSpace(line))
+	}
+	return "<p>" + strings.Join(lines, "<br>\n") + "</p>\n"
+}
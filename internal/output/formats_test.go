@@ -0,0 +1,165 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+func TestSaveResultFormatsWritesExactlyRequestedFormats(t *testing.T) {
+	dir := t.TempDir()
+	path := NewTimestampPath(dir, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	result := orchestrator.Result{
+		Problem: "<b>문제</b>",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "첫 주장"},
+			{Index: 2, SpeakerID: "b", SpeakerName: "B", Type: orchestrator.TurnTypePersona, Content: "반론"},
+		},
+	}
+
+	if err := SaveResultFormats(path, result, []Format{FormatHTML, FormatText, FormatJSONL}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, filepath.Ext(entry.Name()))
+	}
+	if strings.Join(names, ",") != ".html,.jsonl,.txt" {
+		t.Fatalf("unexpected artifact set: %v", names)
+	}
+
+	htmlData, _ := os.ReadFile(FormatPath(path, FormatHTML))
+	if !strings.Contains(string(htmlData), "&lt;b&gt;문제&lt;/b&gt;") {
+		t.Fatalf("expected escaped problem in html, got %s", htmlData)
+	}
+	jsonlData, _ := os.ReadFile(FormatPath(path, FormatJSONL))
+	if lines := strings.Split(strings.TrimSpace(string(jsonlData)), "\n"); len(lines) != 2 {
+		t.Fatalf("expected one jsonl line per turn, got %q", jsonlData)
+	}
+	txtData, _ := os.ReadFile(FormatPath(path, FormatText))
+	if !strings.Contains(string(txtData), "[2] B (persona)\n반론") {
+		t.Fatalf("unexpected text transcript: %s", txtData)
+	}
+}
+
+func TestFormatResultHTMLUsesAssignedSpeakerColors(t *testing.T) {
+	result := orchestrator.Result{
+		Personas: []persona.Persona{{ID: "a", Name: "Alice"}, {ID: "b", Name: "Bob"}},
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "Alice", Type: orchestrator.TurnTypePersona, Content: "Ship it."},
+			{Index: 2, SpeakerID: orchestrator.ModeratorSpeakerID, SpeakerName: "사회자", Type: orchestrator.TurnTypeModerator, Content: "Bob?"},
+		},
+	}
+
+	page := formatResultHTML(result)
+	want := orchestrator.AssignSpeakerColors(result.Personas)
+	if !strings.Contains(page, "border-left: 4px solid "+want["a"]) {
+		t.Fatalf("expected derived persona color for legacy result, got %s", page)
+	}
+	if !strings.Contains(page, "border-left: 4px solid "+orchestrator.ModeratorColor) {
+		t.Fatalf("expected reserved moderator color, got %s", page)
+	}
+
+	result.SpeakerColors = map[string]string{"a": "#123456"}
+	if page := formatResultHTML(result); !strings.Contains(page, "border-left: 4px solid #123456") {
+		t.Fatalf("expected recorded speaker color, got %s", page)
+	}
+}
+
+func TestParseFormatsRejectsEmptyAndUnknown(t *testing.T) {
+	if _, err := ParseFormats(" , "); err == nil {
+		t.Fatal("expected error for empty format list")
+	}
+	if _, err := ParseFormats("md,docx"); err == nil || !strings.Contains(err.Error(), "docx") {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+}
+
+func TestParseFormatsExpandsBothAlias(t *testing.T) {
+	got, err := ParseFormats("BOTH,md,txt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Format{FormatJSON, FormatMarkdown, FormatText}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected formats: got %v, want %v", got, want)
+	}
+}
+
+func TestRenderScriptWritesOneLinePerTurnWithoutControlLines(t *testing.T) {
+	result := orchestrator.Result{
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "Alice", Type: orchestrator.TurnTypePersona, Content: "Ship the canary first.\nIt limits blast radius.\nNEXT: b"},
+			{Index: 2, SpeakerID: "a", SpeakerName: "Alice", Type: orchestrator.TurnTypePersona, Content: "Then widen rollout."},
+			{Index: 3, SpeakerID: "b", SpeakerName: "Bob", Type: orchestrator.TurnTypePersona, Content: "Pause until alerts are fixed.\nCLOSE: yes"},
+		},
+	}
+
+	got := RenderScript(result)
+	want := "Alice: Ship the canary first. It limits blast radius.\n" +
+		"Alice: Then widen rollout.\n" +
+		"\n" +
+		"Bob: Pause until alerts are fixed.\n"
+	if got != want {
+		t.Fatalf("unexpected script:\n%s", got)
+	}
+	if ScriptPath("/tmp/run.json") != "/tmp/run.script" {
+		t.Fatalf("unexpected script path %q", ScriptPath("/tmp/run.json"))
+	}
+}
+
+func TestRenderSSMLUsesPersonaVoices(t *testing.T) {
+	result := orchestrator.Result{
+		Personas: []persona.Persona{{ID: "a", Name: "Alice", Voice: "en-US-Jenny"}, {ID: "b", Name: "Bob"}},
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "a", SpeakerName: "Alice", Type: orchestrator.TurnTypePersona, Content: "Latency < 200ms & stable.\nNEXT: b"},
+			{Index: 2, SpeakerID: "b", SpeakerName: "Bob", Type: orchestrator.TurnTypePersona, Content: "Agreed."},
+			{Index: 3, SpeakerID: orchestrator.ModeratorSpeakerID, Type: orchestrator.TurnTypeModerator, Content: "Next, Alice."},
+		},
+	}
+
+	got := RenderSSML(result)
+	want := "<speak>\n" +
+		"<voice name=\"en-US-Jenny\"><p>Latency &lt; 200ms &amp; stable.</p></voice>\n" +
+		"<voice name=\"" + defaultSSMLVoices[0] + "\"><p>Agreed.</p></voice>\n" +
+		"<voice name=\"" + moderatorSSMLVoice + "\"><p>Next, Alice.</p></voice>\n" +
+		"</speak>\n"
+	if got != want {
+		t.Fatalf("unexpected ssml:\n%s", got)
+	}
+}
+
+func TestAssignSSMLVoicesGivesUnconfiguredPersonasDistinctDefaults(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "a", Voice: defaultSSMLVoices[1]},
+		{ID: "b"},
+		{ID: "c"},
+		{ID: "d"},
+	}
+
+	voices := assignSSMLVoices(personas)
+	if voices["a"] != defaultSSMLVoices[1] {
+		t.Fatalf("expected configured voice to be kept, got %q", voices["a"])
+	}
+	seen := make(map[string]string)
+	for id, voice := range voices {
+		if other, dup := seen[voice]; dup {
+			t.Fatalf("speakers %s and %s share voice %q", other, id, voice)
+		}
+		seen[voice] = id
+	}
+	if len(voices) != len(personas)+1 {
+		t.Fatalf("expected a voice per persona plus the moderator, got %v", voices)
+	}
+}
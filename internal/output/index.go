@@ -0,0 +1,90 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ResultSummary is the listing view of a saved debate result.
+type ResultSummary struct {
+	Path         string    `json:"path"`
+	MarkdownPath string    `json:"markdown_path"`
+	Problem      string    `json:"problem"`
+	Status       string    `json:"status"`
+	Project      string    `json:"project,omitempty"`
+	Tags         []string  `json:"tags,omitempty"`
+	StartedAt    time.Time `json:"started_at"`
+	EndedAt      time.Time `json:"ended_at"`
+}
+
+// ListResults returns saved results in dir and its direct project
+// subdirectories, newest first. JSON files that fail to decode are skipped;
+// sets saved without JSON are listed from their file names alone.
+func ListResults(dir string) ([]ResultSummary, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read output dir: %w", err)
+	}
+
+	sets := listArtifactSets(dir, entries)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		subDir := filepath.Join(dir, entry.Name())
+		subEntries, err := os.ReadDir(subDir)
+		if err != nil {
+			return nil, fmt.Errorf("read output dir: %w", err)
+		}
+		sets = append(sets, listArtifactSets(subDir, subEntries)...)
+	}
+	sortArtifactSets(sets)
+
+	summaries := make([]ResultSummary, 0, len(sets))
+	for _, set := range sets {
+		if !set.hasJSON {
+			summaries = append(summaries, artifactOnlySummary(dir, set))
+			continue
+		}
+		summary, err := readResultSummary(set.JSONPath())
+		if err != nil {
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// artifactOnlySummary describes a set saved without JSON. Only what the file
+// names tell is known: the start time, the project subdirectory and the
+// Markdown path; Path stays empty since there is no result to load.
+func artifactOnlySummary(dir string, set artifactSet) ResultSummary {
+	summary := ResultSummary{StartedAt: set.createdAt}
+	if parent := filepath.Dir(set.stemPath); filepath.Clean(parent) != filepath.Clean(dir) {
+		summary.Project = filepath.Base(parent)
+	}
+	if set.hasMarkdown {
+		summary.MarkdownPath = set.stemPath + "." + string(FormatMarkdown)
+	}
+	return summary
+}
+
+func readResultSummary(path string) (ResultSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ResultSummary{}, err
+	}
+	var summary ResultSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return ResultSummary{}, err
+	}
+	summary.Path = path
+	summary.MarkdownPath = MarkdownPath(path)
+	return summary, nil
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"debate/internal/orchestrator"
+)
+
+// maxProblemSlugRunes keeps the problem column readable in a spreadsheet.
+const maxProblemSlugRunes = 60
+
+var metricsCSVHeader = []string{
+	"timestamp",
+	"problem_slug",
+	"status",
+	"consensus_score",
+	"turns",
+	"prompt_tokens",
+	"completion_tokens",
+	"total_tokens",
+	"latency_ms",
+	"duration_seconds",
+}
+
+// metricsCSVMu serializes appends within the process so concurrent web runs
+// never interleave rows or both write the header.
+var metricsCSVMu sync.Mutex
+
+// AppendMetricsCSV appends one row describing result to the CSV file at path,
+// writing the header first when the file is new or empty. Each row is written
+// with a single append so readers never see a partial line.
+func AppendMetricsCSV(path string, result orchestrator.Result) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create metrics dir: %w", err)
+	}
+
+	metricsCSVMu.Lock()
+	defer metricsCSVMu.Unlock()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open metrics csv: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat metrics csv: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if info.Size() == 0 {
+		_ = w.Write(metricsCSVHeader)
+	}
+	_ = w.Write(metricsCSVRow(result))
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("encode metrics csv: %w", err)
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("append metrics csv: %w", err)
+	}
+	return nil
+}
+
+func metricsCSVRow(result orchestrator.Result) []string {
+	timestamp := result.EndedAt
+	if timestamp.IsZero() {
+		timestamp = result.StartedAt
+	}
+	duration := time.Duration(0)
+	if !result.StartedAt.IsZero() && result.EndedAt.After(result.StartedAt) {
+		duration = result.EndedAt.Sub(result.StartedAt)
+	}
+	return []string{
+		timestamp.UTC().Format(time.RFC3339),
+		problemSlug(result.Problem),
+		result.Status,
+		strconv.FormatFloat(result.Consensus.Score, 'f', 2, 64),
+		strconv.Itoa(len(result.Turns)),
+		strconv.Itoa(result.Metrics.PromptTokens),
+		strconv.Itoa(result.Metrics.CompletionTokens),
+		strconv.Itoa(result.Metrics.TotalTokens),
+		strconv.FormatInt(result.Metrics.LatencyMS, 10),
+		strconv.FormatFloat(duration.Seconds(), 'f', 3, 64),
+	}
+}
+
+// problemSlug lowercases problem and joins its letter/digit runs with "-",
+// truncated to maxProblemSlugRunes.
+func problemSlug(problem string) string {
+	var b strings.Builder
+	runes := 0
+	pendingSep := false
+	for _, r := range strings.ToLower(problem) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingSep = true
+			continue
+		}
+		if pendingSep && b.Len() > 0 {
+			if runes+1 >= maxProblemSlugRunes {
+				break
+			}
+			b.WriteByte('-')
+			runes++
+		}
+		pendingSep = false
+		if runes >= maxProblemSlugRunes {
+			break
+		}
+		b.WriteRune(r)
+		runes++
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+func TestAppendMetricsCSVWritesHeaderOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics", "debates.csv")
+	started := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	first := orchestrator.Result{
+		Problem:   "Should we ship, now?",
+		Status:    orchestrator.StatusConsensusReached,
+		StartedAt: started,
+		EndedAt:   started.Add(90 * time.Second),
+		Turns:     make([]orchestrator.Turn, 4),
+		Consensus: orchestrator.Consensus{Score: 0.912},
+		Metrics:   orchestrator.Metrics{LatencyMS: 90000, PromptTokens: 100, CompletionTokens: 40, TotalTokens: 140},
+	}
+	second := first
+	second.Problem = "롤백 전략"
+	second.Status = orchestrator.StatusMaxTurnsReached
+
+	for _, result := range []orchestrator.Result{first, second} {
+		if err := AppendMetricsCSV(path, result); err != nil {
+			t.Fatalf("append metrics: %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open metrics csv: %v", err)
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("read metrics csv: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected one header and two data rows, got %d: %v", len(rows), rows)
+	}
+	if strings.Join(rows[0], ",") != strings.Join(metricsCSVHeader, ",") {
+		t.Fatalf("unexpected header: %v", rows[0])
+	}
+	want := "2026-03-01T10:01:30Z,should-we-ship-now,consensus_reached,0.91,4,100,40,140,90000,90.000"
+	if got := strings.Join(rows[1], ","); got != want {
+		t.Fatalf("unexpected first row:\n got %s\nwant %s", got, want)
+	}
+	if rows[2][1] != "롤백-전략" || rows[2][2] != orchestrator.StatusMaxTurnsReached {
+		t.Fatalf("unexpected second row: %v", rows[2])
+	}
+}
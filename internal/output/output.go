@@ -1,7 +1,7 @@
 package output
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,38 +11,70 @@ import (
 	"debate/internal/orchestrator"
 )
 
+// SaveResult writes the JSON result at path and the Markdown rendering next to it.
 func SaveResult(path string, result orchestrator.Result) error {
+	return SaveResultFormats(path, result, DefaultFormats)
+}
+
+// SaveResultFormats writes exactly the requested formats next to path (the
+// JSON result path). If any write fails, files this call created are removed.
+func SaveResultFormats(path string, result orchestrator.Result, formats []Format) error {
+	if len(formats) == 0 {
+		return errors.New("at least one output format is required")
+	}
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("create output dir: %w", err)
 	}
-	jsonPathExisted := false
-	if _, err := os.Stat(path); err == nil {
-		jsonPathExisted = true
-	} else if !os.IsNotExist(err) {
-		return fmt.Errorf("stat json result file: %w", err)
-	}
 
-	jsonData, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return fmt.Errorf("marshal result: %w", err)
-	}
-	if err := writeAtomic(path, jsonData, 0o644); err != nil {
-		return fmt.Errorf("write json result file: %w", err)
+	created := make([]string, 0, len(formats))
+	rollback := func() {
+		// Avoid leaving half-written artifact sets when a later write fails.
+		for _, p := range created {
+			_ = os.Remove(p)
+		}
 	}
-
-	mdPath := MarkdownPath(path)
-	mdData := []byte(formatResultMarkdown(result))
-	if err := writeAtomic(mdPath, mdData, 0o644); err != nil {
-		// Avoid leaving half-written artifacts when markdown write fails.
-		if !jsonPathExisted {
-			_ = os.Remove(path)
+	for _, format := range formats {
+		data, err := renderResult(result, format)
+		if err != nil {
+			rollback()
+			return err
+		}
+		target := FormatPath(path, format)
+		existed := false
+		if _, err := os.Stat(target); err == nil {
+			existed = true
+		} else if !os.IsNotExist(err) {
+			rollback()
+			return fmt.Errorf("stat %s result file: %w", format, err)
+		}
+		if err := writeAtomic(target, data, 0o644); err != nil {
+			rollback()
+			return fmt.Errorf("write %s result file: %w", format, err)
+		}
+		if !existed {
+			created = append(created, target)
 		}
-		return fmt.Errorf("write markdown result file: %w", err)
 	}
 	return nil
 }
 
+// SaveResultFallback writes the result set into a new directory under dir and
+// returns its JSON path. It is the last resort when the configured output dir
+// cannot be written, so a finished debate is not discarded.
+func SaveResultFallback(dir string, result orchestrator.Result, formats []Format, now time.Time) (string, error) {
+	target, err := os.MkdirTemp(dir, "debate-fallback-")
+	if err != nil {
+		return "", fmt.Errorf("create fallback dir: %w", err)
+	}
+	path := NewTimestampPath(target, now)
+	if err := SaveResultFormats(path, result, formats); err != nil {
+		_ = os.RemoveAll(target)
+		return "", err
+	}
+	return path, nil
+}
+
 func MarkdownPath(path string) string {
 	ext := filepath.Ext(path)
 	if ext == "" {
@@ -1,7 +1,9 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,6 +22,12 @@ type turnItem struct {
 	Turn orchestrator.Turn
 }
 
+// RenderMarkdown formats result as the Markdown report SaveResult writes
+// next to the JSON result.
+func RenderMarkdown(result orchestrator.Result) string {
+	return formatResultMarkdown(result)
+}
+
 func formatResultMarkdown(result orchestrator.Result) string {
 	var b strings.Builder
 
@@ -29,19 +37,28 @@ func formatResultMarkdown(result orchestrator.Result) string {
 	b.WriteString(markdownBulletedText(result.Problem, "") + "\n\n")
 
 	writeConsensusSection(&b, result.Consensus)
+	writeOpenQuestionsSection(&b, result.OpenQuestions)
 	writePersonasSection(&b, result.Personas)
+	writePositionChangesSection(&b, result)
+	writeParticipationSection(&b, result)
 
 	b.WriteString("\n## Turns\n\n")
 	b.WriteString(formatTurnsBySpeaker(result.Turns))
 	b.WriteString("\n")
 
-	writeMetricsSection(&b, result.Metrics)
+	writeMetricsSection(&b, result)
 	return b.String()
 }
 
 func writeResultMetadata(b *strings.Builder, result orchestrator.Result) {
 	b.WriteString("- status: " + safeText(result.Status) + "\n")
+	if strings.TrimSpace(result.StopReason) != "" {
+		b.WriteString("- stop_reason: " + safeText(result.StopReason) + "\n")
+	}
 	b.WriteString(fmt.Sprintf("- consensus_score: %.2f\n", result.Consensus.Score))
+	if result.Consensus.Threshold > 0 {
+		b.WriteString(fmt.Sprintf("- consensus_threshold: %.2f\n", result.Consensus.Threshold))
+	}
 	if !result.StartedAt.IsZero() {
 		b.WriteString("- started_at: " + result.StartedAt.UTC().Format(time.RFC3339) + "\n")
 	}
@@ -52,12 +69,31 @@ func writeResultMetadata(b *strings.Builder, result orchestrator.Result) {
 		b.WriteString("- duration: " + result.EndedAt.Sub(result.StartedAt).Round(time.Millisecond).String() + "\n")
 	}
 	b.WriteString(fmt.Sprintf("- turns: %d\n", len(result.Turns)))
+	if !turnIndicesMonotonic(result.Turns) {
+		b.WriteString("- warning: " + nonMonotonicTurnsWarning + "\n")
+	}
+	if strings.TrimSpace(result.OpeningSpeakerSource) != "" {
+		b.WriteString("- opening_speaker_source: " + safeText(result.OpeningSpeakerSource) + "\n")
+	}
+}
+
+func writeOpenQuestionsSection(b *strings.Builder, questions []string) {
+	if len(questions) == 0 {
+		return
+	}
+	b.WriteString("\n## Open Questions\n\n")
+	for _, q := range questions {
+		b.WriteString(markdownBulletedText(rewriteTechnicalTerms(q), "") + "\n")
+	}
 }
 
 func writeConsensusSection(b *strings.Builder, consensus orchestrator.Consensus) {
 	b.WriteString("## Consensus\n\n")
 	b.WriteString(fmt.Sprintf("- reached: %t\n", consensus.Reached))
 	b.WriteString(fmt.Sprintf("- score: %.2f\n", consensus.Score))
+	if strings.TrimSpace(consensus.Model) != "" {
+		b.WriteString("- judge_model: " + safeText(consensus.Model) + "\n")
+	}
 	if strings.TrimSpace(consensus.Summary) != "" {
 		b.WriteString("\n### Summary\n\n")
 		b.WriteString(markdownBulletedText(rewriteTechnicalTerms(consensus.Summary), "") + "\n")
@@ -90,6 +126,9 @@ func writeConsensusSection(b *strings.Builder, consensus orchestrator.Consensus)
 		b.WriteString("\n### Required Next Action\n\n")
 		b.WriteString(markdownBulletedText(rewriteTechnicalTerms(consensus.RequiredNextAction), "") + "\n")
 	}
+	if consensus.OwnerMissing {
+		b.WriteString("\n> **Warning:** the required next action does not name an owner.\n")
+	}
 }
 
 func writePersonasSection(b *strings.Builder, personas []persona.Persona) {
@@ -105,16 +144,106 @@ func writePersonasSection(b *strings.Builder, personas []persona.Persona) {
 		if strings.TrimSpace(p.MasterName) != "" {
 			line += ", master_name: " + safeText(p.MasterName)
 		}
+		if p.Observer {
+			line += " (observer)"
+		}
 		b.WriteString(line + "\n")
 	}
 }
 
-func writeMetricsSection(b *strings.Builder, metrics orchestrator.Metrics) {
+// positionShiftThreshold marks drift values worth calling out as a real shift.
+const positionShiftThreshold = 0.6
+
+func writePositionChangesSection(b *strings.Builder, result orchestrator.Result) {
+	if len(result.StanceDrift) == 0 {
+		return
+	}
+	names := make(map[string]string, len(result.Personas))
+	for _, p := range result.Personas {
+		names[p.ID] = persona.DisplayName(p)
+	}
+	ids := make([]string, 0, len(result.StanceDrift))
+	for id := range result.StanceDrift {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		di, dj := result.StanceDrift[ids[i]], result.StanceDrift[ids[j]]
+		if di != dj {
+			return di > dj
+		}
+		return ids[i] < ids[j]
+	})
+
+	b.WriteString("\n## Position Changes\n\n")
+	for _, id := range ids {
+		name := names[id]
+		if strings.TrimSpace(name) == "" {
+			name = id
+		}
+		drift := result.StanceDrift[id]
+		line := fmt.Sprintf("%s (`%s`): drift %.2f", safeText(name), safeText(id), drift)
+		if drift >= positionShiftThreshold {
+			line = "**" + line + "** - shifted position"
+		}
+		b.WriteString("- " + line + "\n")
+	}
+}
+
+func writeMetricsSection(b *strings.Builder, result orchestrator.Result) {
+	metrics := result.Metrics
 	b.WriteString("## Metrics\n\n")
 	b.WriteString(fmt.Sprintf("- latency_ms: %d\n", metrics.LatencyMS))
 	b.WriteString(fmt.Sprintf("- prompt_tokens: %d\n", metrics.PromptTokens))
 	b.WriteString(fmt.Sprintf("- completion_tokens: %d\n", metrics.CompletionTokens))
 	b.WriteString(fmt.Sprintf("- total_tokens: %d\n", metrics.TotalTokens))
+	if metrics.EstimatedCostUSD > 0 {
+		b.WriteString(fmt.Sprintf("- estimated_cost_usd: %.4f\n", metrics.EstimatedCostUSD))
+	}
+	writePerSpeakerMetrics(b, result)
+}
+
+// writePerSpeakerMetrics renders Result.PerSpeaker in persona order, then the
+// moderator, then any speaker IDs no longer among the personas.
+func writePerSpeakerMetrics(b *strings.Builder, result orchestrator.Result) {
+	if len(result.PerSpeaker) == 0 {
+		return
+	}
+	names := make(map[string]string, len(result.Personas)+1)
+	order := make([]string, 0, len(result.PerSpeaker))
+	for _, p := range result.Personas {
+		if _, ok := result.PerSpeaker[p.ID]; ok {
+			if _, seen := names[p.ID]; !seen {
+				order = append(order, p.ID)
+			}
+			names[p.ID] = persona.DisplayName(p)
+		}
+	}
+	if _, ok := result.PerSpeaker[orchestrator.ModeratorSpeakerID]; ok {
+		if _, seen := names[orchestrator.ModeratorSpeakerID]; !seen {
+			order = append(order, orchestrator.ModeratorSpeakerID)
+			names[orchestrator.ModeratorSpeakerID] = "moderator"
+		}
+	}
+	var rest []string
+	for id := range result.PerSpeaker {
+		if _, seen := names[id]; !seen {
+			rest = append(rest, id)
+		}
+	}
+	sort.Strings(rest)
+	order = append(order, rest...)
+
+	b.WriteString("\n| speaker | turns | prompt_tokens | completion_tokens | total_tokens |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, id := range order {
+		m := result.PerSpeaker[id]
+		name := names[id]
+		if strings.TrimSpace(name) == "" {
+			name = id
+		}
+		b.WriteString(fmt.Sprintf("| %s (`%s`) | %d | %d | %d | %d |\n",
+			strings.ReplaceAll(safeText(name), "|", "\\|"), safeText(id), m.Turns, m.PromptTokens, m.CompletionTokens, m.TotalTokens))
+	}
 }
 
 func formatTurnsBySpeaker(turns []orchestrator.Turn) string {
@@ -123,13 +252,15 @@ func formatTurnsBySpeaker(turns []orchestrator.Turn) string {
 	}
 
 	groups := groupTurnsBySpeaker(turns)
+	anchors := citationAnchors(turns)
+	byPosition := !turnIndicesMonotonic(turns)
 	var b strings.Builder
 
 	b.WriteString("### TOC (turn order)\n\n")
 	for i, turn := range turns {
 		seq := i + 1
-		b.WriteString(fmt.Sprintf("- [Turn %d · %s (%s)](#%s)\n",
-			turn.Index,
+		b.WriteString(fmt.Sprintf("- [%s · %s (%s)](#%s)\n",
+			turnLabel(seq, turn, byPosition),
 			safeText(displaySpeaker(turn)),
 			safeText(turn.Type),
 			turnAnchor(seq),
@@ -146,15 +277,7 @@ func formatTurnsBySpeaker(turns []orchestrator.Turn) string {
 		))
 
 		for _, item := range group.Turns {
-			t := item.Turn
-			b.WriteString(fmt.Sprintf("<a id=\"%s\"></a>\n", turnAnchor(item.Seq)))
-			header := fmt.Sprintf("#### Turn %d · %s (%s)", t.Index, safeText(displaySpeaker(t)), safeText(t.Type))
-			b.WriteString(header + "\n\n")
-			if !t.Timestamp.IsZero() {
-				b.WriteString("- timestamp: " + t.Timestamp.UTC().Format(time.RFC3339) + "\n")
-			}
-			b.WriteString("- content:\n")
-			b.WriteString(markdownBulletedText(sanitizeTurnContentForDisplay(t.Content), "  ") + "\n\n")
+			writeTurnBlock(&b, item.Seq, item.Turn, anchors, byPosition)
 		}
 
 		b.WriteString("</details>\n")
@@ -165,6 +288,75 @@ func formatTurnsBySpeaker(turns []orchestrator.Turn) string {
 	return b.String()
 }
 
+func writeTurnBlock(b *strings.Builder, seq int, t orchestrator.Turn, anchors map[int]string, byPosition bool) {
+	b.WriteString(fmt.Sprintf("<a id=\"%s\"></a>\n", turnAnchor(seq)))
+	header := fmt.Sprintf("#### %s · %s (%s)", turnLabel(seq, t, byPosition), safeText(displaySpeaker(t)), safeText(t.Type))
+	b.WriteString(header + "\n\n")
+	if !t.Timestamp.IsZero() {
+		b.WriteString("- timestamp: " + t.Timestamp.UTC().Format(time.RFC3339) + "\n")
+	}
+	if strings.TrimSpace(t.Model) != "" {
+		b.WriteString("- model: " + safeText(t.Model) + "\n")
+	}
+	if len(t.Structured) > 0 {
+		b.WriteString("\n")
+		writeStructuredFields(b, t.Structured)
+		b.WriteString("\n")
+		return
+	}
+	if ask := strings.TrimSpace(t.ModeratorAsk); ask != "" {
+		b.WriteString("\n> **Ask:** " + safeText(ask) + "\n\n")
+	}
+	b.WriteString("- content:\n")
+	content := markdownBulletedText(sanitizeTurnContentForDisplay(t.Content), "  ")
+	b.WriteString(linkTurnCitations(content, anchors) + "\n\n")
+}
+
+// structuredFieldOrder lists schema fields rendered before any extra keys.
+var structuredFieldOrder = []string{"claim", "evidence", "next_step"}
+
+// writeStructuredFields renders a structured turn as a definition list.
+func writeStructuredFields(b *strings.Builder, fields map[string]any) {
+	keys := make([]string, 0, len(fields))
+	seen := make(map[string]struct{}, len(structuredFieldOrder))
+	for _, key := range structuredFieldOrder {
+		if _, ok := fields[key]; ok {
+			keys = append(keys, key)
+			seen[key] = struct{}{}
+		}
+	}
+	extra := make([]string, 0, len(fields))
+	for key := range fields {
+		if _, ok := seen[key]; !ok {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	keys = append(keys, extra...)
+
+	b.WriteString("<dl>\n")
+	for _, key := range keys {
+		b.WriteString("<dt>" + safeText(key) + "</dt>\n")
+		b.WriteString("<dd>" + safeText(structuredFieldText(fields[key])) + "</dd>\n")
+	}
+	b.WriteString("</dl>\n")
+}
+
+func structuredFieldText(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(encoded)
+	}
+}
+
 func groupTurnsBySpeaker(turns []orchestrator.Turn) []turnSpeakerGroup {
 	groups := make([]turnSpeakerGroup, 0, len(turns))
 	indexByKey := make(map[string]int, len(turns))
@@ -1,6 +1,7 @@
 package output
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"debate/internal/orchestrator"
+	"debate/internal/persona"
 )
 
 func TestSaveResultWritesJSONAndMarkdown(t *testing.T) {
@@ -246,6 +248,164 @@ func TestFormatResultMarkdownHidesDirectiveMetadataLines(t *testing.T) {
 	}
 }
 
+func TestFormatResultMarkdownRendersStructuredTurnAsDefinitionList(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusMaxTurnsReached,
+		Turns: []orchestrator.Turn{
+			{
+				Index:       1,
+				SpeakerID:   "p1",
+				SpeakerName: "A",
+				Type:        orchestrator.TurnTypePersona,
+				Content:     "단계적 롤아웃\nEvidence: 장애 2건\nNext step: 카나리",
+				Structured: map[string]any{
+					"next":      "p2",
+					"next_step": "카나리",
+					"evidence":  "장애 <2>건",
+					"claim":     "단계적 롤아웃",
+				},
+			},
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	want := "<dl>\n" +
+		"<dt>claim</dt>\n<dd>단계적 롤아웃</dd>\n" +
+		"<dt>evidence</dt>\n<dd>장애 &lt;2&gt;건</dd>\n" +
+		"<dt>next_step</dt>\n<dd>카나리</dd>\n" +
+		"<dt>next</dt>\n<dd>p2</dd>\n" +
+		"</dl>\n"
+	if !strings.Contains(md, want) {
+		t.Fatalf("expected structured definition list %q, got %q", want, md)
+	}
+	if strings.Contains(md, "- content:") {
+		t.Fatalf("expected structured turn to replace content bullets, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownAnnotatesModels(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "p1", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "주장", Model: "gpt-main"},
+		},
+		Consensus: orchestrator.Consensus{Reached: true, Score: 0.9, Model: "gpt-judge"},
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "- model: gpt-main\n") {
+		t.Fatalf("expected turn model annotation, got %q", md)
+	}
+	if !strings.Contains(md, "- judge_model: gpt-judge\n") {
+		t.Fatalf("expected judge model annotation, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownHighlightsModeratorAsk(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "moderator", SpeakerName: "사회자", Type: orchestrator.TurnTypeModerator, Content: "SYNTHESIS: s\nASK: A, which risk?", ModeratorAsk: "A, which risk?"},
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "> **Ask:** A, which risk?\n") {
+		t.Fatalf("expected ask callout, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownShowsStopReason(t *testing.T) {
+	result := orchestrator.Result{
+		Problem:    "test",
+		Status:     orchestrator.StatusNoProgressReached,
+		StopReason: orchestrator.StopReasonNoProgressCloseSignals,
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "- stop_reason: no_progress:close_signals\n") {
+		t.Fatalf("expected stop reason in metadata, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownWarnsOnMissingActionOwner(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusMaxTurnsReached,
+		Consensus: orchestrator.Consensus{
+			Score:              0.5,
+			RequiredNextAction: "ship the canary by Friday",
+			OwnerMissing:       true,
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "does not name an owner") {
+		t.Fatalf("expected missing owner warning, got %q", md)
+	}
+	result.Consensus.OwnerMissing = false
+	if md := formatResultMarkdown(result); strings.Contains(md, "does not name an owner") {
+		t.Fatalf("unexpected missing owner warning, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownLinksTurnCitations(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "p1", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "첫 주장"},
+			{Index: 2, SpeakerID: "p2", SpeakerName: "B", Type: orchestrator.TurnTypePersona, Content: "[1]에 동의하지만 [99]는 근거가 없다"},
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "[1](#turn-1)에 동의") {
+		t.Fatalf("expected [1] to link to turn-1 anchor, got %q", md)
+	}
+	if !strings.Contains(md, "[99]는") || strings.Contains(md, "[99](") {
+		t.Fatalf("expected out-of-range citation to stay literal, got %q", md)
+	}
+}
+
+func TestFormatResultMarkdownNumbersDuplicateTurnIndicesByPosition(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "test",
+		Status:  orchestrator.StatusMaxTurnsReached,
+		Turns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "p1", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "첫 주장"},
+			{Index: 2, SpeakerID: "p2", SpeakerName: "B", Type: orchestrator.TurnTypePersona, Content: "반론"},
+			{Index: 2, SpeakerID: "p1", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "[2]에 대한 재반론"},
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	for seq := 1; seq <= 3; seq++ {
+		anchor := fmt.Sprintf("<a id=\"turn-%d\"></a>", seq)
+		if strings.Count(md, anchor) != 1 {
+			t.Fatalf("expected exactly one %s anchor, got %q", anchor, md)
+		}
+	}
+	if !strings.Contains(md, "- warning: "+nonMonotonicTurnsWarning+"\n") {
+		t.Fatalf("expected non-monotonic index warning, got %q", md)
+	}
+	if !strings.Contains(md, "#### Turn 3 (index 2) · A (persona)") {
+		t.Fatalf("expected position-numbered header with stored index, got %q", md)
+	}
+	if !strings.Contains(md, "[2](#turn-2)에 대한") {
+		t.Fatalf("expected duplicate-index citation to link to the first turn, got %q", md)
+	}
+
+	result.Turns[2].Index = 3
+	md = formatResultMarkdown(result)
+	if strings.Contains(md, "- warning:") || strings.Contains(md, "(index ") {
+		t.Fatalf("expected monotonic indices to render unchanged, got %q", md)
+	}
+}
+
 func TestSanitizeTurnContentForDisplayRemovesDirectiveLines(t *testing.T) {
 	input := strings.Join([]string{
 		"일반 본문",
@@ -329,3 +489,102 @@ func TestFormatResultMarkdownRewritesTechnicalTermsInConsensus(t *testing.T) {
 		t.Fatalf("expected required action rewrite, got %q", md)
 	}
 }
+
+func TestFormatResultMarkdownListsPositionChanges(t *testing.T) {
+	result := orchestrator.Result{
+		Problem: "p",
+		Personas: []persona.Persona{
+			{ID: "a", Name: "Architect", Role: "design"},
+			{ID: "o", Name: "Operator", Role: "ops"},
+		},
+		StanceDrift: map[string]float64{"a": 0.85, "o": 0.1},
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "## Position Changes") {
+		t.Fatalf("expected position changes section, got %q", md)
+	}
+	shifted := "- **Architect (`a`): drift 0.85** - shifted position"
+	stable := "- Operator (`o`): drift 0.10"
+	if !strings.Contains(md, shifted) || !strings.Contains(md, stable) {
+		t.Fatalf("expected drift lines, got %q", md)
+	}
+	if strings.Index(md, shifted) > strings.Index(md, stable) {
+		t.Fatalf("expected largest drift first, got %q", md)
+	}
+}
+
+func TestParticipationStatsCountsTurnsWordsCitationsAndCloseVotes(t *testing.T) {
+	result := orchestrator.Result{
+		Personas: []persona.Persona{
+			{ID: "a", Name: "Architect"},
+			{ID: "o", Name: "Operator"},
+			{ID: "q", Name: "Quiet"},
+		},
+		Turns: []orchestrator.Turn{
+			{Index: 1, Type: orchestrator.TurnTypeModerator, SpeakerID: orchestrator.ModeratorSpeakerID, Content: "Opening [2] remarks"},
+			{Index: 2, Type: orchestrator.TurnTypePersona, SpeakerID: "a", Content: "Use a queue here"},
+			{Index: 3, Type: orchestrator.TurnTypePersona, SpeakerID: "o", Content: "Building on [2] we add alerts\nCLOSE: no"},
+			{Index: 4, Type: orchestrator.TurnTypePersona, SpeakerID: "a", Content: "Agreed with [3] and my own [2]\nCLOSE: yes"},
+		},
+	}
+
+	stats := ParticipationStats(result)
+	if len(stats) != 3 {
+		t.Fatalf("expected one row per persona, got %+v", stats)
+	}
+	want := []struct {
+		id           string
+		turns, words int
+		cited        int
+		vote         string
+	}{
+		{id: "a", turns: 2, words: 4 + 9, cited: 2, vote: "yes"},
+		{id: "o", turns: 1, words: 8, cited: 1, vote: "no"},
+		{id: "q", turns: 0, words: 0, cited: 0, vote: "-"},
+	}
+	for i, w := range want {
+		got := stats[i]
+		vote := "-"
+		if got.CloseVote != nil {
+			vote = "no"
+			if *got.CloseVote {
+				vote = "yes"
+			}
+		}
+		if got.PersonaID != w.id || got.Turns != w.turns || got.Words != w.words || got.Cited != w.cited || vote != w.vote {
+			t.Fatalf("row %d = %+v (vote %s), want %+v", i, got, vote, w)
+		}
+	}
+
+	md := formatResultMarkdown(result)
+	if !strings.Contains(md, "## Participation") || !strings.Contains(md, "| Architect (`a`) | 2 | 13 | 2 | yes |") {
+		t.Fatalf("expected participation table in markdown, got:\n%s", md)
+	}
+}
+
+func TestMarkdownMetricsIncludesPerSpeakerTable(t *testing.T) {
+	result := orchestrator.Result{
+		Personas: []persona.Persona{{ID: "a", Name: "Architect"}, {ID: "o", Name: "Operator"}},
+		PerSpeaker: map[string]orchestrator.SpeakerMetrics{
+			orchestrator.ModeratorSpeakerID: {Turns: 2, PromptTokens: 6, CompletionTokens: 6, TotalTokens: 12},
+			"o":                             {Turns: 1, PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+			"a":                             {Turns: 2, PromptTokens: 20, CompletionTokens: 10, TotalTokens: 30},
+		},
+	}
+
+	md := formatResultMarkdown(result)
+	rows := []string{
+		"| Architect (`a`) | 2 | 20 | 10 | 30 |",
+		"| Operator (`o`) | 1 | 10 | 5 | 15 |",
+		"| moderator (`" + orchestrator.ModeratorSpeakerID + "`) | 2 | 6 | 6 | 12 |",
+	}
+	last := -1
+	for _, row := range rows {
+		at := strings.Index(md, row)
+		if at < 0 || at < last {
+			t.Fatalf("expected per-speaker row %q in persona order, got:\n%s", row, md)
+		}
+		last = at
+	}
+}
@@ -84,6 +84,16 @@ func sanitizeTurnContentForDisplay(content string) string {
 	if strings.TrimSpace(content) == "" {
 		return ""
 	}
+	visible := visibleContentLines(content)
+	for i, line := range visible {
+		visible[i] = rewriteTechnicalTerms(line)
+	}
+	return strings.TrimSpace(strings.Join(visible, "\n"))
+}
+
+// visibleContentLines returns the trimmed content lines left after dropping
+// directive lines, bare list markers and evidence-quality metadata.
+func visibleContentLines(content string) []string {
 	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
 	visible := make([]string, 0, len(lines))
 	for _, line := range lines {
@@ -98,9 +108,9 @@ func sanitizeTurnContentForDisplay(content string) string {
 		if isHiddenDirectiveLine(trimmed) {
 			continue
 		}
-		visible = append(visible, rewriteTechnicalTerms(trimmed))
+		visible = append(visible, trimmed)
 	}
-	return strings.TrimSpace(strings.Join(visible, "\n"))
+	return visible
 }
 
 func rewriteTechnicalTerms(text string) string {
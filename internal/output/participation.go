@@ -0,0 +1,98 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+// PersonaStats is one persona's row in the participation scoreboard.
+type PersonaStats struct {
+	PersonaID string `json:"persona_id"`
+	Name      string `json:"name"`
+	Turns     int    `json:"turns"`
+	Words     int    `json:"words"`
+	// Cited counts `[N]` citations of this persona's turns by other speakers.
+	Cited int `json:"cited"`
+	// CloseVote is the persona's latest CLOSE vote; nil when it never voted.
+	CloseVote *bool `json:"close_vote,omitempty"`
+}
+
+// ParticipationStats computes per-persona participation from the stored
+// turns, one row per persona in result.Personas order.
+func ParticipationStats(result orchestrator.Result) []PersonaStats {
+	stats := make([]PersonaStats, 0, len(result.Personas))
+	rowByID := make(map[string]int, len(result.Personas))
+	for _, p := range result.Personas {
+		if _, dup := rowByID[p.ID]; dup {
+			continue
+		}
+		rowByID[p.ID] = len(stats)
+		stats = append(stats, PersonaStats{PersonaID: p.ID, Name: persona.DisplayName(p)})
+	}
+
+	// As with citation links, the first turn with a duplicated index wins.
+	speakerByIndex := make(map[int]string, len(result.Turns))
+	for _, turn := range result.Turns {
+		if turn.Type != orchestrator.TurnTypePersona || turn.Index <= 0 {
+			continue
+		}
+		if _, ok := speakerByIndex[turn.Index]; !ok {
+			speakerByIndex[turn.Index] = turn.SpeakerID
+		}
+	}
+
+	for _, turn := range result.Turns {
+		for _, m := range turnCitationPattern.FindAllStringSubmatch(turn.Content, -1) {
+			idx, err := strconv.Atoi(m[1])
+			if err != nil {
+				continue
+			}
+			cited, ok := speakerByIndex[idx]
+			if !ok || cited == turn.SpeakerID {
+				continue
+			}
+			if row, ok := rowByID[cited]; ok {
+				stats[row].Cited++
+			}
+		}
+
+		if turn.Type != orchestrator.TurnTypePersona {
+			continue
+		}
+		row, ok := rowByID[turn.SpeakerID]
+		if !ok {
+			continue
+		}
+		stats[row].Turns++
+		stats[row].Words += len(strings.Fields(turn.Content))
+		if vote, ok := orchestrator.CloseVote(turn.Content); ok {
+			stats[row].CloseVote = &vote
+		}
+	}
+	return stats
+}
+
+func writeParticipationSection(b *strings.Builder, result orchestrator.Result) {
+	stats := ParticipationStats(result)
+	if len(stats) == 0 {
+		return
+	}
+	b.WriteString("\n## Participation\n\n")
+	b.WriteString("| persona | turns | words | cited | voted close |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | --- |\n")
+	for _, s := range stats {
+		vote := "-"
+		if s.CloseVote != nil {
+			vote = "no"
+			if *s.CloseVote {
+				vote = "yes"
+			}
+		}
+		name := strings.ReplaceAll(safeText(s.Name), "|", "\\|")
+		b.WriteString(fmt.Sprintf("| %s (`%s`) | %d | %d | %d | %s |\n", name, safeText(s.PersonaID), s.Turns, s.Words, s.Cited, vote))
+	}
+}
@@ -0,0 +1,43 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+// PromptArchive is the opt-in reproducibility sidecar saved next to a result:
+// the exact system prompts plus the persona and config snapshot of the run.
+type PromptArchive struct {
+	// SystemPrompts maps a prompt kind (turn, moderator, judge, ...) to the
+	// system prompt text sent to the model.
+	SystemPrompts map[string]string   `json:"system_prompts"`
+	Personas      []persona.Persona   `json:"personas"`
+	Config        orchestrator.Config `json:"config"`
+}
+
+// PromptsPath returns the prompt archive path next to the JSON result path.
+// It shares the result's stem so retention cleanup removes it with the set.
+func PromptsPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".prompts.json"
+}
+
+// SavePrompts writes archive to PromptsPath(path).
+func SavePrompts(path string, archive PromptArchive) error {
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal prompt archive: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create output dir: %w", err)
+	}
+	if err := writeAtomic(PromptsPath(path), data, 0o644); err != nil {
+		return fmt.Errorf("write prompt archive: %w", err)
+	}
+	return nil
+}
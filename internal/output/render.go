@@ -0,0 +1,33 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"debate/internal/orchestrator"
+)
+
+// LoadResult reads a result previously saved as JSON by SaveResult.
+func LoadResult(path string) (orchestrator.Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return orchestrator.Result{}, fmt.Errorf("read result: %w", err)
+	}
+	var result orchestrator.Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return orchestrator.Result{}, fmt.Errorf("decode result %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// Render formats result as one of the supported output formats, the same
+// bytes SaveResult would write for it.
+func Render(result orchestrator.Result, format Format) ([]byte, error) {
+	format = Format(strings.ToLower(strings.TrimSpace(string(format))))
+	if !HasFormat(supportedFormats, format) {
+		return nil, fmt.Errorf("unknown output format %q (supported: %s)", format, joinFormats(supportedFormats))
+	}
+	return renderResult(result, format)
+}
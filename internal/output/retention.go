@@ -0,0 +1,166 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RetentionOptions controls which saved debate artifacts Cleanup removes.
+// A zero MaxAge or MaxCount disables that rule.
+type RetentionOptions struct {
+	MaxAge   time.Duration
+	MaxCount int
+	// Now overrides the clock used for MaxAge; defaults to time.Now.
+	Now func() time.Time
+}
+
+func (o RetentionOptions) Enabled() bool {
+	return o.MaxAge > 0 || o.MaxCount > 0
+}
+
+// artifactNamePattern matches names produced by NewTimestampPath with any
+// Format extension, including the numeric suffix added when a timestamp
+// collides. Sets are grouped by stem, so a debate saved without JSON (for
+// example -formats md) is still found.
+var artifactNamePattern = regexp.MustCompile(`^((\d{8}-\d{6}\.\d{9})-debate(?:-\d{6})?)\.(` + formatExtensionPattern() + `)$`)
+
+const artifactTimestampLayout = "20060102-150405.000000000"
+
+type artifactSet struct {
+	// stemPath is the shared path without extension; JSONPath adds ".json".
+	stemPath    string
+	createdAt   time.Time
+	hasJSON     bool
+	hasMarkdown bool
+}
+
+func (s artifactSet) JSONPath() string {
+	return s.stemPath + "." + string(FormatJSON)
+}
+
+func formatExtensionPattern() string {
+	names := make([]string, 0, len(supportedFormats))
+	for _, format := range supportedFormats {
+		names = append(names, regexp.QuoteMeta(string(format)))
+	}
+	return strings.Join(names, "|")
+}
+
+// Cleanup deletes debate artifact sets (every file sharing a result stem,
+// whichever formats were written) that are older than MaxAge or beyond the
+// newest MaxCount.
+// Only files matching the NewTimestampPath naming pattern are considered.
+// It returns the paths that were removed.
+func Cleanup(dir string, opts RetentionOptions) ([]string, error) {
+	if !opts.Enabled() {
+		return nil, nil
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read output dir: %w", err)
+	}
+	sets := listArtifactSets(dir, entries)
+	sortArtifactSets(sets)
+
+	cutoff := time.Time{}
+	if opts.MaxAge > 0 {
+		cutoff = opts.Now().UTC().Add(-opts.MaxAge)
+	}
+
+	removed := make([]string, 0)
+	for i, set := range sets {
+		expired := opts.MaxAge > 0 && set.createdAt.Before(cutoff)
+		overflow := opts.MaxCount > 0 && i >= opts.MaxCount
+		if !expired && !overflow {
+			continue
+		}
+		paths, err := removeArtifactSet(dir, entries, set)
+		removed = append(removed, paths...)
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+func listArtifactSets(dir string, entries []os.DirEntry) []artifactSet {
+	sets := make([]artifactSet, 0, len(entries))
+	byStem := make(map[string]int)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := artifactNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		createdAt, err := time.Parse(artifactTimestampLayout, match[2])
+		if err != nil {
+			continue
+		}
+		i, seen := byStem[match[1]]
+		if !seen {
+			i = len(sets)
+			byStem[match[1]] = i
+			sets = append(sets, artifactSet{
+				stemPath:  filepath.Join(dir, match[1]),
+				createdAt: createdAt,
+			})
+		}
+		switch Format(match[3]) {
+		case FormatJSON:
+			sets[i].hasJSON = true
+		case FormatMarkdown:
+			sets[i].hasMarkdown = true
+		}
+	}
+	return sets
+}
+
+// sortArtifactSets orders sets newest first.
+func sortArtifactSets(sets []artifactSet) {
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].createdAt.Equal(sets[j].createdAt) {
+			return sets[i].stemPath > sets[j].stemPath
+		}
+		return sets[i].createdAt.After(sets[j].createdAt)
+	})
+}
+
+func removeArtifactSet(dir string, entries []os.DirEntry, set artifactSet) ([]string, error) {
+	stem := filepath.Base(set.stemPath)
+	removed := make([]string, 0, 2)
+	for _, entry := range entries {
+		if entry.IsDir() || !belongsToArtifactSet(entry.Name(), stem) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return removed, fmt.Errorf("remove artifact %q: %w", path, err)
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
+
+// belongsToArtifactSet reports whether name is the stem itself with an
+// extension (result.json, result.md) or a derived file like result.prompts.json.
+// A collision-suffixed sibling (stem-000001.json) is a separate set.
+func belongsToArtifactSet(name string, stem string) bool {
+	if !strings.HasPrefix(name, stem+".") {
+		return false
+	}
+	return !strings.Contains(name, ".tmp-")
+}
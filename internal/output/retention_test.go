@@ -0,0 +1,130 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCleanupKeepsNewestMaxCount(t *testing.T) {
+	tmp := t.TempDir()
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	paths := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		path := NewTimestampPath(tmp, base.Add(time.Duration(i)*time.Minute))
+		writeArtifactSet(t, path)
+		paths = append(paths, path)
+	}
+	unrelated := filepath.Join(tmp, "notes.json")
+	if err := os.WriteFile(unrelated, []byte("{}"), 0o644); err != nil {
+		t.Fatalf("write unrelated file: %v", err)
+	}
+
+	removed, err := Cleanup(tmp, RetentionOptions{MaxCount: 2})
+	if err != nil {
+		t.Fatalf("cleanup failed: %v", err)
+	}
+	if len(removed) != 6 {
+		t.Fatalf("expected 3 json+md sets removed, got %v", removed)
+	}
+	for i, path := range paths {
+		_, jsonErr := os.Stat(path)
+		_, mdErr := os.Stat(MarkdownPath(path))
+		if i >= 3 {
+			if jsonErr != nil || mdErr != nil {
+				t.Fatalf("expected newest set %d to survive, got json=%v md=%v", i, jsonErr, mdErr)
+			}
+			continue
+		}
+		if !os.IsNotExist(jsonErr) || !os.IsNotExist(mdErr) {
+			t.Fatalf("expected old set %d to be removed, got json=%v md=%v", i, jsonErr, mdErr)
+		}
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Fatalf("expected unrelated file to be kept, got %v", err)
+	}
+}
+
+func TestCleanupRemovesSetsOlderThanMaxAge(t *testing.T) {
+	tmp := t.TempDir()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	oldPath := NewTimestampPath(tmp, now.Add(-72*time.Hour))
+	collisionPath := oldPath[:len(oldPath)-len(".json")] + "-000001.json"
+	freshPath := NewTimestampPath(tmp, now.Add(-time.Hour))
+	writeArtifactSet(t, oldPath)
+	writeArtifactSet(t, collisionPath)
+	writeArtifactSet(t, freshPath)
+
+	removed, err := Cleanup(tmp, RetentionOptions{
+		MaxAge: 24 * time.Hour,
+		Now:    func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("cleanup failed: %v", err)
+	}
+	if len(removed) != 4 {
+		t.Fatalf("expected both expired sets removed, got %v", removed)
+	}
+	if _, err := os.Stat(freshPath); err != nil {
+		t.Fatalf("expected fresh set to survive, got %v", err)
+	}
+}
+
+func TestCleanupDisabledWithoutLimits(t *testing.T) {
+	tmp := t.TempDir()
+	path := NewTimestampPath(tmp, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	writeArtifactSet(t, path)
+
+	removed, err := Cleanup(tmp, RetentionOptions{})
+	if err != nil {
+		t.Fatalf("cleanup failed: %v", err)
+	}
+	if len(removed) != 0 {
+		t.Fatalf("expected no removals, got %v", removed)
+	}
+}
+
+func writeArtifactSet(t *testing.T, path string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+		t.Fatalf("write json: %v", err)
+	}
+	if err := os.WriteFile(MarkdownPath(path), []byte("# Debate Result"), 0o644); err != nil {
+		t.Fatalf("write markdown: %v", err)
+	}
+}
+
+func TestCleanupGroupsSetsWithoutJSON(t *testing.T) {
+	tmp := t.TempDir()
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	paths := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		path := NewTimestampPath(tmp, base.Add(time.Duration(i)*time.Minute))
+		for _, format := range []Format{FormatMarkdown, FormatHTML} {
+			if err := os.WriteFile(FormatPath(path, format), []byte("x"), 0o644); err != nil {
+				t.Fatalf("write %s: %v", format, err)
+			}
+		}
+		paths = append(paths, path)
+	}
+
+	summaries, err := ListResults(tmp)
+	if err != nil {
+		t.Fatalf("list results: %v", err)
+	}
+	if len(summaries) != 3 || summaries[0].MarkdownPath != MarkdownPath(paths[2]) || summaries[0].Path != "" {
+		t.Fatalf("expected one summary per markdown set, newest first, got %+v", summaries)
+	}
+
+	removed, err := Cleanup(tmp, RetentionOptions{MaxCount: 1})
+	if err != nil {
+		t.Fatalf("cleanup failed: %v", err)
+	}
+	if len(removed) != 4 {
+		t.Fatalf("expected 2 md+html sets removed, got %v", removed)
+	}
+	if _, err := os.Stat(FormatPath(paths[2], FormatHTML)); err != nil {
+		t.Fatalf("expected the newest set to survive, got %v", err)
+	}
+}
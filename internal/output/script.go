@@ -0,0 +1,108 @@
+package output
+
+import (
+	"html"
+	"strings"
+
+	"debate/internal/orchestrator"
+	"debate/internal/persona"
+)
+
+// RenderScript renders a TTS-ready script: one "SpeakerName: text" line per
+// turn, control lines removed, and a blank line whenever the speaker changes.
+func RenderScript(result orchestrator.Result) string {
+	var b strings.Builder
+	prevSpeaker := ""
+	for _, turn := range result.Turns {
+		text := scriptLine(turn.Content)
+		if text == "" {
+			continue
+		}
+		speaker := displaySpeaker(turn)
+		if prevSpeaker != "" && speaker != prevSpeaker {
+			b.WriteString("\n")
+		}
+		b.WriteString(speaker + ": " + text + "\n")
+		prevSpeaker = speaker
+	}
+	return b.String()
+}
+
+// moderatorSSMLVoice is the voice used for moderator turns in SSML exports.
+const moderatorSSMLVoice = "ko-KR-InJoonNeural"
+
+// defaultSSMLVoices are assigned round-robin to personas without a voice.
+var defaultSSMLVoices = []string{
+	"ko-KR-SunHiNeural",
+	"ko-KR-HyunsuNeural",
+	"ko-KR-JiMinNeural",
+	"ko-KR-BongJinNeural",
+	"ko-KR-SeoHyeonNeural",
+	"ko-KR-GookMinNeural",
+	"ko-KR-YuJinNeural",
+	"ko-KR-SoonBokNeural",
+}
+
+// RenderSSML renders the same script as SSML, wrapping each turn in
+// <voice name="..."> so every speaker sounds distinct.
+func RenderSSML(result orchestrator.Result) string {
+	voices := assignSSMLVoices(result.Personas)
+
+	var b strings.Builder
+	b.WriteString("<speak>\n")
+	for _, turn := range result.Turns {
+		text := scriptLine(turn.Content)
+		if text == "" {
+			continue
+		}
+		paragraph := "<p>" + html.EscapeString(text) + "</p>"
+		if voice, ok := voices[strings.ToLower(strings.TrimSpace(turn.SpeakerID))]; ok {
+			paragraph = "<voice name=\"" + html.EscapeString(voice) + "\">" + paragraph + "</voice>"
+		}
+		b.WriteString(paragraph + "\n")
+	}
+	b.WriteString("</speak>\n")
+	return b.String()
+}
+
+// assignSSMLVoices maps lowercased speaker IDs to voices. Configured persona
+// voices are kept; the rest get default voices not already taken, wrapping
+// around once the default list runs out.
+func assignSSMLVoices(personas []persona.Persona) map[string]string {
+	voices := map[string]string{orchestrator.ModeratorSpeakerID: moderatorSSMLVoice}
+	taken := map[string]bool{moderatorSSMLVoice: true}
+	for _, p := range personas {
+		if voice := strings.TrimSpace(p.Voice); voice != "" {
+			voices[strings.ToLower(p.ID)] = voice
+			taken[voice] = true
+		}
+	}
+
+	next := 0
+	for _, p := range personas {
+		key := strings.ToLower(p.ID)
+		if _, ok := voices[key]; ok {
+			continue
+		}
+		voice := defaultSSMLVoices[next%len(defaultSSMLVoices)]
+		for i := 0; i < len(defaultSSMLVoices) && taken[voice]; i++ {
+			next++
+			voice = defaultSSMLVoices[next%len(defaultSSMLVoices)]
+		}
+		next++
+		voices[key] = voice
+		taken[voice] = true
+	}
+	return voices
+}
+
+// ScriptPath returns the audio-script path next to the JSON result path.
+func ScriptPath(path string) string {
+	return FormatPath(path, FormatScript)
+}
+
+// scriptLine flattens the spoken part of a turn onto one line. Technical
+// terms are left as written so the glossary parentheses are not read aloud.
+func scriptLine(content string) string {
+	return strings.Join(strings.Fields(strings.Join(visibleContentLines(content), " ")), " ")
+}
@@ -0,0 +1,113 @@
+package output
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+// StreamingMarkdownWriter appends turns to a Markdown transcript while a
+// debate is running, so a partial transcript survives a crash. Finalize
+// replaces the live file with the regular grouped rendering.
+type StreamingMarkdownWriter struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	seq    int
+	closed bool
+	// anchors tracks turns written so far so later turns can link citations.
+	anchors map[int]string
+}
+
+func NewStreamingMarkdownWriter(path string, problem string, startedAt time.Time) (*StreamingMarkdownWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create output dir: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("create live markdown file: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Debate Result\n\n")
+	b.WriteString("- status: running\n")
+	if !startedAt.IsZero() {
+		b.WriteString("- started_at: " + startedAt.UTC().Format(time.RFC3339) + "\n")
+	}
+	b.WriteString("\n## Problem\n\n")
+	b.WriteString(markdownBulletedText(problem, "") + "\n\n")
+	b.WriteString("## Turns (live)\n\n")
+	if _, err := file.WriteString(b.String()); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("write live markdown header: %w", err)
+	}
+	return &StreamingMarkdownWriter{path: path, file: file, anchors: map[int]string{}}, nil
+}
+
+func (w *StreamingMarkdownWriter) Path() string {
+	return w.path
+}
+
+// AppendTurn writes one turn block in arrival order.
+func (w *StreamingMarkdownWriter) AppendTurn(turn orchestrator.Turn) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errors.New("live markdown writer is closed")
+	}
+
+	w.seq++
+	if turn.Index > 0 {
+		w.anchors[turn.Index] = turnAnchor(w.seq)
+	}
+	var b strings.Builder
+	writeTurnBlock(&b, w.seq, turn, w.anchors, false)
+	if _, err := w.file.WriteString(b.String()); err != nil {
+		return fmt.Errorf("append live markdown turn: %w", err)
+	}
+	return nil
+}
+
+// Finalize closes the live file and atomically replaces it with the same
+// rendering SaveResult produces for the finished result.
+func (w *StreamingMarkdownWriter) Finalize(result orchestrator.Result) error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := writeAtomic(w.path, []byte(formatResultMarkdown(result)), 0o644); err != nil {
+		return fmt.Errorf("write markdown result file: %w", err)
+	}
+	return nil
+}
+
+// Close stops appending and leaves the partial transcript in place.
+func (w *StreamingMarkdownWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("close live markdown file: %w", err)
+	}
+	return nil
+}
+
+// Discard closes the writer and removes the partial transcript.
+func (w *StreamingMarkdownWriter) Discard() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove live markdown file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+)
+
+func TestStreamingMarkdownWriterAppendsTurnsAndFinalizes(t *testing.T) {
+	tmp := t.TempDir()
+	path := filepath.Join(tmp, "live.md")
+	started := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	turns := []orchestrator.Turn{
+		{Index: 1, SpeakerID: "a", SpeakerName: "A", Type: orchestrator.TurnTypePersona, Content: "first point\nNEXT: b", Timestamp: started.Add(time.Second)},
+		{Index: 2, SpeakerID: "moderator", SpeakerName: "사회자", Type: orchestrator.TurnTypeModerator, Content: "next question", Timestamp: started.Add(2 * time.Second)},
+		{Index: 3, SpeakerID: "b", SpeakerName: "B", Type: orchestrator.TurnTypePersona, Content: "counter point", Timestamp: started.Add(3 * time.Second)},
+	}
+
+	writer, err := NewStreamingMarkdownWriter(path, "live problem", started)
+	if err != nil {
+		t.Fatalf("create writer failed: %v", err)
+	}
+	for i, turn := range turns {
+		if err := writer.AppendTurn(turn); err != nil {
+			t.Fatalf("append turn %d failed: %v", i+1, err)
+		}
+		partial, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read partial markdown: %v", err)
+		}
+		if !strings.Contains(string(partial), "· "+turn.SpeakerName+" ("+turn.Type+")") {
+			t.Fatalf("expected turn %d in partial transcript, got %q", i+1, partial)
+		}
+	}
+	partial, _ := os.ReadFile(path)
+	if !strings.Contains(string(partial), "- status: running") || !strings.Contains(string(partial), "- live problem") {
+		t.Fatalf("expected live header with problem, got %q", partial)
+	}
+	if strings.Contains(string(partial), "NEXT: b") {
+		t.Fatalf("expected directive lines hidden in live transcript, got %q", partial)
+	}
+
+	result := orchestrator.Result{
+		Problem:   "live problem",
+		Status:    orchestrator.StatusMaxTurnsReached,
+		Turns:     turns,
+		StartedAt: started,
+		EndedAt:   started.Add(4 * time.Second),
+		Consensus: orchestrator.Consensus{Score: 0.5, Summary: "partial alignment"},
+		Metrics:   orchestrator.Metrics{TotalTokens: 42},
+	}
+	if err := writer.Finalize(result); err != nil {
+		t.Fatalf("finalize failed: %v", err)
+	}
+	final, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read final markdown: %v", err)
+	}
+	if string(final) != formatResultMarkdown(result) {
+		t.Fatalf("expected finalized markdown to match batch output, got %q", final)
+	}
+	if err := writer.AppendTurn(turns[0]); err == nil {
+		t.Fatal("expected append after finalize to fail")
+	}
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+
+	"debate/internal/orchestrator"
+)
+
+const nonMonotonicTurnsWarning = "turn indices are duplicated or out of order; turns are numbered by position with the stored index in parentheses"
+
+// turnIndicesMonotonic reports whether stored turn indices strictly increase
+// in transcript order. Loaded or hand-edited results may break this.
+func turnIndicesMonotonic(turns []orchestrator.Turn) bool {
+	for i := 1; i < len(turns); i++ {
+		if turns[i].Index <= turns[i-1].Index {
+			return false
+		}
+	}
+	return true
+}
+
+// turnLabel is the heading for the turn at 1-based position seq. When the
+// stored indices cannot be trusted the position is shown first so headers
+// stay unique and match the TOC order.
+func turnLabel(seq int, turn orchestrator.Turn, byPosition bool) string {
+	if byPosition {
+		return fmt.Sprintf("Turn %d (index %d)", seq, turn.Index)
+	}
+	return fmt.Sprintf("Turn %d", turn.Index)
+}
@@ -0,0 +1,95 @@
+package persona
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PathListSeparator separates persona files in a path list such as
+// "team-a.json,team-b.json".
+const PathListSeparator = ","
+
+// MergeMode decides what LoadMerged does when two files declare the same ID.
+type MergeMode int
+
+const (
+	// MergeOverride keeps the first position of a duplicated ID but takes
+	// the persona from the later file.
+	MergeOverride MergeMode = iota
+	// MergeRejectConflicts fails on an ID declared by more than one file.
+	MergeRejectConflicts
+)
+
+// SplitPathList splits a comma-separated persona path list, dropping blanks.
+func SplitPathList(raw string) []string {
+	var paths []string
+	for _, part := range strings.Split(raw, PathListSeparator) {
+		if path := strings.TrimSpace(part); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// LoadFromPathList loads a single persona file or, for a comma-separated
+// list, merges the files with LoadMerged.
+func LoadFromPathList(raw string, mode MergeMode) ([]Persona, error) {
+	paths := SplitPathList(raw)
+	if len(paths) == 1 {
+		return LoadFromFile(paths[0])
+	}
+	return LoadMerged(mode, paths...)
+}
+
+// LoadFromFiles merges the rosters in paths, later files overriding
+// personas with the same ID from earlier ones.
+func LoadFromFiles(paths ...string) ([]Persona, error) {
+	return LoadMerged(MergeOverride, paths...)
+}
+
+// LoadMerged loads each file, merges the rosters in file order and validates
+// the result as one roster. IDs are matched case-insensitively across files;
+// duplicates inside one file and personas without an ID are left to
+// NormalizeAndValidate.
+func LoadMerged(mode MergeMode, paths ...string) ([]Persona, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("at least one persona file is required")
+	}
+
+	var merged []Persona
+	type origin struct {
+		index int
+		path  string
+	}
+	byID := make(map[string]origin)
+	for _, path := range paths {
+		personas, err := readPersonaFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		fileIDs := make(map[string]origin, len(personas))
+		for _, p := range personas {
+			key := strings.ToLower(strings.TrimSpace(p.ID))
+			if _, dup := fileIDs[key]; key == "" || dup {
+				merged = append(merged, p)
+				continue
+			}
+			if prev, ok := byID[key]; ok {
+				if mode == MergeRejectConflicts {
+					return nil, fmt.Errorf("persona id %q is declared in both %s and %s", strings.TrimSpace(p.ID), prev.path, path)
+				}
+				merged[prev.index] = p
+				fileIDs[key] = origin{index: prev.index, path: path}
+				continue
+			}
+			fileIDs[key] = origin{index: len(merged), path: path}
+			merged = append(merged, p)
+		}
+		// Registered after the file so its own duplicates still reach
+		// NormalizeAndValidate instead of silently overriding each other.
+		for key, o := range fileIDs {
+			byID[key] = o
+		}
+	}
+	return NormalizeAndValidate(merged)
+}
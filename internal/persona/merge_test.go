@@ -0,0 +1,96 @@
+package persona
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTeamFiles(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	teamA := filepath.Join(dir, "team-a.json")
+	writeTestFile(t, teamA, `[
+		{"id":"pm","name":"PM","role":"product"},
+		{"id":"ops","name":"Ops","role":"operations"}
+	]`)
+	teamB := filepath.Join(dir, "team-b.json")
+	writeTestFile(t, teamB, `[
+		{"id":"OPS","name":"Ops Lead","role":"incident response"},
+		{"id":"data","name":"Data","role":"analytics"}
+	]`)
+	return teamA, teamB
+}
+
+func TestLoadFromFilesMergesRostersInFileOrder(t *testing.T) {
+	dir := t.TempDir()
+	teamA := filepath.Join(dir, "team-a.json")
+	writeTestFile(t, teamA, `[{"id":"pm","name":"PM","role":"product"}]`)
+	teamB := filepath.Join(dir, "team-b.json")
+	writeTestFile(t, teamB, `[{"id":"data","name":"Data","role":"analytics"}]`)
+
+	personas, err := LoadFromFiles(teamA, teamB)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(personas) != 2 || personas[0].ID != "pm" || personas[1].ID != "data" {
+		t.Fatalf("unexpected merged roster: %+v", personas)
+	}
+}
+
+func TestLoadFromFilesLaterFileOverridesSameID(t *testing.T) {
+	teamA, teamB := writeTeamFiles(t)
+
+	personas, err := LoadFromFiles(teamA, teamB)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(personas) != 3 {
+		t.Fatalf("expected 3 personas after override, got %+v", personas)
+	}
+	if personas[1].ID != "OPS" || personas[1].Role != "incident response" {
+		t.Fatalf("expected team-b ops to replace team-a ops in place, got %+v", personas[1])
+	}
+	if personas[2].ID != "data" {
+		t.Fatalf("expected data last, got %+v", personas[2])
+	}
+}
+
+func TestLoadMergedRejectsConflictingIDs(t *testing.T) {
+	teamA, teamB := writeTeamFiles(t)
+
+	_, err := LoadMerged(MergeRejectConflicts, teamA, teamB)
+	if err == nil || !strings.Contains(err.Error(), `"OPS"`) || !strings.Contains(err.Error(), "team-a.json") {
+		t.Fatalf("expected conflict error naming the id and files, got %v", err)
+	}
+}
+
+func TestLoadMergedKeepsDuplicateIDsWithinOneFile(t *testing.T) {
+	dir := t.TempDir()
+	teamA := filepath.Join(dir, "team-a.json")
+	writeTestFile(t, teamA, `[{"id":"pm","name":"PM","role":"product"}]`)
+	teamB := filepath.Join(dir, "team-b.json")
+	writeTestFile(t, teamB, `[
+		{"id":"data","name":"Data","role":"analytics"},
+		{"id":"data","name":"Data 2","role":"analytics"}
+	]`)
+
+	if _, err := LoadFromFiles(teamA, teamB); err == nil || !strings.Contains(err.Error(), "duplicate persona id") {
+		t.Fatalf("expected duplicate id error, got %v", err)
+	}
+}
+
+func TestLoadFromPathListSplitsCommaSeparatedPaths(t *testing.T) {
+	teamA, teamB := writeTeamFiles(t)
+
+	personas, err := LoadFromPathList(" "+teamA+" , "+teamB+",", MergeOverride)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(personas) != 3 {
+		t.Fatalf("expected merged roster of 3, got %d", len(personas))
+	}
+	if _, err := LoadFromPathList(teamA+","+teamB, MergeRejectConflicts); err == nil {
+		t.Fatal("expected conflict error in reject mode")
+	}
+}
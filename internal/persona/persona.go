@@ -1,15 +1,19 @@
 package persona
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"unicode"
 )
 
 const (
 	MinPersonas = 2
 	MaxPersonas = 12
+	// MaxWeight caps Persona.Weight; the weighted rotation schedule grows
+	// with the sum of weights.
+	MaxWeight = 100
 )
 
 type Persona struct {
@@ -22,17 +26,50 @@ type Persona struct {
 	Expertise     []string `json:"expertise,omitempty"`
 	SignatureLens []string `json:"signature_lens,omitempty"`
 	Constraints   []string `json:"constraints,omitempty"`
+	// RequiredConsiderations are named constraints (e.g. "GDPR") every turn
+	// by this persona must explicitly address. Compliance is advisory.
+	RequiredConsiderations []string `json:"required_considerations,omitempty"`
+	// GeneratedID is set when ID was omitted and derived from Name.
+	GeneratedID bool `json:"generated_id,omitempty"`
+	// ReferenceDocs are snippets the persona should ground its turns in.
+	// Entries prefixed with "file:" are read from paths relative to the
+	// persona file when loaded with LoadFromFile.
+	ReferenceDocs []string `json:"reference_docs,omitempty"`
+	// HandoffPriority ranks personas when a turn hands off to several at
+	// once and the "priority" ambiguous-handoff policy is active; higher wins.
+	HandoffPriority int `json:"handoff_priority,omitempty"`
+	// Voice is the TTS voice name used for this persona in SSML exports.
+	Voice string `json:"voice,omitempty"`
+	// Observer personas never take turns; their role and signature lens are
+	// passed to the moderator and judge as stakeholder perspectives.
+	Observer bool `json:"observer,omitempty"`
+	// ExcludeFromConsensus marks a speaking persona (a facilitator or fact
+	// provider) whose statements are context only: the judge does not treat
+	// it as a party, and it is left out of close-vote and speaker tallies.
+	ExcludeFromConsensus bool `json:"exclude_from_consensus,omitempty"`
+	// OpeningStatement, when set, is used verbatim as the persona's first
+	// turn instead of a generated one.
+	OpeningStatement string `json:"opening_statement,omitempty"`
+	// CanInterrupt lets the persona take the next turn out of order when
+	// another persona's turn contains one of InterruptTriggers, at most
+	// InterruptBudget times per debate (0 means once).
+	CanInterrupt      bool     `json:"can_interrupt,omitempty"`
+	InterruptTriggers []string `json:"interrupt_triggers,omitempty"`
+	InterruptBudget   int      `json:"interrupt_budget,omitempty"`
+	// Creativity (0..1) sets how freely the persona's turns are sampled:
+	// 0 suits a careful analyst, 1 a brainstormer. Nil keeps the model's
+	// default sampling.
+	Creativity *float64 `json:"creativity,omitempty"`
+	// Weight biases round-robin fallback handoffs: a persona with weight 3
+	// gets about three fallback turns for every one of a weight-1 persona.
+	// 0 means the default of 1; explicit handoffs ignore it.
+	Weight int `json:"weight,omitempty"`
 }
 
 func LoadFromFile(path string) ([]Persona, error) {
-	data, err := os.ReadFile(path)
+	personas, err := readPersonaFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("read persona file: %w", err)
-	}
-
-	var personas []Persona
-	if err := json.Unmarshal(data, &personas); err != nil {
-		return nil, fmt.Errorf("parse persona json: %w", err)
+		return nil, err
 	}
 
 	normalized, err := NormalizeAndValidate(personas)
@@ -42,6 +79,27 @@ func LoadFromFile(path string) ([]Persona, error) {
 	return normalized, nil
 }
 
+// readPersonaFile parses one JSON or YAML persona file and resolves its
+// reference docs without validating the roster, which may be only part of a
+// merged one.
+func readPersonaFile(path string) ([]Persona, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read persona file: %w", err)
+	}
+
+	personas, err := decodePersonaData(path, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveReferenceDocs(personas, filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	return personas, nil
+}
+
+// NormalizeAndValidate trims and defaults persona fields and rejects rosters
+// that cannot run. Soft issues such as an empty role are left to Warnings.
 func NormalizeAndValidate(personas []Persona) ([]Persona, error) {
 	if len(personas) < MinPersonas {
 		return nil, fmt.Errorf("at least %d personas are required", MinPersonas)
@@ -52,6 +110,14 @@ func NormalizeAndValidate(personas []Persona) ([]Persona, error) {
 
 	seen := make(map[string]struct{}, len(personas))
 	out := make([]Persona, 0, len(personas))
+	// Explicit IDs are reserved up front so a generated slug never takes an
+	// ID that a later persona declares.
+	reserved := make(map[string]struct{}, len(personas))
+	for _, p := range personas {
+		if id := strings.TrimSpace(p.ID); id != "" {
+			reserved[strings.ToLower(id)] = struct{}{}
+		}
+	}
 
 	for i, p := range personas {
 		p.ID = strings.TrimSpace(p.ID)
@@ -60,15 +126,19 @@ func NormalizeAndValidate(personas []Persona) ([]Persona, error) {
 		p.Role = strings.TrimSpace(p.Role)
 		p.Stance = strings.TrimSpace(p.Stance)
 		p.Style = strings.TrimSpace(p.Style)
+		p.Voice = strings.TrimSpace(p.Voice)
+		p.OpeningStatement = strings.TrimSpace(p.OpeningStatement)
 
-		if p.ID == "" {
-			return nil, fmt.Errorf("persona[%d].id is required", i)
+		if p.ID == "" && p.Name == "" {
+			return nil, fmt.Errorf("persona[%d] requires an id or a name", i)
 		}
 		if p.Name == "" {
 			return nil, fmt.Errorf("persona[%d].name is required", i)
 		}
-		if p.Role == "" {
-			return nil, fmt.Errorf("persona[%d].role is required", i)
+		if p.ID == "" {
+			p.ID = uniqueSlugID(p.Name, reserved)
+			reserved[strings.ToLower(p.ID)] = struct{}{}
+			p.GeneratedID = true
 		}
 		idKey := strings.ToLower(p.ID)
 		if _, exists := seen[idKey]; exists {
@@ -79,16 +149,113 @@ func NormalizeAndValidate(personas []Persona) ([]Persona, error) {
 		p.Expertise = trimNonEmpty(p.Expertise)
 		p.SignatureLens = trimNonEmpty(p.SignatureLens)
 		p.Constraints = trimNonEmpty(p.Constraints)
+		p.RequiredConsiderations = trimNonEmpty(p.RequiredConsiderations)
+		p.ReferenceDocs = trimNonEmpty(p.ReferenceDocs)
+		for _, doc := range p.ReferenceDocs {
+			if _, isFile := referenceDocPath(doc); isFile {
+				return nil, fmt.Errorf("persona[%d].reference_docs: %s entries are only supported in persona files", i, ReferenceDocFilePrefix)
+			}
+		}
+		if p.Voice != "" && !isVoiceToken(p.Voice) {
+			return nil, fmt.Errorf("persona[%d].voice must be a simple token (letters, digits, '.', '_', '-'): %q", i, p.Voice)
+		}
+		p.InterruptTriggers = trimNonEmpty(p.InterruptTriggers)
+		if p.CanInterrupt && len(p.InterruptTriggers) == 0 {
+			return nil, fmt.Errorf("persona[%d].interrupt_triggers is required when can_interrupt is true", i)
+		}
+		if p.InterruptBudget < 0 {
+			return nil, fmt.Errorf("persona[%d].interrupt_budget must be >= 0", i)
+		}
+		if p.Creativity != nil && (*p.Creativity < 0 || *p.Creativity > 1) {
+			return nil, fmt.Errorf("persona[%d].creativity must be between 0 and 1, got %v", i, *p.Creativity)
+		}
+		if p.Weight < 0 {
+			p.Weight = 1
+		}
+		if p.Weight > MaxWeight {
+			return nil, fmt.Errorf("persona[%d].weight must be at most %d, got %d", i, MaxWeight, p.Weight)
+		}
 		if p.Stance == "" {
 			p.Stance = "neutral"
 		}
 
 		out = append(out, p)
 	}
+	if speakers := len(Speakers(out)); speakers < MinPersonas {
+		return nil, fmt.Errorf("at least %d non-observer personas are required, got %d", MinPersonas, speakers)
+	}
+	if parties := len(ConsensusParties(out)); parties < MinPersonas {
+		return nil, fmt.Errorf("at least %d speaking personas must count toward consensus, got %d", MinPersonas, parties)
+	}
 
 	return out, nil
 }
 
+// Speakers returns the personas that take turns, in their original order.
+func Speakers(personas []Persona) []Persona {
+	return filterObserver(personas, false)
+}
+
+// Observers returns the personas that never speak.
+func Observers(personas []Persona) []Persona {
+	return filterObserver(personas, true)
+}
+
+// ConsensusParties returns the speakers whose positions count toward
+// consensus, in their original order.
+func ConsensusParties(personas []Persona) []Persona {
+	var out []Persona
+	for _, p := range Speakers(personas) {
+		if !p.ExcludeFromConsensus {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func filterObserver(personas []Persona, observer bool) []Persona {
+	var out []Persona
+	for _, p := range personas {
+		if p.Observer == observer {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// uniqueSlugID derives a lowercase snake_case ID from name ("Growth Lead" ->
+// "growth_lead") and appends _2, _3, ... until it is not in taken.
+func uniqueSlugID(name string, taken map[string]struct{}) string {
+	base := slugID(name)
+	id := base
+	for n := 2; ; n++ {
+		if _, exists := taken[id]; !exists {
+			return id
+		}
+		id = fmt.Sprintf("%s_%d", base, n)
+	}
+}
+
+func slugID(name string) string {
+	var b strings.Builder
+	pendingSep := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingSep && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			pendingSep = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingSep = true
+	}
+	if b.Len() == 0 {
+		return "persona"
+	}
+	return b.String()
+}
+
 func DisplayName(p Persona) string {
 	name := strings.TrimSpace(p.Name)
 	master := strings.TrimSpace(p.MasterName)
@@ -102,6 +269,23 @@ func DisplayName(p Persona) string {
 	}
 }
 
+// isVoiceToken reports whether voice is a TTS voice id such as
+// "en-US-JennyNeural": ASCII letters, digits, '.', '_' and '-' only.
+func isVoiceToken(voice string) bool {
+	if voice == "" {
+		return false
+	}
+	for _, r := range voice {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.' || r == '_' || r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func trimNonEmpty(values []string) []string {
 	if len(values) == 0 {
 		return nil
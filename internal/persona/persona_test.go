@@ -1,6 +1,9 @@
 package persona
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestNormalizeAndValidate(t *testing.T) {
 	personas := []Persona{
@@ -60,3 +63,188 @@ func TestNormalizeAndValidateDuplicateIDCaseInsensitive(t *testing.T) {
 		t.Fatal("expected duplicate id error for case-insensitive collision")
 	}
 }
+
+func TestNormalizeAndValidateGeneratesSlugIDFromName(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{Name: " Growth Lead ", Role: "growth"},
+		{ID: "operator", Name: "Operator", Role: "reliability"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := normalized[0].ID; got != "growth_lead" {
+		t.Fatalf("unexpected generated id: %s", got)
+	}
+	if !normalized[0].GeneratedID {
+		t.Fatal("expected generated id to be recorded")
+	}
+	if normalized[1].GeneratedID {
+		t.Fatal("expected explicit id to stay unmarked")
+	}
+
+	again, err := NormalizeAndValidate(normalized)
+	if err != nil {
+		t.Fatalf("expected renormalization to succeed, got %v", err)
+	}
+	if again[0].ID != "growth_lead" || !again[0].GeneratedID {
+		t.Fatalf("expected generated id to be stable, got %+v", again[0])
+	}
+}
+
+func TestNormalizeAndValidateSuffixesDuplicateGeneratedIDs(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{Name: "Growth Lead", Role: "r1"},
+		{Name: "growth-lead", Role: "r2"},
+		{ID: "growth_lead_2", Name: "Explicit", Role: "r3"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := []string{normalized[0].ID, normalized[1].ID, normalized[2].ID}
+	want := []string{"growth_lead", "growth_lead_3", "growth_lead_2"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected ids: got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestNormalizeAndValidateRequiresIDOrName(t *testing.T) {
+	_, err := NormalizeAndValidate([]Persona{
+		{Role: "r1"},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "requires an id or a name") {
+		t.Fatalf("expected missing id and name error, got %v", err)
+	}
+}
+
+func TestNormalizeAndValidateRequiresTwoNonObserverPersonas(t *testing.T) {
+	_, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "b", Name: "B", Role: "r2", Observer: true},
+	})
+	if err == nil || !strings.Contains(err.Error(), "non-observer") {
+		t.Fatalf("expected non-observer count error, got %v", err)
+	}
+
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "b", Name: "B", Role: "r2"},
+		{ID: "c", Name: "C", Role: "customer", Observer: true},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := Speakers(normalized); len(got) != 2 || got[0].ID != "a" || got[1].ID != "b" {
+		t.Fatalf("unexpected speakers: %+v", got)
+	}
+	if got := Observers(normalized); len(got) != 1 || got[0].ID != "c" {
+		t.Fatalf("unexpected observers: %+v", got)
+	}
+}
+
+func TestNormalizeAndValidateVoiceToken(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", Voice: "  ko-KR-SunHiNeural "},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if normalized[0].Voice != "ko-KR-SunHiNeural" {
+		t.Fatalf("expected trimmed voice, got %q", normalized[0].Voice)
+	}
+
+	_, err = NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", Voice: `x"><audio src="y`},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "persona[0].voice") {
+		t.Fatalf("expected voice token error, got %v", err)
+	}
+}
+
+func TestNormalizeAndValidateCreativityRange(t *testing.T) {
+	ok, tooHigh := 1.0, 1.5
+	if _, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", Creativity: &ok},
+		{ID: "b", Name: "B", Role: "r2"},
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "b", Name: "B", Role: "r2", Creativity: &tooHigh},
+	})
+	if err == nil || !strings.Contains(err.Error(), "persona[1].creativity") {
+		t.Fatalf("expected creativity range error, got %v", err)
+	}
+}
+
+func TestNormalizeAndValidateClampsNegativeWeight(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", Weight: -2},
+		{ID: "b", Name: "B", Role: "r2", Weight: 3},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if normalized[0].Weight != 1 || normalized[1].Weight != 3 {
+		t.Fatalf("unexpected weights: %d, %d", normalized[0].Weight, normalized[1].Weight)
+	}
+}
+
+func TestNormalizeAndValidateRejectsWeightAboveMax(t *testing.T) {
+	_, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", Weight: MaxWeight},
+		{ID: "b", Name: "B", Role: "r2", Weight: 2000000000},
+	})
+	if err == nil || !strings.Contains(err.Error(), "persona[1].weight must be at most 100") {
+		t.Fatalf("expected weight cap error, got %v", err)
+	}
+}
+
+func TestNormalizeAndValidateRequiresTwoConsensusParties(t *testing.T) {
+	_, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "f", Name: "F", Role: "facts", ExcludeFromConsensus: true},
+	})
+	if err == nil || !strings.Contains(err.Error(), "count toward consensus") {
+		t.Fatalf("expected consensus party count error, got %v", err)
+	}
+
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1"},
+		{ID: "f", Name: "F", Role: "facts", ExcludeFromConsensus: true},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := ConsensusParties(normalized); len(got) != 2 || got[0].ID != "a" || got[1].ID != "b" {
+		t.Fatalf("unexpected consensus parties: %+v", got)
+	}
+}
+
+func TestNormalizeAndValidateInterruptSettings(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", CanInterrupt: true, InterruptTriggers: []string{" data loss ", ""}},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := normalized[0].InterruptTriggers; len(got) != 1 || got[0] != "data loss" {
+		t.Fatalf("expected trimmed triggers, got %q", got)
+	}
+
+	_, err = NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "r1", CanInterrupt: true},
+		{ID: "b", Name: "B", Role: "r2"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "interrupt_triggers") {
+		t.Fatalf("expected missing trigger error, got %v", err)
+	}
+}
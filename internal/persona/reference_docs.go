@@ -0,0 +1,98 @@
+package persona
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReferenceDocFilePrefix marks a reference_docs entry as a file path instead
+// of inline text. Paths are relative to the persona file's directory.
+const ReferenceDocFilePrefix = "file:"
+
+// maxReferenceDocBytes caps a single reference file so one persona cannot
+// blow up every turn prompt.
+const maxReferenceDocBytes = 64 * 1024
+
+var errReferenceDocOutsideBase = errors.New("reference doc path must stay within the persona file directory")
+
+// resolveReferenceDocs replaces file: entries with the file contents. Files
+// must resolve (after symlinks) inside baseDir.
+func resolveReferenceDocs(personas []Persona, baseDir string) error {
+	base, err := resolveContainedPath(baseDir)
+	if err != nil {
+		return fmt.Errorf("resolve persona directory: %w", err)
+	}
+	for i := range personas {
+		docs := make([]string, 0, len(personas[i].ReferenceDocs))
+		for j, doc := range personas[i].ReferenceDocs {
+			rawPath, isFile := referenceDocPath(doc)
+			if !isFile {
+				docs = append(docs, doc)
+				continue
+			}
+			content, err := readReferenceDoc(base, rawPath)
+			if err != nil {
+				return fmt.Errorf("persona[%d].reference_docs[%d]: %w", i, j, err)
+			}
+			docs = append(docs, content)
+		}
+		personas[i].ReferenceDocs = docs
+	}
+	return nil
+}
+
+func referenceDocPath(doc string) (string, bool) {
+	doc = strings.TrimSpace(doc)
+	if !strings.HasPrefix(doc, ReferenceDocFilePrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(doc, ReferenceDocFilePrefix)), true
+}
+
+func readReferenceDoc(base string, rawPath string) (string, error) {
+	if rawPath == "" {
+		return "", errors.New("reference doc path is empty")
+	}
+	if filepath.IsAbs(rawPath) {
+		return "", errReferenceDocOutsideBase
+	}
+	candidate, err := resolveContainedPath(filepath.Join(base, filepath.Clean(rawPath)))
+	if err != nil {
+		return "", fmt.Errorf("resolve reference doc: %w", err)
+	}
+	rel, err := filepath.Rel(base, candidate)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errReferenceDocOutsideBase
+	}
+
+	info, err := os.Stat(candidate)
+	if err != nil {
+		return "", fmt.Errorf("read reference doc: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", errors.New("reference doc must be a regular file")
+	}
+	if info.Size() > maxReferenceDocBytes {
+		return "", fmt.Errorf("reference doc exceeds %d bytes", maxReferenceDocBytes)
+	}
+	data, err := os.ReadFile(candidate)
+	if err != nil {
+		return "", fmt.Errorf("read reference doc: %w", err)
+	}
+	return string(data), nil
+}
+
+func resolveContainedPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Clean(resolved), nil
+}
@@ -0,0 +1,78 @@
+package persona
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestLoadFromFileResolvesReferenceDocs(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "docs", "churn.md"), "annual plans retain 18% better\n")
+	path := filepath.Join(dir, "personas.json")
+	writeTestFile(t, path, `[
+		{"id":"pm","name":"PM","role":"product","reference_docs":["inline note","file:docs/churn.md"]},
+		{"id":"data","name":"Data","role":"analytics"}
+	]`)
+
+	personas, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	docs := personas[0].ReferenceDocs
+	if len(docs) != 2 || docs[0] != "inline note" || docs[1] != "annual plans retain 18% better" {
+		t.Fatalf("unexpected reference docs: %#v", docs)
+	}
+	if personas[1].ReferenceDocs != nil {
+		t.Fatalf("expected no reference docs, got %#v", personas[1].ReferenceDocs)
+	}
+}
+
+func TestLoadFromFileRejectsReferenceDocOutsidePersonaDir(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "secret.txt"), "secret")
+	outside := t.TempDir()
+	writeTestFile(t, filepath.Join(outside, "outside.txt"), "outside")
+	dir := filepath.Join(root, "project")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "outside.txt"), filepath.Join(dir, "link.txt")); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	for _, ref := range []string{"file:../secret.txt", "file:" + filepath.Join(root, "secret.txt"), "file:link.txt"} {
+		path := filepath.Join(dir, "personas.json")
+		writeTestFile(t, path, `[
+			{"id":"pm","name":"PM","role":"product","reference_docs":[`+strconv.Quote(ref)+`]},
+			{"id":"data","name":"Data","role":"analytics"}
+		]`)
+		_, err := LoadFromFile(path)
+		if !errors.Is(err, errReferenceDocOutsideBase) {
+			t.Fatalf("expected containment error for %q, got %v", ref, err)
+		}
+	}
+}
+
+func TestNormalizeAndValidateRejectsUnresolvedReferenceDocFile(t *testing.T) {
+	_, err := NormalizeAndValidate([]Persona{
+		{ID: "pm", Name: "PM", Role: "product", ReferenceDocs: []string{"file:docs/churn.md"}},
+		{ID: "data", Name: "Data", Role: "analytics"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "reference_docs") {
+		t.Fatalf("expected reference_docs error, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package persona
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Warning codes for soft roster issues that do not stop a debate.
+const (
+	WarningDuplicateName      = "duplicate_name"
+	WarningEmptyRole          = "empty_role"
+	WarningMasterNameNoLenses = "master_name_without_signature_lens"
+)
+
+// Warning is a soft issue in a persona roster: the roster is usable, but the
+// debate is likely to read worse (indistinguishable speakers, a persona with
+// no role to argue from).
+type Warning struct {
+	// Index is the persona's position in the roster.
+	Index     int    `json:"index"`
+	PersonaID string `json:"persona_id"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("persona[%d] %s: %s", w.Index, w.PersonaID, w.Message)
+}
+
+// Warnings lists soft issues in a roster returned by NormalizeAndValidate,
+// which stays pass/fail. Duplicate names are reported on every persona after
+// the first that uses the name.
+func Warnings(personas []Persona) []Warning {
+	var warnings []Warning
+	firstByName := make(map[string]string, len(personas))
+	for i, p := range personas {
+		nameKey := strings.ToLower(strings.TrimSpace(p.Name))
+		if firstID, exists := firstByName[nameKey]; exists {
+			warnings = append(warnings, Warning{
+				Index:     i,
+				PersonaID: p.ID,
+				Code:      WarningDuplicateName,
+				Message:   fmt.Sprintf("name %q is also used by %s; turns will be hard to tell apart", p.Name, firstID),
+			})
+		} else if nameKey != "" {
+			firstByName[nameKey] = p.ID
+		}
+		if strings.TrimSpace(p.Role) == "" {
+			warnings = append(warnings, Warning{
+				Index:     i,
+				PersonaID: p.ID,
+				Code:      WarningEmptyRole,
+				Message:   "role is empty; the persona has no perspective to argue from",
+			})
+		}
+		if strings.TrimSpace(p.MasterName) != "" && len(p.SignatureLens) == 0 {
+			warnings = append(warnings, Warning{
+				Index:     i,
+				PersonaID: p.ID,
+				Code:      WarningMasterNameNoLenses,
+				Message:   fmt.Sprintf("master_name %q is set without signature_lens, so the master's viewpoint is not described", p.MasterName),
+			})
+		}
+	}
+	return warnings
+}
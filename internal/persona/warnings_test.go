@@ -0,0 +1,74 @@
+package persona
+
+import "testing"
+
+func warningCodes(warnings []Warning) map[string][]string {
+	codes := make(map[string][]string)
+	for _, w := range warnings {
+		codes[w.Code] = append(codes[w.Code], w.PersonaID)
+	}
+	return codes
+}
+
+func TestWarningsDuplicateName(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "pm-a", Name: "PM", Role: "product"},
+		{ID: "pm-b", Name: " pm ", Role: "product"},
+		{ID: "ops", Name: "Ops", Role: "operations"},
+	})
+	if err != nil {
+		t.Fatalf("duplicate names must not fail validation: %v", err)
+	}
+	got := warningCodes(Warnings(normalized))[WarningDuplicateName]
+	if len(got) != 1 || got[0] != "pm-b" {
+		t.Fatalf("expected one duplicate-name warning on pm-b, got %v", got)
+	}
+}
+
+func TestWarningsEmptyRole(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "  "},
+		{ID: "b", Name: "B", Role: "build"},
+	})
+	if err != nil {
+		t.Fatalf("an empty role must not fail validation: %v", err)
+	}
+	got := warningCodes(Warnings(normalized))[WarningEmptyRole]
+	if len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected one empty-role warning on a, got %v", got)
+	}
+}
+
+func TestWarningsMasterNameWithoutSignatureLens(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "growth", MasterName: "Brian Balfour", SignatureLens: []string{" "}},
+		{ID: "b", Name: "B", Role: "build", MasterName: "Kent Beck", SignatureLens: []string{"small steps"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := warningCodes(Warnings(normalized))[WarningMasterNameNoLenses]
+	if len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected one master-name warning on a, got %v", got)
+	}
+}
+
+func TestWarningsCleanRosterAndHardErrors(t *testing.T) {
+	normalized, err := NormalizeAndValidate([]Persona{
+		{ID: "a", Name: "A", Role: "plan"},
+		{ID: "b", Name: "B", Role: "build"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warnings := Warnings(normalized); len(warnings) != 0 {
+		t.Fatalf("expected no warnings, got %v", warnings)
+	}
+
+	if _, err := NormalizeAndValidate([]Persona{{ID: "a", Name: "A", Role: "plan"}}); err == nil {
+		t.Fatal("expected a single persona to stay a hard error")
+	}
+	if _, err := NormalizeAndValidate([]Persona{{ID: "a", Name: "A", Role: "plan"}, {ID: "A", Name: "B", Role: "build"}}); err == nil {
+		t.Fatal("expected duplicate ids to stay a hard error")
+	}
+}
@@ -0,0 +1,711 @@
+package persona
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// supportedExtensions are the persona file formats LoadFromFile understands,
+// matched case-insensitively.
+var supportedExtensions = []string{".json", ".yaml", ".yml"}
+
+// HasSupportedExtension reports whether path names a persona file format the
+// loader can decode.
+func HasSupportedExtension(path string) bool {
+	ext := filepath.Ext(path)
+	for _, supported := range supportedExtensions {
+		if strings.EqualFold(ext, supported) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodePersonaData decodes a roster according to the file extension.
+func decodePersonaData(path string, data []byte) ([]Persona, error) {
+	var personas []Persona
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &personas); err != nil {
+			return nil, fmt.Errorf("parse persona json: %w", err)
+		}
+	case ".yaml", ".yml":
+		doc, err := parseYAML(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("parse persona yaml: %w", err)
+		}
+		// Round-trip through JSON so YAML keys follow the same field tags.
+		raw, err := json.Marshal(typeYAMLScalars(doc, reflect.TypeOf(personas)))
+		if err != nil {
+			return nil, fmt.Errorf("parse persona yaml: %w", err)
+		}
+		if err := json.Unmarshal(raw, &personas); err != nil {
+			return nil, fmt.Errorf("parse persona yaml: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported persona file extension %q (want %s)", filepath.Ext(path), strings.Join(supportedExtensions, ", "))
+	}
+	return personas, nil
+}
+
+// yamlLine is one source line without its indentation; comments are
+// stripped when the line is read, since block scalars keep them verbatim.
+type yamlLine struct {
+	no     int
+	indent int
+	text   string
+}
+
+// parseYAML decodes the block-style YAML subset persona rosters use:
+// mappings, sequences, single- and multi-line plain scalars, quoted scalars,
+// flow sequences of scalars and |/> block scalars (also as sequence items).
+// Anchors, tags and multi-document streams are rejected. The README lists
+// the subset for users; keep the two in sync.
+func parseYAML(src string) (any, error) {
+	rawLines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	lines := make([]yamlLine, 0, len(rawLines))
+	seenContent := false
+	for i, raw := range rawLines {
+		if strings.HasPrefix(strings.TrimLeft(raw, " "), "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		text := strings.TrimRight(raw, " \t")
+		trimmed := strings.TrimSpace(text)
+		// Document markers only count at column 0, so "---" inside a block
+		// scalar stays content.
+		if text == "---" || strings.HasPrefix(text, "--- ") {
+			if !seenContent && stripYAMLComment(strings.TrimSpace(text[3:])) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: multi-document YAML is not supported", i+1)
+		}
+		if text == "..." {
+			for j := i + 1; j < len(rawLines); j++ {
+				if stripYAMLComment(strings.TrimSpace(rawLines[j])) != "" {
+					return nil, fmt.Errorf("line %d: multi-document YAML is not supported", j+1)
+				}
+			}
+			break
+		}
+		if stripYAMLComment(trimmed) != "" {
+			seenContent = true
+		}
+		lines = append(lines, yamlLine{no: i + 1, indent: len(text) - len(strings.TrimLeft(text, " ")), text: strings.TrimLeft(text, " ")})
+	}
+
+	p := &yamlParser{lines: lines}
+	p.skipBlank()
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+	value, err := p.parseNode(p.lines[p.pos].indent)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected content", p.lines[p.pos].no)
+	}
+	return value, nil
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// skipBlank advances past empty and comment-only lines. Block scalars read
+// raw lines themselves, so comments are only stripped here and in values.
+func (p *yamlParser) skipBlank() {
+	for p.pos < len(p.lines) {
+		if text := stripYAMLComment(p.lines[p.pos].text); text != "" {
+			return
+		}
+		p.pos++
+	}
+}
+
+func (p *yamlParser) current() yamlLine {
+	line := p.lines[p.pos]
+	line.text = stripYAMLComment(line.text)
+	return line
+}
+
+func (p *yamlParser) parseNode(indent int) (any, error) {
+	if isYAMLSequenceItem(p.current().text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseSequence(indent int) (any, error) {
+	items := []any{}
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.lines) {
+			break
+		}
+		line := p.current()
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", line.no)
+		}
+		if !isYAMLSequenceItem(line.text) {
+			break
+		}
+		rest := strings.TrimLeft(line.text[1:], " ")
+		switch {
+		case rest == "":
+			p.pos++
+			value, err := p.parseChild(indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		case isYAMLSequenceItem(rest) || yamlKeyEnd(rest) >= 0:
+			// "- key: value" opens a mapping (or nested sequence) whose
+			// entries align with the text after the dash.
+			p.lines[p.pos].indent = line.indent + len(line.text) - len(rest)
+			p.lines[p.pos].text = rest
+			value, err := p.parseNode(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		case rest[0] == '|' || rest[0] == '>':
+			p.pos++
+			value, err := p.parseBlockScalar(indent, rest, line.no)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		default:
+			value, err := parseYAMLScalar(rest, line.no)
+			if err != nil {
+				return nil, err
+			}
+			p.pos++
+			items = append(items, p.continuePlainScalar(value, indent))
+		}
+	}
+	return items, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (any, error) {
+	m := map[string]any{}
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.lines) {
+			break
+		}
+		line := p.current()
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", line.no)
+		}
+		if isYAMLSequenceItem(line.text) {
+			break
+		}
+		end := yamlKeyEnd(line.text)
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: expected \"key: value\"", line.no)
+		}
+		key, err := parseYAMLKey(line.text[:end], line.no)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := m[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", line.no, key)
+		}
+		rest := strings.TrimSpace(line.text[end+1:])
+		p.pos++
+
+		switch {
+		case rest == "":
+			value, err := p.parseChild(indent)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = value
+		case rest[0] == '|' || rest[0] == '>':
+			value, err := p.parseBlockScalar(indent, rest, line.no)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = value
+		default:
+			value, err := parseYAMLScalar(rest, line.no)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = p.continuePlainScalar(value, indent)
+		}
+	}
+	return m, nil
+}
+
+// continuePlainScalar folds the more-indented lines after a plain scalar
+// into it, as YAML does for multi-line plain scalars: single breaks become
+// spaces and each blank line becomes one newline. Other values are returned
+// unchanged, so a continuation after them fails as unexpected indentation.
+func (p *yamlParser) continuePlainScalar(value any, indent int) any {
+	plain, ok := value.(yamlPlainScalar)
+	if !ok {
+		return value
+	}
+	text := string(plain)
+	for {
+		pos, breaks := p.pos, 0
+		for pos < len(p.lines) && p.lines[pos].text == "" {
+			pos++
+			breaks++
+		}
+		if pos >= len(p.lines) || p.lines[pos].indent <= indent {
+			return yamlPlainScalar(text)
+		}
+		next := stripYAMLComment(p.lines[pos].text)
+		if next == "" || yamlKeyEnd(next) >= 0 {
+			return yamlPlainScalar(text)
+		}
+		if breaks > 0 {
+			text += strings.Repeat("\n", breaks)
+		} else {
+			text += " "
+		}
+		text += next
+		p.pos = pos + 1
+	}
+}
+
+// parseChild parses the nested value after "key:" or a bare "-". A sequence
+// may sit at the parent's indentation, as YAML allows for mapping values.
+func (p *yamlParser) parseChild(parentIndent int) (any, error) {
+	p.skipBlank()
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+	next := p.current()
+	if next.indent > parentIndent || (next.indent == parentIndent && isYAMLSequenceItem(next.text) && !p.inSequenceAt(parentIndent)) {
+		return p.parseNode(next.indent)
+	}
+	return nil, nil
+}
+
+// inSequenceAt reports whether the line before pos is itself a sequence item
+// at indent, in which case a following "- " is its sibling, not its child.
+func (p *yamlParser) inSequenceAt(indent int) bool {
+	for i := p.pos - 1; i >= 0; i-- {
+		line := p.lines[i]
+		if stripYAMLComment(line.text) == "" {
+			continue
+		}
+		return line.indent == indent && isYAMLSequenceItem(line.text)
+	}
+	return false
+}
+
+// parseBlockScalar reads a | (literal) or > (folded) scalar. The "-"
+// indicator strips the final newline; "+" keeps trailing blank lines.
+func (p *yamlParser) parseBlockScalar(parentIndent int, header string, lineNo int) (string, error) {
+	indicator := strings.TrimSpace(stripYAMLComment(header))
+	style, chomp := indicator[0], ""
+	if len(indicator) > 1 {
+		chomp = indicator[1:]
+	}
+	if chomp != "" && chomp != "-" && chomp != "+" {
+		return "", fmt.Errorf("line %d: unsupported block scalar indicator %q", lineNo, indicator)
+	}
+
+	var body []string
+	blockIndent := -1
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.text == "" {
+			body = append(body, "")
+			p.pos++
+			continue
+		}
+		if line.indent <= parentIndent {
+			break
+		}
+		if blockIndent < 0 {
+			blockIndent = line.indent
+		}
+		if line.indent < blockIndent {
+			break
+		}
+		body = append(body, strings.Repeat(" ", line.indent-blockIndent)+line.text)
+		p.pos++
+	}
+
+	trailing := 0
+	for len(body) > 0 && body[len(body)-1] == "" {
+		body = body[:len(body)-1]
+		trailing++
+	}
+	var text string
+	if style == '|' {
+		text = strings.Join(body, "\n")
+	} else {
+		text = foldYAMLLines(body)
+	}
+	if len(body) == 0 {
+		return "", nil
+	}
+	switch chomp {
+	case "-":
+		return text, nil
+	case "+":
+		return text + strings.Repeat("\n", trailing+1), nil
+	default:
+		return text + "\n", nil
+	}
+}
+
+// foldYAMLLines joins folded-scalar lines: a single break becomes a space,
+// each blank line becomes one newline, and breaks next to more-indented
+// lines are kept as they are.
+func foldYAMLLines(lines []string) string {
+	var b strings.Builder
+	prev, breaks := "", 0
+	for _, line := range lines {
+		if line == "" {
+			breaks++
+			continue
+		}
+		switch {
+		case b.Len() == 0 && prev == "":
+			b.WriteString(strings.Repeat("\n", breaks))
+		case strings.HasPrefix(line, " ") || strings.HasPrefix(prev, " "):
+			b.WriteString(strings.Repeat("\n", breaks+1))
+		case breaks > 0:
+			b.WriteString(strings.Repeat("\n", breaks))
+		default:
+			b.WriteString(" ")
+		}
+		b.WriteString(line)
+		prev, breaks = line, 0
+	}
+	return b.String()
+}
+
+func isYAMLSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// yamlKeyEnd returns the index of the ":" ending a mapping key, or -1. The
+// colon must end the line or be followed by a space, outside quotes.
+func yamlKeyEnd(text string) int {
+	if text == "" || text[0] == '[' || text[0] == '{' {
+		return -1
+	}
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && i == 0:
+			quote = c
+		case c == ':' && (i == len(text)-1 || text[i+1] == ' '):
+			return i
+		}
+	}
+	return -1
+}
+
+func parseYAMLKey(raw string, lineNo int) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("line %d: empty key", lineNo)
+	}
+	if raw[0] == '"' || raw[0] == '\'' {
+		value, err := parseYAMLScalar(raw, lineNo)
+		if err != nil {
+			return "", err
+		}
+		s, _ := value.(string)
+		return s, nil
+	}
+	return raw, nil
+}
+
+// stripYAMLComment removes a trailing "# comment" that is not inside quotes.
+func stripYAMLComment(text string) string {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.ContainsRune(" [,:-", rune(text[i-1])) {
+				quote = c
+			}
+		case c == '#' && (i == 0 || text[i-1] == ' '):
+			return strings.TrimRight(text[:i], " ")
+		}
+	}
+	return text
+}
+
+// yamlPlainScalar is an unquoted scalar whose type is not decided yet;
+// typeYAMLScalars resolves it against the field it decodes into.
+type yamlPlainScalar string
+
+// parseYAMLScalar decodes a flow value: quoted strings, flow sequences of
+// scalars, null, and plain scalars left as yamlPlainScalar.
+func parseYAMLScalar(raw string, lineNo int) (any, error) {
+	raw = strings.TrimSpace(stripYAMLComment(raw))
+	if raw == "" {
+		return nil, nil
+	}
+	switch raw[0] {
+	case '"':
+		if len(raw) < 2 || raw[len(raw)-1] != '"' {
+			return nil, fmt.Errorf("line %d: unterminated double-quoted string", lineNo)
+		}
+		s, err := unquoteYAMLDouble(raw[1 : len(raw)-1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid double-quoted string: %w", lineNo, err)
+		}
+		return s, nil
+	case '\'':
+		if len(raw) < 2 || raw[len(raw)-1] != '\'' {
+			return nil, fmt.Errorf("line %d: unterminated single-quoted string", lineNo)
+		}
+		return strings.ReplaceAll(raw[1:len(raw)-1], "''", "'"), nil
+	case '[':
+		return parseYAMLFlowSequence(raw, lineNo)
+	case '{':
+		if raw == "{}" {
+			return map[string]any{}, nil
+		}
+		return nil, fmt.Errorf("line %d: flow mappings are not supported", lineNo)
+	case '&', '*', '!':
+		return nil, fmt.Errorf("line %d: anchors, aliases and tags are not supported", lineNo)
+	}
+
+	switch raw {
+	case "null", "Null", "NULL", "~":
+		return nil, nil
+	}
+	return yamlPlainScalar(raw), nil
+}
+
+// yamlEscapes are the single-character escapes of YAML double-quoted
+// scalars; yamlHexEscapes give the hex digit count of \x, \u and \U, which
+// all name Unicode code points.
+var (
+	yamlEscapes = map[byte]string{
+		'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n",
+		'v': "\v", 'f': "\f", 'r': "\r", 'e': "\x1b", ' ': " ", '"': "\"",
+		'/': "/", '\\': "\\", 'N': "\u0085", '_': "\u00a0", 'L': "\u2028", 'P': "\u2029",
+	}
+	yamlHexEscapes = map[byte]int{'x': 2, 'u': 4, 'U': 8}
+)
+
+// unquoteYAMLDouble decodes the body of a double-quoted scalar using YAML's
+// escape rules rather than Go's.
+func unquoteYAMLDouble(body string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if c == '"' {
+			return "", errors.New("unescaped double quote")
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(body) {
+			return "", errors.New("trailing backslash")
+		}
+		if escaped, ok := yamlEscapes[body[i]]; ok {
+			b.WriteString(escaped)
+			continue
+		}
+		width, ok := yamlHexEscapes[body[i]]
+		if !ok {
+			return "", fmt.Errorf("unknown escape \\%c", body[i])
+		}
+		if i+width >= len(body) {
+			return "", fmt.Errorf("short escape \\%s", body[i:])
+		}
+		code, err := strconv.ParseUint(body[i+1:i+1+width], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return "", fmt.Errorf("invalid escape \\%s", body[i:i+1+width])
+		}
+		b.WriteRune(rune(code))
+		i += width
+	}
+	return b.String(), nil
+}
+
+// typeYAMLScalars resolves plain scalars against the Go type they decode
+// into, so they become bools or numbers only for bool and numeric fields:
+// "id: 2024" stays the string "2024".
+func typeYAMLScalars(value any, target reflect.Type) any {
+	for target != nil && target.Kind() == reflect.Pointer {
+		target = target.Elem()
+	}
+	switch v := value.(type) {
+	case []any:
+		var elem reflect.Type
+		if target != nil && (target.Kind() == reflect.Slice || target.Kind() == reflect.Array) {
+			elem = target.Elem()
+		}
+		for i := range v {
+			v[i] = typeYAMLScalars(v[i], elem)
+		}
+		return v
+	case map[string]any:
+		for key, item := range v {
+			v[key] = typeYAMLScalars(item, yamlFieldType(target, key))
+		}
+		return v
+	case yamlPlainScalar:
+		return resolveYAMLPlainScalar(string(v), target)
+	}
+	return value
+}
+
+// yamlFieldType returns the type a mapping key decodes into, matching json
+// tags the way encoding/json does, or nil when the key is unknown.
+func yamlFieldType(target reflect.Type, key string) reflect.Type {
+	if target == nil {
+		return nil
+	}
+	if target.Kind() == reflect.Map {
+		return target.Elem()
+	}
+	if target.Kind() != reflect.Struct {
+		return nil
+	}
+	var folded reflect.Type
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if name == key {
+			return field.Type
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = field.Type
+		}
+	}
+	return folded
+}
+
+// resolveYAMLPlainScalar applies the YAML 1.2 core schema only where the
+// target is a bool or number; everything else, and anything that does not
+// parse, stays a string so decoding reports a clear type error.
+func resolveYAMLPlainScalar(raw string, target reflect.Type) any {
+	if target == nil {
+		return raw
+	}
+	switch target.Kind() {
+	case reflect.Bool:
+		switch raw {
+		case "true", "True", "TRUE":
+			return true
+		case "false", "False", "FALSE":
+			return false
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseUint(raw, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if strings.ContainsAny(raw, "0123456789") && !strings.ContainsAny(raw, "xXpP_") {
+			if f, err := strconv.ParseFloat(raw, 64); err == nil {
+				return f
+			}
+		}
+	}
+	return raw
+}
+
+func parseYAMLFlowSequence(raw string, lineNo int) (any, error) {
+	if raw[len(raw)-1] != ']' {
+		return nil, fmt.Errorf("line %d: unterminated flow sequence", lineNo)
+	}
+	inner := strings.TrimSpace(raw[1 : len(raw)-1])
+	items := []any{}
+	if inner == "" {
+		return items, nil
+	}
+	var quote byte
+	start := 0
+	for i := 0; i <= len(inner); i++ {
+		if i < len(inner) {
+			c := inner[i]
+			if quote != 0 {
+				if c == '\\' && quote == '"' {
+					i++
+				} else if c == quote {
+					quote = 0
+				}
+				continue
+			}
+			if c == '"' || c == '\'' {
+				quote = c
+				continue
+			}
+			if c == '[' || c == '{' {
+				return nil, fmt.Errorf("line %d: nested flow collections are not supported", lineNo)
+			}
+			if c != ',' {
+				continue
+			}
+		}
+		item := strings.TrimSpace(inner[start:i])
+		start = i + 1
+		if item == "" {
+			if i == len(inner) {
+				break // trailing comma
+			}
+			return nil, fmt.Errorf("line %d: empty flow sequence entry", lineNo)
+		}
+		value, err := parseYAMLScalar(item, lineNo)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("line %d: unterminated string in flow sequence", lineNo)
+	}
+	return items, nil
+}
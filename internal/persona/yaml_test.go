@@ -0,0 +1,258 @@
+package persona
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadFromFileReadsYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "team.yaml")
+	writeTestFile(t, path, `# product roster
+---
+- id: pm
+  name: "PM: Lead"
+  role: product # trailing comment
+  expertise:
+  - pricing
+  - onboarding
+  creativity: 0.8
+  constraints: [Mention tradeoffs, 'Cite data']
+- name: Ops
+  role: operations
+  stance: |
+    cautious about
+    weekend deploys
+  observer: false
+`)
+
+	personas, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("load yaml: %v", err)
+	}
+	if len(personas) != 2 {
+		t.Fatalf("expected 2 personas, got %d", len(personas))
+	}
+	pm := personas[0]
+	if pm.ID != "pm" || pm.Name != "PM: Lead" || pm.Role != "product" {
+		t.Fatalf("unexpected first persona: %+v", pm)
+	}
+	if !reflect.DeepEqual(pm.Expertise, []string{"pricing", "onboarding"}) {
+		t.Fatalf("unexpected expertise: %#v", pm.Expertise)
+	}
+	if !reflect.DeepEqual(pm.Constraints, []string{"Mention tradeoffs", "Cite data"}) {
+		t.Fatalf("unexpected constraints: %#v", pm.Constraints)
+	}
+	if pm.Creativity == nil || *pm.Creativity != 0.8 {
+		t.Fatalf("unexpected creativity: %v", pm.Creativity)
+	}
+	ops := personas[1]
+	if ops.ID != "ops" || ops.Stance != "cautious about\nweekend deploys" {
+		t.Fatalf("unexpected second persona: %+v", ops)
+	}
+}
+
+func TestLoadFromFileMatchesJSONAndYAML(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "team.json")
+	writeTestFile(t, jsonPath, `[
+		{"id":"pm","name":"PM","role":"product","handoff_priority":2},
+		{"id":"ops","name":"Ops","role":"operations","can_interrupt":true,"interrupt_triggers":["outage"]}
+	]`)
+	ymlPath := filepath.Join(dir, "team.YML")
+	writeTestFile(t, ymlPath, `
+- id: pm
+  name: PM
+  role: product
+  handoff_priority: 2
+- id: ops
+  name: Ops
+  role: operations
+  can_interrupt: true
+  interrupt_triggers:
+    - outage
+`)
+
+	fromJSON, err := LoadFromFile(jsonPath)
+	if err != nil {
+		t.Fatalf("load json: %v", err)
+	}
+	fromYAML, err := LoadFromFile(ymlPath)
+	if err != nil {
+		t.Fatalf("load yml: %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, fromYAML) {
+		t.Fatalf("expected identical rosters\njson=%+v\nyaml=%+v", fromJSON, fromYAML)
+	}
+}
+
+func TestLoadFromFileRejectsUnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.toml")
+	writeTestFile(t, path, `id = "pm"`)
+
+	_, err := LoadFromFile(path)
+	if err == nil || !strings.Contains(err.Error(), `unsupported persona file extension ".toml"`) {
+		t.Fatalf("expected unsupported extension error, got %v", err)
+	}
+}
+
+func TestLoadFromFileReportsYAMLLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	writeTestFile(t, path, "- id: pm\n  name: PM\n    role: product\n")
+
+	_, err := LoadFromFile(path)
+	if err == nil || !strings.Contains(err.Error(), "parse persona yaml: line 3") {
+		t.Fatalf("expected yaml line error, got %v", err)
+	}
+}
+
+func TestLoadFromFileKeepsYAMLPlainScalarsAsStrings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	writeTestFile(t, path, `
+- id: 2024
+  name: 1984
+  role: true
+  stance: 1.5
+  expertise: [2024, yes, null-safe]
+  handoff_priority: 3
+  creativity: 0.5
+  observer: false
+- id: ops
+  name: Ops
+  role: operations
+  can_interrupt: True
+  interrupt_triggers: [outage]
+`)
+
+	personas, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("load yaml: %v", err)
+	}
+	first := personas[0]
+	if first.ID != "2024" || first.Name != "1984" || first.Role != "true" || first.Stance != "1.5" {
+		t.Fatalf("expected numeric-looking scalars to stay strings, got %+v", first)
+	}
+	if !reflect.DeepEqual(first.Expertise, []string{"2024", "yes", "null-safe"}) {
+		t.Fatalf("unexpected expertise: %#v", first.Expertise)
+	}
+	if first.HandoffPriority != 3 || first.Creativity == nil || *first.Creativity != 0.5 {
+		t.Fatalf("expected numeric fields to decode as numbers, got %+v", first)
+	}
+	if !personas[1].CanInterrupt {
+		t.Fatalf("expected bool field to decode, got %+v", personas[1])
+	}
+}
+
+func TestLoadFromFileRejectsNonNumericYAMLForNumericField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	writeTestFile(t, path, "- id: pm\n  name: PM\n  role: product\n  handoff_priority: high\n")
+
+	_, err := LoadFromFile(path)
+	if err == nil || !strings.Contains(err.Error(), "parse persona yaml") || !strings.Contains(err.Error(), "handoff_priority") {
+		t.Fatalf("expected type error for handoff_priority, got %v", err)
+	}
+}
+
+func TestLoadFromFileDecodesYAMLEscapes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	writeTestFile(t, path, `
+- id: pm
+  name: "caf\xe9 é \U0001F600"
+  role: "a\/b\tc\\d \"q\""
+  stance: "nb\_sp\e"
+- id: ops
+  name: Ops
+  role: operations
+`)
+
+	personas, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("load yaml: %v", err)
+	}
+	pm := personas[0]
+	if pm.Name != "café é 😀" {
+		t.Fatalf("unexpected name: %q", pm.Name)
+	}
+	if pm.Role != "a/b\tc\\d \"q\"" {
+		t.Fatalf("unexpected role: %q", pm.Role)
+	}
+	if pm.Stance != "nb\u00a0sp\x1b" {
+		t.Fatalf("unexpected stance: %q", pm.Stance)
+	}
+
+	writeTestFile(t, path, "- id: pm\n  name: \"bad \\q escape\"\n  role: product\n- id: ops\n  name: Ops\n  role: operations\n")
+	_, err = LoadFromFile(path)
+	if err == nil || !strings.Contains(err.Error(), `line 2: invalid double-quoted string: unknown escape \q`) {
+		t.Fatalf("expected escape error, got %v", err)
+	}
+}
+
+func TestLoadFromFileRejectsMultiDocumentYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	roster := "- id: a\n  name: A\n  role: r1\n- id: c\n  name: C\n  role: r3\n"
+	for marker, want := range map[string]string{"---": "line 7:", "...": "line 8:"} {
+		writeTestFile(t, path, roster+marker+"\n- id: b\n  name: B\n  role: r2\n")
+		_, err := LoadFromFile(path)
+		if err == nil || !strings.Contains(err.Error(), want+" multi-document YAML is not supported") {
+			t.Fatalf("%s: expected multi-document error, got %v", marker, err)
+		}
+	}
+
+	writeTestFile(t, path, "--- # roster\n- id: a\n  name: A\n  role: r1\n  stance: |\n    before\n    ---\n    after\n- id: b\n  name: B\n  role: r2\n...\n# trailing comment\n")
+	personas, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("expected a single document with markers to load, got %v", err)
+	}
+	if personas[0].Stance != "before\n---\nafter" {
+		t.Fatalf("unexpected stance: %q", personas[0].Stance)
+	}
+}
+
+func TestParseYAMLFoldsBlockAndPlainScalars(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want any
+	}{
+		{
+			name: "folded blank line",
+			src:  "a: >\n  folded\n  text\n\n  para\n",
+			want: map[string]any{"a": "folded text\npara\n"},
+		},
+		{
+			name: "folded more-indented",
+			src:  "a: >\n  intro\n    code\n  outro\n",
+			want: map[string]any{"a": "intro\n  code\noutro\n"},
+		},
+		{
+			name: "literal sequence item",
+			src:  "- |\n  line one\n  line two\n- >-\n  folded\n  item\n- plain\n",
+			want: []any{"line one\nline two\n", "folded item", yamlPlainScalar("plain")},
+		},
+		{
+			name: "multi-line plain in mapping",
+			src:  "a: first\n  second\n\n  third\nb: next\n",
+			want: map[string]any{"a": yamlPlainScalar("first second\nthird"), "b": yamlPlainScalar("next")},
+		},
+		{
+			name: "multi-line plain in sequence",
+			src:  "- first\n  second\n- next\n",
+			want: []any{yamlPlainScalar("first second"), yamlPlainScalar("next")},
+		},
+	}
+	for _, tc := range cases {
+		got, err := parseYAML(tc.src)
+		if err != nil {
+			t.Fatalf("%s: parse: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%s: got %#v, want %#v", tc.name, got, tc.want)
+		}
+	}
+
+	if _, err := parseYAML("a: \"quoted\"\n  more\n"); err == nil || !strings.Contains(err.Error(), "line 2: unexpected indentation") {
+		t.Fatalf("expected a continuation after a quoted scalar to fail, got %v", err)
+	}
+}
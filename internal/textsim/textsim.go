@@ -0,0 +1,113 @@
+// Package textsim provides the lightweight text similarity used to compare
+// debate turns, claims and problem keywords.
+package textsim
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokens lowercases text and splits it on anything that is not a letter or
+// digit. Tokens shorter than two runes are dropped and duplicates are removed,
+// keeping first-seen order.
+func Tokens(text string) []string {
+	trimmed := strings.TrimSpace(strings.ToLower(text))
+	if trimmed == "" {
+		return nil
+	}
+
+	parts := strings.FieldsFunc(trimmed, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	out := make([]string, 0, len(parts))
+	seen := make(map[string]struct{}, len(parts))
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) < 2 {
+			continue
+		}
+		if _, dup := seen[part]; dup {
+			continue
+		}
+		seen[part] = struct{}{}
+		out = append(out, part)
+	}
+	return out
+}
+
+// TokenSet returns Tokens(text) as a set.
+func TokenSet(text string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, token := range Tokens(text) {
+		set[token] = struct{}{}
+	}
+	return set
+}
+
+// Similarity is the Jaccard overlap of the two texts' token sets, in [0, 1].
+// Two texts without any tokens are treated as identical.
+func Similarity(a string, b string) float64 {
+	setA := TokenSet(a)
+	setB := TokenSet(b)
+	if len(setA) == 0 && len(setB) == 0 {
+		return 1
+	}
+	if len(setA) == 0 || len(setB) == 0 {
+		return 0
+	}
+	shared := 0
+	for token := range setA {
+		if _, ok := setB[token]; ok {
+			shared++
+		}
+	}
+	union := len(setA) + len(setB) - shared
+	return float64(shared) / float64(union)
+}
+
+// Questions returns the sentences in text that end with a question mark
+// (ASCII or full-width), trimmed and without leading list markers. Each
+// line is split on sentence punctuation independently.
+func Questions(text string) []string {
+	var out []string
+	for _, line := range strings.Split(text, "\n") {
+		start := 0
+		for i, r := range line {
+			switch r {
+			case '!', '。', '?', '？':
+			case '.':
+				// Keep decimals and versions such as "1.5" inside a sentence.
+				if next := i + 1; next < len(line) && line[next] != ' ' {
+					continue
+				}
+			default:
+				continue
+			}
+			end := i + utf8.RuneLen(r)
+			if r == '?' || r == '？' {
+				if q := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line[start:end]), "-*•>")); len(Tokens(q)) > 0 {
+					out = append(out, q)
+				}
+			}
+			start = end
+		}
+	}
+	return out
+}
+
+// Coverage is the share of a's tokens that also appear in b, in [0, 1]. Text
+// without tokens is fully covered.
+func Coverage(a string, b string) float64 {
+	setA := TokenSet(a)
+	if len(setA) == 0 {
+		return 1
+	}
+	setB := TokenSet(b)
+	shared := 0
+	for token := range setA {
+		if _, ok := setB[token]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(setA))
+}
@@ -0,0 +1,66 @@
+package textsim
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	got := Tokens("Keep the monolith, keep THE deploys! a 결제 결제")
+	want := []string{"keep", "the", "monolith", "deploys", "결제"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Tokens()=%v, want %v", got, want)
+	}
+	if Tokens("   ") != nil {
+		t.Fatal("expected nil tokens for blank text")
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{name: "identical ignoring case and punctuation", a: "Ship the canary first.", b: "ship THE canary first", want: 1},
+		{name: "disjoint", a: "ship the canary", b: "pause all deploys", want: 0},
+		{name: "partial overlap", a: "ship the canary now", b: "ship the canary later", want: 3.0 / 5.0},
+		{name: "both empty", a: "", b: " ", want: 1},
+		{name: "one empty", a: "ship", b: "", want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Similarity(tc.a, tc.b)
+			if math.Abs(got-tc.want) > 1e-9 {
+				t.Fatalf("Similarity()=%v, want %v", got, tc.want)
+			}
+			if back := Similarity(tc.b, tc.a); math.Abs(back-got) > 1e-9 {
+				t.Fatalf("expected symmetric similarity, got %v and %v", got, back)
+			}
+		})
+	}
+}
+
+func TestQuestions(t *testing.T) {
+	got := Questions("We ship v1.5 first. Who owns rollback? Fine!\n- What is the budget？\nNo question here.\n?")
+	want := []string{"Who owns rollback?", "What is the budget？"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Questions()=%q, want %q", got, want)
+	}
+	if Questions("Statements only.") != nil {
+		t.Fatal("expected no questions")
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	if got := Coverage("who owns rollback", "the rollback is owned by ops; ops owns it"); math.Abs(got-2.0/3.0) > 1e-9 {
+		t.Fatalf("Coverage()=%v, want 2/3", got)
+	}
+	if got := Coverage("", "anything"); got != 1 {
+		t.Fatalf("expected blank text to be covered, got %v", got)
+	}
+	if got := Coverage("rollback plan", ""); got != 0 {
+		t.Fatalf("expected no coverage by blank text, got %v", got)
+	}
+}
@@ -0,0 +1,68 @@
+package web
+
+import "errors"
+
+// Stable machine-readable codes for API error responses. Clients should
+// branch on these rather than on message text.
+const (
+	errCodeInvalidRequest    = "invalid_request"
+	errCodePersonaLoadFailed = "persona_load_failed"
+	errCodePathTraversal     = "path_traversal"
+	errCodeRunFailed         = "run_failed"
+	errCodeSaveFailed        = "save_failed"
+	errCodeNotFound          = "not_found"
+	errCodeRunNotActive      = "run_not_active"
+	errCodeMethodNotAllowed  = "method_not_allowed"
+	errCodeUnauthorized      = "unauthorized"
+	// errCodeRateLimited reports a full queue, such as too many pending asks.
+	errCodeRateLimited   = "rate_limited"
+	errCodeInternalError = "internal_error"
+	// errCodeNotReady is returned by /readyz when a dependency is unusable.
+	errCodeNotReady = "not_ready"
+)
+
+// errorResponse is the body of every non-2xx JSON response:
+// {"error": {"code": "...", "message": "...", "details": {...}}}.
+type errorResponse struct {
+	Error apiError `json:"error"`
+}
+
+type apiError struct {
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+var (
+	errPersonaPathOutsideBase  = errors.New("persona path must stay within the project directory")
+	errResultPathOutsideOutput = errors.New("result path must be inside the output directory")
+	errTooManyPersonas         = errors.New("too many personas")
+)
+
+// saveError marks runAndSaveDebate failures that happened while preparing or
+// writing output files rather than while debating. The message is unchanged.
+type saveError struct {
+	err error
+}
+
+func (e saveError) Error() string { return e.err.Error() }
+
+func (e saveError) Unwrap() error { return e.err }
+
+func personaErrorCode(err error) string {
+	if errors.Is(err, errPersonaPathOutsideBase) {
+		return errCodePathTraversal
+	}
+	if errors.Is(err, errTooManyPersonas) {
+		return errCodeInvalidRequest
+	}
+	return errCodePersonaLoadFailed
+}
+
+func debateErrorCode(err error) string {
+	var saveErr saveError
+	if errors.As(err, &saveErr) {
+		return errCodeSaveFailed
+	}
+	return errCodeRunFailed
+}
@@ -8,11 +8,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"debate/internal/orchestrator"
+	"debate/internal/output"
 	"debate/internal/persona"
 )
 
@@ -25,6 +27,11 @@ const (
 	serverMaxHeader   = 1 << 20
 	defaultRunTimeout = 30 * time.Minute
 	defaultTurnBuffer = 600
+	// defaultPersonaWarnAt matches the persona count at which prompts start
+	// compressing, where debates get noticeably slower and costlier.
+	defaultPersonaWarnAt = 8
+
+	defaultRetentionInterval = time.Hour
 )
 
 type Runner interface {
@@ -39,9 +46,13 @@ type LoaderFunc func(path string) ([]persona.Persona, error)
 
 type Config struct {
 	PersonaPath string
-	BaseDir     string
-	OutputDir   string
-	Runner      Runner
+	// Personas, when non-nil, is the server default roster (from
+	// -personas-inline) used instead of loading PersonaPath. It is shown as
+	// the path "inline"; other explicit paths still go through Loader.
+	Personas  []persona.Persona
+	BaseDir   string
+	OutputDir string
+	Runner    Runner
 	// RunnerDefaults is the baseline config used when per-request runtime
 	// tuning overrides are provided.
 	RunnerDefaults orchestrator.Config
@@ -49,56 +60,120 @@ type Config struct {
 	Now            func() time.Time
 	RunTimeout     time.Duration
 	TurnBuffer     int
+	// Retention prunes old artifacts from OutputDir while the server runs.
+	// Cleanup is disabled when neither MaxAge nor MaxCount is set.
+	Retention         output.RetentionOptions
+	RetentionInterval time.Duration
+	// OutputFormats selects the artifact files written per debate; empty
+	// means output.DefaultFormats (json, md).
+	OutputFormats []output.Format
+	// MaxPersonas rejects debate requests with more personas; <= 0 falls
+	// back to persona.MaxPersonas.
+	MaxPersonas int
+	// PersonaWarnAt adds a warning to /api/personas responses above this
+	// many personas; <= 0 means defaultPersonaWarnAt.
+	PersonaWarnAt int
+	// SystemPrompts, when non-nil, are archived with the persona and config
+	// snapshot as <result>.prompts.json next to every saved debate.
+	SystemPrompts map[string]string
+	// SaveFallbackDir receives results that cannot be written to OutputDir
+	// once a debate has finished; empty disables the fallback.
+	SaveFallbackDir string
+	// MetricsCSVPath, when set, gets one row per saved debate appended by
+	// output.AppendMetricsCSV.
+	MetricsCSVPath string
+	// AdminToken guards PUT /api/config as a bearer token; empty disables
+	// live config updates.
+	AdminToken string
 }
 
 type App struct {
-	personaPath string
-	baseDir     string
-	outputDir   string
-	runner      Runner
-	runnerCfg   orchestrator.Config
-	loader      LoaderFunc
-	now         func() time.Time
-	runTimeout  time.Duration
-	turnBuffer  int
-	runsMu      sync.RWMutex
-	runs        map[string]*debateRun
-	runSeq      uint64
-	outputSeq   uint64
+	personaPath       string
+	personas          []persona.Persona
+	baseDir           string
+	outputDir         string
+	runner            Runner
+	runnerCfgMu       sync.RWMutex
+	runnerCfg         orchestrator.Config
+	runnerCfgUpdated  bool
+	loader            LoaderFunc
+	now               func() time.Time
+	runTimeout        time.Duration
+	turnBuffer        int
+	retention         output.RetentionOptions
+	retentionInterval time.Duration
+	outputFormats     []output.Format
+	maxPersonas       int
+	personaWarnAt     int
+	systemPrompts     map[string]string
+	saveFallbackDir   string
+	metricsCSVPath    string
+	adminToken        string
+	runsMu            sync.RWMutex
+	runs              map[string]*debateRun
+	runSeq            uint64
+	outputSeq         uint64
 }
 
 type debateRequest struct {
-	Problem                 string            `json:"problem"`
-	PersonaPath             string            `json:"persona_path,omitempty"`
-	Personas                []persona.Persona `json:"personas,omitempty"`
-	AudienceMode            *string           `json:"audience_mode,omitempty"`
-	MaxTurns                *int              `json:"max_turns,omitempty"`
-	ConsensusThreshold      *float64          `json:"consensus_threshold,omitempty"`
-	MaxNoProgressJudges     *int              `json:"max_no_progress_judges,omitempty"`
-	NoProgressEpsilon       *float64          `json:"no_progress_epsilon,omitempty"`
-	UnlimitedHardMaxTurns   *int              `json:"unlimited_hard_max_turns,omitempty"`
-	DirectHandoffJudgeEvery *int              `json:"direct_handoff_judge_every,omitempty"`
-	LLMHistoryTurnWindow    *int              `json:"llm_history_turn_window,omitempty"`
-	MaxDurationSeconds      *int              `json:"max_duration_seconds,omitempty"`
-	MaxTotalTokens          *int              `json:"max_total_tokens,omitempty"`
-	RunTimeoutSeconds       *int              `json:"run_timeout_seconds,omitempty"`
+	Problem                   string            `json:"problem"`
+	PersonaPath               string            `json:"persona_path,omitempty"`
+	Personas                  []persona.Persona `json:"personas,omitempty"`
+	Project                   string            `json:"project,omitempty"`
+	Tags                      []string          `json:"tags,omitempty"`
+	AudienceMode              *string           `json:"audience_mode,omitempty"`
+	MaxTurns                  *int              `json:"max_turns,omitempty"`
+	ConsensusThreshold        *float64          `json:"consensus_threshold,omitempty"`
+	MaxNoProgressJudges       *int              `json:"max_no_progress_judges,omitempty"`
+	NoProgressEpsilon         *float64          `json:"no_progress_epsilon,omitempty"`
+	FocusPersonaID            *string           `json:"focus_persona_id,omitempty"`
+	FocusBias                 *float64          `json:"focus_bias,omitempty"`
+	OpeningSpeakerID          *string           `json:"opening_speaker_id,omitempty"`
+	ModeratorName             *string           `json:"moderator_name,omitempty"`
+	IrreconcilableAfterJudges *int              `json:"irreconcilable_after_judges,omitempty"`
+	UnlimitedHardMaxTurns     *int              `json:"unlimited_hard_max_turns,omitempty"`
+	DirectHandoffJudgeEvery   *int              `json:"direct_handoff_judge_every,omitempty"`
+	LLMHistoryTurnWindow      *int              `json:"llm_history_turn_window,omitempty"`
+	MaxDurationSeconds        *int              `json:"max_duration_seconds,omitempty"`
+	MaxTotalTokens            *int              `json:"max_total_tokens,omitempty"`
+	RunTimeoutSeconds         *int              `json:"run_timeout_seconds,omitempty"`
 }
 
 type debateResponse struct {
 	Result            orchestrator.Result `json:"result"`
 	SavedJSONPath     string              `json:"saved_json_path"`
 	SavedMarkdownPath string              `json:"saved_markdown_path"`
+	SavedPromptsPath  string              `json:"saved_prompts_path,omitempty"`
+	// SaveWarning reports that OutputDir could not be written and the result
+	// was saved under the fallback dir instead.
+	SaveWarning string `json:"save_warning,omitempty"`
+}
+
+type runsResponse struct {
+	Runs []output.ResultSummary `json:"runs"`
 }
 
 type personasResponse struct {
 	Path     string            `json:"path"`
 	Personas []persona.Persona `json:"personas"`
+	Warning  string            `json:"warning,omitempty"`
+	// Warnings are soft roster issues from persona.Warnings.
+	Warnings []persona.Warning `json:"warnings,omitempty"`
+}
+
+type recommendResponse struct {
+	Path     string            `json:"path"`
+	Problem  string            `json:"problem"`
+	Size     int               `json:"size"`
+	Personas []persona.Persona `json:"personas"`
 }
 
 type streamStartEvent struct {
 	Problem      string `json:"problem"`
 	PersonaPath  string `json:"persona_path,omitempty"`
 	PersonaCount int    `json:"persona_count"`
+	// SpeakerColors is the same mapping the finished result records.
+	SpeakerColors map[string]string `json:"speaker_colors,omitempty"`
 }
 
 type streamStartResponse struct {
@@ -117,6 +192,57 @@ type streamStopResponse struct {
 	Status string `json:"status"`
 }
 
+type streamAskRequest struct {
+	RunID    string `json:"run_id"`
+	Question string `json:"question"`
+}
+
+type streamMuteRequest struct {
+	RunID     string `json:"run_id"`
+	PersonaID string `json:"persona_id"`
+}
+
+type streamMuteResponse struct {
+	RunID     string `json:"run_id"`
+	PersonaID string `json:"persona_id"`
+	Status    string `json:"status"`
+}
+
+type streamAskResponse struct {
+	RunID  string `json:"run_id"`
+	Status string `json:"status"`
+}
+
+// streamProgressEvent replaces turn events for mode=summary subscribers.
+type streamProgressEvent struct {
+	RunID       string `json:"run_id"`
+	Turns       int    `json:"turns"`
+	LastSpeaker string `json:"last_speaker,omitempty"`
+}
+
+// streamFinalModeratorEvent carries one fragment of the final wrap-up while
+// it is still being written; the completed text follows as a turn event.
+type streamFinalModeratorEvent struct {
+	RunID string `json:"run_id"`
+	Delta string `json:"delta"`
+}
+
+// streamDeltaEvent carries one fragment of a persona turn while it is still
+// being written; the completed turn follows as a turn event.
+type streamDeltaEvent struct {
+	RunID     string `json:"run_id"`
+	SpeakerID string `json:"speaker_id"`
+	Delta     string `json:"delta"`
+}
+
+// streamSlowTurnEvent reports that the persona or moderator call for the
+// next turn is taking longer than Config.SlowTurnNudge.
+type streamSlowTurnEvent struct {
+	RunID     string `json:"run_id"`
+	SpeakerID string `json:"speaker_id"`
+	Detail    string `json:"detail"`
+}
+
 type streamStoppedEvent struct {
 	RunID  string `json:"run_id"`
 	Status string `json:"status"`
@@ -135,6 +261,18 @@ func NewApp(cfg Config) *App {
 	if cfg.TurnBuffer <= 0 {
 		cfg.TurnBuffer = defaultTurnBuffer
 	}
+	if cfg.RetentionInterval <= 0 {
+		cfg.RetentionInterval = defaultRetentionInterval
+	}
+	if len(cfg.OutputFormats) == 0 {
+		cfg.OutputFormats = output.DefaultFormats
+	}
+	if cfg.MaxPersonas <= 0 {
+		cfg.MaxPersonas = persona.MaxPersonas
+	}
+	if cfg.PersonaWarnAt <= 0 {
+		cfg.PersonaWarnAt = defaultPersonaWarnAt
+	}
 	baseDir := strings.TrimSpace(cfg.BaseDir)
 	if baseDir == "" {
 		wd, err := os.Getwd()
@@ -149,16 +287,26 @@ func NewApp(cfg Config) *App {
 	}
 
 	return &App{
-		personaPath: cfg.PersonaPath,
-		baseDir:     filepath.Clean(baseDir),
-		outputDir:   cfg.OutputDir,
-		runner:      cfg.Runner,
-		runnerCfg:   cfg.RunnerDefaults,
-		loader:      cfg.Loader,
-		now:         cfg.Now,
-		runTimeout:  cfg.RunTimeout,
-		turnBuffer:  cfg.TurnBuffer,
-		runs:        make(map[string]*debateRun),
+		personaPath:       cfg.PersonaPath,
+		personas:          cfg.Personas,
+		baseDir:           filepath.Clean(baseDir),
+		outputDir:         cfg.OutputDir,
+		runner:            cfg.Runner,
+		runnerCfg:         cfg.RunnerDefaults,
+		loader:            cfg.Loader,
+		now:               cfg.Now,
+		runTimeout:        cfg.RunTimeout,
+		turnBuffer:        cfg.TurnBuffer,
+		retention:         cfg.Retention,
+		retentionInterval: cfg.RetentionInterval,
+		outputFormats:     cfg.OutputFormats,
+		maxPersonas:       cfg.MaxPersonas,
+		personaWarnAt:     cfg.PersonaWarnAt,
+		systemPrompts:     cfg.SystemPrompts,
+		saveFallbackDir:   cfg.SaveFallbackDir,
+		metricsCSVPath:    cfg.MetricsCSVPath,
+		adminToken:        strings.TrimSpace(cfg.AdminToken),
+		runs:              make(map[string]*debateRun),
 	}
 }
 
@@ -185,6 +333,9 @@ func (a *App) Start(ctx context.Context, addr string) error {
 		defer cancel()
 		_ = server.Shutdown(shutdownCtx)
 	}()
+	if a.retention.Enabled() {
+		go a.runRetentionLoop(ctx)
+	}
 
 	err := server.ListenAndServe()
 	if errors.Is(err, http.ErrServerClosed) {
@@ -198,10 +349,19 @@ func (a *App) Handler() http.Handler {
 	mux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.FS(staticFS))))
 	mux.HandleFunc("/", a.handleIndex)
 	mux.HandleFunc("/api/personas", a.handlePersonas)
+	mux.HandleFunc("/api/recommend", a.handleRecommend)
 	mux.HandleFunc("/api/debate", a.handleDebate)
 	mux.HandleFunc("/api/debate/stream/start", a.handleDebateStreamStart)
 	mux.HandleFunc("/api/debate/stream", a.handleDebateStream)
 	mux.HandleFunc("/api/debate/stream/stop", a.handleDebateStreamStop)
+	mux.HandleFunc("/api/debate/stream/ask", a.handleDebateStreamAsk)
+	mux.HandleFunc("/api/debate/stream/mute", a.handleDebateStreamMute)
+	mux.HandleFunc("/api/debate/stream/unmute", a.handleDebateStreamUnmute)
+	mux.HandleFunc("/api/runs", a.handleRuns)
+	mux.HandleFunc("/api/debate/result", a.handleResult)
+	mux.HandleFunc("/api/config", a.handleConfig)
+	mux.HandleFunc("/healthz", a.handleHealthz)
+	mux.HandleFunc("/readyz", a.handleReadyz)
 	return mux
 }
 
@@ -225,22 +385,165 @@ func (a *App) handlePersonas(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	loaderPath, displayPath, err := a.resolvePersonaPath(r.URL.Query().Get("path"))
+	personas, displayPath, err := a.loadPersonaRoster(r.URL.Query().Get("path"))
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, personaErrorCode(err), err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, personasResponse{
+		Path:     displayPath,
+		Personas: personas,
+		Warning:  a.personaCountWarning(len(personas)),
+		Warnings: persona.Warnings(personas),
+	})
+}
+
+// handleRecommend suggests a panel of size personas from the roster at path
+// for problem, using orchestrator.RecommendPanel without calling the model.
+func (a *App) handleRecommend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	query := r.URL.Query()
+	problem := strings.TrimSpace(query.Get("problem"))
+	if problem == "" {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, "problem is required")
+		return
+	}
+	size, err := strconv.Atoi(strings.TrimSpace(query.Get("size")))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, fmt.Sprintf("resolve personas path: %v", err))
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, "size must be an integer")
 		return
 	}
-	personas, err := a.loader(loaderPath)
+	personas, displayPath, err := a.loadPersonaRoster(query.Get("path"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, fmt.Sprintf("load personas: %v", err))
+		writeErrorCode(w, http.StatusBadRequest, personaErrorCode(err), err.Error())
 		return
 	}
-	writeJSON(w, http.StatusOK, personasResponse{
+	panel, err := orchestrator.RecommendPanel(problem, personas, size)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, recommendResponse{
 		Path:     displayPath,
-		Personas: personas,
+		Problem:  problem,
+		Size:     size,
+		Personas: panel,
 	})
 }
 
+func (a *App) handleRuns(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	project := strings.TrimSpace(r.URL.Query().Get("project"))
+	if err := validateProjectName(project); err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+	summaries, err := output.ListResults(a.outputDir)
+	if err != nil {
+		writeErrorCode(w, http.StatusInternalServerError, errCodeInternalError, fmt.Sprintf("list runs: %v", err))
+		return
+	}
+
+	runs := make([]output.ResultSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		if project != "" && summary.Project != project {
+			continue
+		}
+		runs = append(runs, summary)
+	}
+	writeJSON(w, http.StatusOK, runsResponse{Runs: runs})
+}
+
+// handleResult returns a saved JSON result, such as a path from /api/runs,
+// so the UI can show a finished debate again after a reload. Only results
+// under OutputDir or SaveFallbackDir are served.
+func (a *App) handleResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	path := strings.TrimSpace(r.URL.Query().Get("path"))
+	if path == "" {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, "result path is required")
+		return
+	}
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, "result path must be a .json file")
+		return
+	}
+	loaderPath, displayPath, err := a.resolveResultPath(path)
+	if err != nil {
+		code := errCodeInvalidRequest
+		if errors.Is(err, errResultPathOutsideOutput) {
+			code = errCodePathTraversal
+		}
+		writeErrorCode(w, http.StatusBadRequest, code, fmt.Sprintf("resolve result path: %v", err))
+		return
+	}
+	if _, err := os.Stat(loaderPath); errors.Is(err, os.ErrNotExist) {
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "result not found")
+		return
+	}
+	result, err := output.LoadResult(loaderPath)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, fmt.Sprintf("load result: %v", err))
+		return
+	}
+
+	resp := debateResponse{Result: result, SavedJSONPath: displayPath}
+	if _, err := os.Stat(output.MarkdownPath(loaderPath)); err == nil {
+		resp.SavedMarkdownPath = output.MarkdownPath(displayPath)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// resolveResultPath accepts path, as returned in saved_json_path, only when
+// it lies under OutputDir or SaveFallbackDir after following symlinks.
+// Relative paths are taken from BaseDir like other API paths. displayPath is
+// "./"-prefixed under BaseDir and absolute otherwise, as for fallback saves.
+func (a *App) resolveResultPath(path string) (loaderPath string, displayPath string, err error) {
+	candidate := filepath.Clean(path)
+	if !filepath.IsAbs(candidate) {
+		candidate = filepath.Join(a.baseDir, candidate)
+	}
+	candidateForCheck, err := resolvePathForContainment(candidate)
+	if err != nil {
+		return "", "", fmt.Errorf("resolve result path: %w", err)
+	}
+	allowed := false
+	for _, root := range []string{a.outputDir, a.saveFallbackDir} {
+		if strings.TrimSpace(root) == "" {
+			continue
+		}
+		rootForCheck, err := resolvePathForContainment(root)
+		if err != nil {
+			continue
+		}
+		if within, err := pathWithinBase(rootForCheck, candidateForCheck); err == nil && within {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", "", errResultPathOutsideOutput
+	}
+
+	displayPath = candidate
+	if rel, err := filepath.Rel(a.baseDir, candidate); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		displayPath = "./" + filepath.ToSlash(rel)
+	}
+	return candidate, displayPath, nil
+}
+
 func (a *App) handleDebate(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		methodNotAllowed(w, http.MethodPost)
@@ -252,34 +555,43 @@ func (a *App) handleDebate(w http.ResponseWriter, r *http.Request) {
 
 	req, err := decodeDebateRequest(body)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	personas, _, err := a.resolvePersonas(req.PersonaPath, req.Personas)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, fmt.Sprintf("load personas: %v", err))
+		writeErrorCode(w, http.StatusBadRequest, personaErrorCode(err), fmt.Sprintf("load personas: %v", err))
+		return
+	}
+	if err := req.validateOpeningSpeaker(personas); err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	runCfg, err := a.resolveRunnerConfig(req)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	runCtx, cancel, err := a.contextWithRuntimeTimeout(r.Context(), req)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 	if cancel != nil {
 		defer cancel()
 	}
 
-	resp, err := a.runAndSaveDebate(runCtx, req.Problem, personas, runCfg, nil)
+	resp, err := a.runAndSaveDebate(runCtx, debateJob{
+		problem:  req.Problem,
+		personas: personas,
+		runCfg:   runCfg,
+		labels:   req.labels(),
+	})
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, err.Error())
+		writeErrorCode(w, http.StatusInternalServerError, debateErrorCode(err), err.Error())
 		return
 	}
 
@@ -287,12 +599,18 @@ func (a *App) handleDebate(w http.ResponseWriter, r *http.Request) {
 }
 
 func (a *App) resolveRunnerConfig(req debateRequest) (*orchestrator.Config, error) {
+	base, updated := a.runnerDefaults()
 	if !req.hasRunnerTuning() {
+		// Once defaults have been updated live, the runner's own config is
+		// stale, so untuned requests go through RunWithConfig as well.
+		if updated {
+			return &base, nil
+		}
 		return nil, nil
 	}
 	if _, ok := a.runner.(ConfigurableRunner); !ok {
 		return nil, errors.New("runtime tuning is not supported by the current runner")
 	}
-	cfg := req.applyRunnerTuning(a.runnerCfg)
+	cfg := req.applyRunnerTuning(base)
 	return &cfg, nil
 }
@@ -0,0 +1,150 @@
+package web
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"debate/internal/orchestrator"
+)
+
+// configUpdateRequest is the body of PUT /api/config. Omitted fields keep
+// their current default; the accepted fields mirror per-request tuning.
+type configUpdateRequest struct {
+	AudienceMode              *string  `json:"audience_mode,omitempty"`
+	MaxTurns                  *int     `json:"max_turns,omitempty"`
+	ConsensusThreshold        *float64 `json:"consensus_threshold,omitempty"`
+	MaxNoProgressJudges       *int     `json:"max_no_progress_judges,omitempty"`
+	NoProgressEpsilon         *float64 `json:"no_progress_epsilon,omitempty"`
+	FocusPersonaID            *string  `json:"focus_persona_id,omitempty"`
+	FocusBias                 *float64 `json:"focus_bias,omitempty"`
+	ModeratorName             *string  `json:"moderator_name,omitempty"`
+	IrreconcilableAfterJudges *int     `json:"irreconcilable_after_judges,omitempty"`
+	UnlimitedHardMaxTurns     *int     `json:"unlimited_hard_max_turns,omitempty"`
+	DirectHandoffJudgeEvery   *int     `json:"direct_handoff_judge_every,omitempty"`
+	LLMHistoryTurnWindow      *int     `json:"llm_history_turn_window,omitempty"`
+	MaxDurationSeconds        *int     `json:"max_duration_seconds,omitempty"`
+	MaxTotalTokens            *int     `json:"max_total_tokens,omitempty"`
+}
+
+type configResponse struct {
+	Config orchestrator.Config `json:"config"`
+}
+
+// tuning reuses debateRequest validation and merge logic for the update.
+func (r configUpdateRequest) tuning() debateRequest {
+	return debateRequest{
+		AudienceMode:              r.AudienceMode,
+		MaxTurns:                  r.MaxTurns,
+		ConsensusThreshold:        r.ConsensusThreshold,
+		MaxNoProgressJudges:       r.MaxNoProgressJudges,
+		NoProgressEpsilon:         r.NoProgressEpsilon,
+		FocusPersonaID:            r.FocusPersonaID,
+		FocusBias:                 r.FocusBias,
+		ModeratorName:             r.ModeratorName,
+		IrreconcilableAfterJudges: r.IrreconcilableAfterJudges,
+		UnlimitedHardMaxTurns:     r.UnlimitedHardMaxTurns,
+		DirectHandoffJudgeEvery:   r.DirectHandoffJudgeEvery,
+		LLMHistoryTurnWindow:      r.LLMHistoryTurnWindow,
+		MaxDurationSeconds:        r.MaxDurationSeconds,
+		MaxTotalTokens:            r.MaxTotalTokens,
+	}
+}
+
+func decodeConfigUpdateRequest(body io.Reader) (debateRequest, error) {
+	var req configUpdateRequest
+	if err := decodeStrictJSON(body, &req); err != nil {
+		return debateRequest{}, fmt.Errorf("invalid request body: %w", err)
+	}
+	tuning := req.tuning()
+	if !tuning.hasRunnerTuning() {
+		return debateRequest{}, errors.New("at least one config field is required")
+	}
+	if err := tuning.validateRuntimeTuning(); err != nil {
+		return debateRequest{}, err
+	}
+	return tuning, nil
+}
+
+// runnerDefaults returns the baseline orchestrator config for new runs and
+// whether it has been replaced by UpdateConfig since startup.
+func (a *App) runnerDefaults() (orchestrator.Config, bool) {
+	a.runnerCfgMu.RLock()
+	defer a.runnerCfgMu.RUnlock()
+	return a.runnerCfg, a.runnerCfgUpdated
+}
+
+// UpdateConfig replaces the default orchestrator config used by runs started
+// after it returns. Runs already in flight keep the config they started
+// with. It requires a ConfigurableRunner, since a plain Runner has its
+// config fixed at construction.
+func (a *App) UpdateConfig(cfg orchestrator.Config) error {
+	_, err := a.updateRunnerDefaults(func(orchestrator.Config) orchestrator.Config {
+		return cfg
+	})
+	return err
+}
+
+// updateRunnerDefaults applies update to the current defaults under the
+// lock, so concurrent partial updates do not drop each other's fields.
+func (a *App) updateRunnerDefaults(update func(orchestrator.Config) orchestrator.Config) (orchestrator.Config, error) {
+	if _, ok := a.runner.(ConfigurableRunner); !ok {
+		return orchestrator.Config{}, errors.New("config updates are not supported by the current runner")
+	}
+
+	a.runnerCfgMu.Lock()
+	defer a.runnerCfgMu.Unlock()
+	cfg := update(a.runnerCfg)
+	// Per-run hooks are attached when a run starts and must not leak into
+	// the shared defaults.
+	cfg.Interjections = nil
+	cfg.OnEvent = nil
+	cfg.OnFinalModeratorDelta = nil
+	cfg.OnTurnDelta = nil
+	a.runnerCfg = cfg
+	a.runnerCfgUpdated = true
+	return cfg, nil
+}
+
+func (a *App) handleConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		methodNotAllowed(w, http.MethodPut)
+		return
+	}
+	if a.adminToken == "" {
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "config updates are disabled")
+		return
+	}
+	if !a.authorizedAdmin(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeErrorCode(w, http.StatusUnauthorized, errCodeUnauthorized, "a valid admin bearer token is required")
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxRequestBytes)
+	defer body.Close()
+
+	tuning, err := decodeConfigUpdateRequest(body)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+
+	cfg, err := a.updateRunnerDefaults(tuning.applyRunnerTuning)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, configResponse{Config: orchestrator.NormalizeConfig(cfg)})
+}
+
+func (a *App) authorizedAdmin(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(a.adminToken)) == 1
+}
@@ -0,0 +1,47 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+// handleHealthz reports that the process is serving. Probes may use any
+// method, so it does not reject non-GET requests.
+func (a *App) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// handleReadyz reports whether a debate could start now: the default persona
+// roster must load and the output dir must be writable. Like healthz it
+// accepts any method.
+func (a *App) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if _, _, err := a.loadPersonaRoster(""); err != nil {
+		writeErrorCode(w, http.StatusServiceUnavailable, errCodeNotReady, err.Error())
+		return
+	}
+	if err := checkDirWritable(a.outputDir); err != nil {
+		writeErrorCode(w, http.StatusServiceUnavailable, errCodeNotReady, fmt.Sprintf("output dir %s is not writable: %v", a.outputDir, err))
+		return
+	}
+	writeJSON(w, http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// checkDirWritable creates dir if needed and proves a file can be written
+// there by creating and removing a probe file.
+func checkDirWritable(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	probe, err := os.CreateTemp(dir, ".debate-ready-*")
+	if err != nil {
+		return err
+	}
+	name := probe.Name()
+	_ = probe.Close()
+	return os.Remove(name)
+}
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"debate/internal/persona"
+)
+
+func newHealthTestApp(t *testing.T, outputDir string, loadErr error) *App {
+	t.Helper()
+	return NewApp(Config{
+		PersonaPath: "./personas.json",
+		BaseDir:     t.TempDir(),
+		OutputDir:   outputDir,
+		Runner:      &stubRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			if loadErr != nil {
+				return nil, loadErr
+			}
+			return []persona.Persona{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}}, nil
+		},
+		Now: time.Now,
+	})
+}
+
+func TestHealthzReportsOKForAnyMethod(t *testing.T) {
+	app := newHealthTestApp(t, t.TempDir(), errors.New("broken roster"))
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodPost} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(method, "/healthz", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: unexpected status %d body=%s", method, rec.Code, rec.Body.String())
+		}
+		if method != http.MethodHead && !strings.Contains(rec.Body.String(), `"status":"ok"`) {
+			t.Fatalf("%s: unexpected body %s", method, rec.Body.String())
+		}
+	}
+}
+
+func TestReadyzChecksPersonasAndOutputDir(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "outputs")
+	rec := httptest.NewRecorder()
+	newHealthTestApp(t, outputDir, nil).Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d body=%s", rec.Code, rec.Body.String())
+	}
+	if _, err := os.Stat(outputDir); err != nil {
+		t.Fatalf("expected readyz to create the output dir: %v", err)
+	}
+
+	rec = httptest.NewRecorder()
+	newHealthTestApp(t, outputDir, errors.New("broken roster")).Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 for persona load failure, got %d", rec.Code)
+	}
+	if apiErr := decodeAPIError(t, rec.Body.Bytes()); apiErr.Code != errCodeNotReady || !strings.Contains(apiErr.Message, "broken roster") {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rec = httptest.NewRecorder()
+	newHealthTestApp(t, filepath.Join(blocker, "outputs"), nil).Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 for unwritable output dir, got %d", rec.Code)
+	}
+	if apiErr := decodeAPIError(t, rec.Body.Bytes()); apiErr.Code != errCodeNotReady || !strings.Contains(apiErr.Message, "output dir") {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+}
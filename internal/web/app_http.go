@@ -19,9 +19,17 @@ func decodeDebateRequest(body io.Reader) (debateRequest, error) {
 	if req.Problem == "" {
 		return debateRequest{}, errors.New("problem is required")
 	}
+	// An explicit "personas": [] with no path is more likely a client bug
+	// than a request for the default roster, so it is not silently accepted.
+	if req.Personas != nil && len(req.Personas) == 0 && strings.TrimSpace(req.PersonaPath) == "" {
+		return debateRequest{}, errors.New("personas is an empty array; omit it to use the default personas or set persona_path")
+	}
 	if err := req.validateRuntimeTuning(); err != nil {
 		return debateRequest{}, err
 	}
+	if err := req.normalizeLabels(); err != nil {
+		return debateRequest{}, err
+	}
 	return req, nil
 }
 
@@ -37,6 +45,38 @@ func decodeStreamStopRequest(body io.Reader) (streamStopRequest, error) {
 	return req, nil
 }
 
+func decodeStreamAskRequest(body io.Reader) (streamAskRequest, error) {
+	var req streamAskRequest
+	if err := decodeStrictJSON(body, &req); err != nil {
+		return streamAskRequest{}, fmt.Errorf("invalid request body: %w", err)
+	}
+	req.RunID = strings.TrimSpace(req.RunID)
+	req.Question = strings.TrimSpace(req.Question)
+	if req.RunID == "" {
+		return streamAskRequest{}, errors.New("run_id is required")
+	}
+	if req.Question == "" {
+		return streamAskRequest{}, errors.New("question is required")
+	}
+	return req, nil
+}
+
+func decodeStreamMuteRequest(body io.Reader) (streamMuteRequest, error) {
+	var req streamMuteRequest
+	if err := decodeStrictJSON(body, &req); err != nil {
+		return streamMuteRequest{}, fmt.Errorf("invalid request body: %w", err)
+	}
+	req.RunID = strings.TrimSpace(req.RunID)
+	req.PersonaID = strings.TrimSpace(req.PersonaID)
+	if req.RunID == "" {
+		return streamMuteRequest{}, errors.New("run_id is required")
+	}
+	if req.PersonaID == "" {
+		return streamMuteRequest{}, errors.New("persona_id is required")
+	}
+	return req, nil
+}
+
 func writeSSE(w io.Writer, flusher http.Flusher, event string, payload any) error {
 	data, err := json.Marshal(payload)
 	if err != nil {
@@ -54,7 +94,11 @@ func writeSSE(w io.Writer, flusher http.Flusher, event string, payload any) erro
 
 func methodNotAllowed(w http.ResponseWriter, allowed string) {
 	w.Header().Set("Allow", allowed)
-	writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: apiError{
+		Code:    errCodeMethodNotAllowed,
+		Message: "method not allowed",
+		Details: map[string]any{"allow": allowed},
+	}})
 }
 
 func writeJSON(w http.ResponseWriter, status int, payload any) {
@@ -63,6 +107,6 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 	_ = json.NewEncoder(w).Encode(payload)
 }
 
-func writeError(w http.ResponseWriter, status int, message string) {
-	writeJSON(w, status, map[string]string{"error": message})
+func writeErrorCode(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, errorResponse{Error: apiError{Code: code, Message: message}})
 }
@@ -3,54 +3,165 @@ package web
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"debate/internal/orchestrator"
 	"debate/internal/output"
 	"debate/internal/persona"
 )
 
-func (a *App) runAndSaveDebate(ctx context.Context, problem string, personas []persona.Persona, runCfg *orchestrator.Config, onTurn func(orchestrator.Turn)) (debateResponse, error) {
+// debateJob describes one debate execution and where its turns go.
+type debateJob struct {
+	problem  string
+	personas []persona.Persona
+	runCfg   *orchestrator.Config
+	labels   runLabels
+	onTurn   func(orchestrator.Turn)
+	// liveMarkdown appends turns to the markdown file while the debate runs.
+	liveMarkdown bool
+}
+
+func (a *App) runAndSaveDebate(ctx context.Context, job debateJob) (debateResponse, error) {
+	savePath := ""
+	onTurn := job.onTurn
+	var live *output.StreamingMarkdownWriter
+	if job.liveMarkdown && output.HasFormat(a.outputFormats, output.FormatMarkdown) {
+		var err error
+		savePath, err = a.nextOutputPath(job.labels.project)
+		if err != nil {
+			return debateResponse{}, saveError{fmt.Errorf("prepare output path: %w", err)}
+		}
+		live, err = output.NewStreamingMarkdownWriter(output.MarkdownPath(savePath), job.problem, a.now())
+		if err != nil {
+			return debateResponse{}, saveError{fmt.Errorf("prepare live markdown: %w", err)}
+		}
+		onTurn = func(turn orchestrator.Turn) {
+			_ = live.AppendTurn(turn)
+			if job.onTurn != nil {
+				job.onTurn(turn)
+			}
+		}
+	}
+	discardLive := func() {
+		if live != nil {
+			_ = live.Discard()
+		}
+	}
+
 	var (
 		result orchestrator.Result
 		err    error
 	)
-	if runCfg != nil {
+	if job.runCfg != nil {
 		configurableRunner, ok := a.runner.(ConfigurableRunner)
 		if !ok {
+			discardLive()
 			return debateResponse{}, fmt.Errorf("runtime tuning is not supported by the current runner")
 		}
-		result, err = configurableRunner.RunWithConfig(ctx, problem, personas, *runCfg, onTurn)
+		result, err = configurableRunner.RunWithConfig(ctx, job.problem, job.personas, *job.runCfg, onTurn)
 	} else {
-		result, err = a.runner.Run(ctx, problem, personas, onTurn)
+		result, err = a.runner.Run(ctx, job.problem, job.personas, onTurn)
 	}
 	if err != nil {
+		discardLive()
 		return debateResponse{}, fmt.Errorf("run debate: %w", err)
 	}
 	if err := ctx.Err(); err != nil {
+		discardLive()
 		return debateResponse{}, fmt.Errorf("debate canceled before save: %w", err)
 	}
 
-	savePath, err := a.nextOutputPath()
+	result.Project = job.labels.project
+	result.Tags = job.labels.tags
+
+	if live != nil {
+		// SaveResultFormats replaces the live transcript with the final rendering.
+		_ = live.Close()
+	}
+	savePath, saveWarning, err := a.saveFinishedResult(savePath, job.labels.project, result)
+	if err != nil || saveWarning != "" {
+		// The live transcript is incomplete without the final save.
+		discardLive()
+	}
 	if err != nil {
-		return debateResponse{}, fmt.Errorf("prepare output path: %w", err)
+		return debateResponse{}, saveError{err}
+	}
+
+	resp := debateResponse{Result: result, SaveWarning: saveWarning}
+	if a.metricsCSVPath != "" {
+		// Metrics are a convenience; a failed append must not fail the debate.
+		if err := output.AppendMetricsCSV(a.metricsCSVPath, result); err != nil {
+			log.Printf("append metrics csv: %v", err)
+		}
+	}
+	if output.HasFormat(a.outputFormats, output.FormatJSON) {
+		resp.SavedJSONPath = savePath
 	}
-	if err := output.SaveResult(savePath, result); err != nil {
-		return debateResponse{}, fmt.Errorf("save result: %w", err)
+	if output.HasFormat(a.outputFormats, output.FormatMarkdown) {
+		resp.SavedMarkdownPath = output.MarkdownPath(savePath)
 	}
+	if a.systemPrompts != nil {
+		runCfg, _ := a.runnerDefaults()
+		if job.runCfg != nil {
+			runCfg = *job.runCfg
+		}
+		// Like the metrics CSV, the opt-in archive must not fail a debate
+		// whose result files were already saved.
+		if err := output.SavePrompts(savePath, output.PromptArchive{
+			SystemPrompts: a.systemPrompts,
+			Personas:      result.Personas,
+			Config:        runCfg,
+		}); err != nil {
+			log.Printf("save prompts: %v", err)
+		} else {
+			resp.SavedPromptsPath = output.PromptsPath(savePath)
+		}
+	}
+	return resp, nil
+}
 
-	return debateResponse{
-		Result:            result,
-		SavedJSONPath:     savePath,
-		SavedMarkdownPath: output.MarkdownPath(savePath),
-	}, nil
+// saveFinishedResult writes result at savePath, or at a fresh output path when
+// savePath is empty. If OutputDir cannot be written and a fallback dir is
+// configured, the result is saved there instead and the returned warning
+// names both the failure and the fallback path.
+func (a *App) saveFinishedResult(savePath string, project string, result orchestrator.Result) (string, string, error) {
+	var err error
+	if savePath == "" {
+		savePath, err = a.nextOutputPath(project)
+		if err != nil {
+			err = fmt.Errorf("prepare output path: %w", err)
+		}
+	}
+	if err == nil {
+		if err = output.SaveResultFormats(savePath, result, a.outputFormats); err == nil {
+			return savePath, "", nil
+		}
+		err = fmt.Errorf("save result: %w", err)
+	}
+	if a.saveFallbackDir == "" {
+		return "", "", err
+	}
+
+	fallbackPath, fallbackErr := output.SaveResultFallback(a.saveFallbackDir, result, a.outputFormats, a.now())
+	if fallbackErr != nil {
+		return "", "", fmt.Errorf("%w; fallback save also failed: %v", err, fallbackErr)
+	}
+	warning := fmt.Sprintf("%v; saved to fallback path %s instead", err, fallbackPath)
+	log.Printf("output save fallback: %s", warning)
+	return fallbackPath, warning, nil
 }
 
-func (a *App) nextOutputPath() (string, error) {
-	basePath := output.NewTimestampPath(a.outputDir, a.now())
+func (a *App) nextOutputPath(project string) (string, error) {
+	dir := a.outputDir
+	if project != "" {
+		dir = filepath.Join(dir, project)
+	}
+	basePath := output.NewTimestampPath(dir, a.now())
 	ext := filepath.Ext(basePath)
 	stem := strings.TrimSuffix(basePath, ext)
 
@@ -60,7 +171,15 @@ func (a *App) nextOutputPath() (string, error) {
 		if seq > 1 {
 			candidate = fmt.Sprintf("%s-%06d%s", stem, seq-1, ext)
 		}
+		// Every artifact of the set must be free; a live markdown transcript
+		// may exist before its JSON result.
 		available, err := pathAvailable(candidate)
+		for _, format := range a.outputFormats {
+			if err != nil || !available {
+				break
+			}
+			available, err = pathAvailable(output.FormatPath(candidate, format))
+		}
 		if err != nil {
 			return "", err
 		}
@@ -80,3 +199,44 @@ func pathAvailable(path string) (bool, error) {
 	}
 	return false, fmt.Errorf("stat output path %q: %w", path, err)
 }
+
+func (a *App) runRetentionLoop(ctx context.Context) {
+	a.pruneOutputs()
+	ticker := time.NewTicker(a.retentionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.pruneOutputs()
+		}
+	}
+}
+
+// pruneOutputs applies retention to the output dir and each project subdir
+// independently.
+func (a *App) pruneOutputs() []string {
+	opts := a.retention
+	if opts.Now == nil {
+		opts.Now = a.now
+	}
+	dirs := []string{a.outputDir}
+	if entries, err := os.ReadDir(a.outputDir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				dirs = append(dirs, filepath.Join(a.outputDir, entry.Name()))
+			}
+		}
+	}
+
+	removed := make([]string, 0)
+	for _, dir := range dirs {
+		paths, err := output.Cleanup(dir, opts)
+		removed = append(removed, paths...)
+		if err != nil {
+			log.Printf("output retention cleanup: %v", err)
+		}
+	}
+	return removed
+}
@@ -10,6 +10,10 @@ import (
 	"debate/internal/persona"
 )
 
+// resolvePersonas picks the debate roster: non-empty inline personas win,
+// otherwise personaPath is loaded, falling back to the server default roster
+// or default path when it is empty. Inline personas together with a path are rejected; an explicitly
+// empty inline array without a path is rejected earlier while decoding.
 func (a *App) resolvePersonas(personaPath string, inline []persona.Persona) ([]persona.Persona, string, error) {
 	if len(inline) > 0 && strings.TrimSpace(personaPath) != "" {
 		return nil, "", errors.New("persona_path and personas cannot be used together")
@@ -19,24 +23,82 @@ func (a *App) resolvePersonas(personaPath string, inline []persona.Persona) ([]p
 		if err != nil {
 			return nil, "", err
 		}
+		if err := a.checkPersonaCount(len(normalized)); err != nil {
+			return nil, "", err
+		}
 		return normalized, "", nil
 	}
 
-	loaderPath, displayPath, err := a.resolvePersonaPath(personaPath)
-	if err != nil {
-		return nil, "", err
+	personas, displayPath := a.serverPersonas(personaPath)
+	if personas == nil {
+		loaderPath, resolvedPath, err := a.resolvePersonaPath(personaPath)
+		if err != nil {
+			return nil, "", err
+		}
+		displayPath = resolvedPath
+		personas, err = a.loader(loaderPath)
+		if err != nil {
+			return nil, displayPath, err
+		}
 	}
-	personas, err := a.loader(loaderPath)
+	normalized, err := persona.NormalizeAndValidate(personas)
 	if err != nil {
 		return nil, displayPath, err
 	}
-	normalized, err := persona.NormalizeAndValidate(personas)
-	if err != nil {
+	if err := a.checkPersonaCount(len(normalized)); err != nil {
 		return nil, displayPath, err
 	}
 	return normalized, displayPath, nil
 }
 
+// inlinePersonaPath is the display path of Config.Personas. Clients may send
+// it back as persona_path or ?path= to select that roster.
+const inlinePersonaPath = "inline"
+
+// serverPersonas returns a copy of Config.Personas and inlinePersonaPath when
+// rawPath selects the server default roster: it is empty or
+// inlinePersonaPath. Any other path returns nil and is loaded from disk.
+func (a *App) serverPersonas(rawPath string) ([]persona.Persona, string) {
+	path := strings.TrimSpace(rawPath)
+	if a.personas == nil || (path != "" && path != inlinePersonaPath) {
+		return nil, ""
+	}
+	return append([]persona.Persona(nil), a.personas...), inlinePersonaPath
+}
+
+// loadPersonaRoster loads the unvalidated roster named by rawPath, or the
+// server default, with the path to display.
+func (a *App) loadPersonaRoster(rawPath string) ([]persona.Persona, string, error) {
+	if personas, displayPath := a.serverPersonas(rawPath); personas != nil {
+		return personas, displayPath, nil
+	}
+	loaderPath, displayPath, err := a.resolvePersonaPath(rawPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolve personas path: %w", err)
+	}
+	personas, err := a.loader(loaderPath)
+	if err != nil {
+		return nil, displayPath, fmt.Errorf("load personas: %w", err)
+	}
+	return personas, displayPath, nil
+}
+
+// checkPersonaCount enforces the server's hard persona cap for debates.
+func (a *App) checkPersonaCount(count int) error {
+	if count > a.maxPersonas {
+		return fmt.Errorf("%w: got %d, this server allows at most %d", errTooManyPersonas, count, a.maxPersonas)
+	}
+	return nil
+}
+
+// personaCountWarning is the soft-limit notice shown before starting a debate.
+func (a *App) personaCountWarning(count int) string {
+	if count <= a.personaWarnAt {
+		return ""
+	}
+	return fmt.Sprintf("%d personas may be slow/expensive", count)
+}
+
 func (a *App) resolvePersonaPath(rawPath string) (loaderPath string, displayPath string, err error) {
 	path := strings.TrimSpace(rawPath)
 	if path == "" {
@@ -45,10 +107,32 @@ func (a *App) resolvePersonaPath(rawPath string) (loaderPath string, displayPath
 	if path == "" {
 		return "", "", errors.New("persona path is required")
 	}
-	if !strings.EqualFold(filepath.Ext(path), ".json") {
-		return "", "", errors.New("persona path must be a .json file")
+	paths := persona.SplitPathList(path)
+	if len(paths) > 1 {
+		// A comma-separated list is checked file by file and handed to the
+		// loader as a list of resolved paths to merge.
+		loaderPaths := make([]string, 0, len(paths))
+		displayPaths := make([]string, 0, len(paths))
+		for _, p := range paths {
+			loaderPath, displayPath, err := a.resolvePersonaPath(p)
+			if err != nil {
+				return "", "", err
+			}
+			loaderPaths = append(loaderPaths, loaderPath)
+			displayPaths = append(displayPaths, displayPath)
+		}
+		return strings.Join(loaderPaths, persona.PathListSeparator), strings.Join(displayPaths, persona.PathListSeparator), nil
+	}
+	if !persona.HasSupportedExtension(path) {
+		return "", "", errors.New("persona path must be a .json, .yaml or .yml file")
 	}
+	return a.resolvePathWithinBase(path, "persona", errPersonaPathOutsideBase)
+}
 
+// resolvePathWithinBase resolves path against BaseDir, following symlinks,
+// and returns outsideErr when the target escapes it. kind names the path in
+// other errors. displayPath is the "./"-prefixed path relative to BaseDir.
+func (a *App) resolvePathWithinBase(path string, kind string, outsideErr error) (loaderPath string, displayPath string, err error) {
 	cleanPath := filepath.Clean(path)
 	candidateAbs := cleanPath
 	if !filepath.IsAbs(candidateAbs) {
@@ -65,14 +149,14 @@ func (a *App) resolvePersonaPath(rawPath string) (loaderPath string, displayPath
 	}
 	candidateForCheck, err := resolvePathForContainment(candidateAbs)
 	if err != nil {
-		return "", "", fmt.Errorf("resolve persona path: %w", err)
+		return "", "", fmt.Errorf("resolve %s path: %w", kind, err)
 	}
 	isWithinBase, err := pathWithinBase(baseForCheck, candidateForCheck)
 	if err != nil {
 		return "", "", fmt.Errorf("relative path: %w", err)
 	}
 	if !isWithinBase {
-		return "", "", errors.New("persona path must stay within the project directory")
+		return "", "", outsideErr
 	}
 
 	relToBase, err := filepath.Rel(a.baseDir, candidateAbs)
@@ -80,7 +164,7 @@ func (a *App) resolvePersonaPath(rawPath string) (loaderPath string, displayPath
 		return "", "", fmt.Errorf("loader relative path: %w", err)
 	}
 	if relToBase == ".." || strings.HasPrefix(relToBase, ".."+string(filepath.Separator)) {
-		return "", "", errors.New("persona path must stay within the project directory")
+		return "", "", outsideErr
 	}
 	relToBase = filepath.Clean(relToBase)
 	displayPath = filepath.ToSlash(relToBase)
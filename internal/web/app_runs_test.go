@@ -0,0 +1,299 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"debate/internal/orchestrator"
+	"debate/internal/output"
+	"debate/internal/persona"
+)
+
+func newLabelsTestApp(t *testing.T, outDir string) *App {
+	t.Helper()
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Planner", Role: "plan"},
+		{ID: "p2", Name: "Builder", Role: "build"},
+	}
+	runner := &stubRunner{
+		result: orchestrator.Result{
+			Problem:   "label test",
+			Personas:  personas,
+			Status:    orchestrator.StatusMaxTurnsReached,
+			Consensus: orchestrator.Consensus{Summary: "done"},
+		},
+	}
+	seq := 0
+	return NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   outDir,
+		Runner:      runner,
+		Loader: func(string) ([]persona.Persona, error) {
+			return personas, nil
+		},
+		Now: func() time.Time {
+			seq++
+			return time.Date(2026, 3, 1, 1, 2, seq, 0, time.UTC)
+		},
+	})
+}
+
+func postDebate(t *testing.T, app *App, body string) debateResponse {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(body))
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp debateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return resp
+}
+
+func TestDebateEndpointStoresProjectAndTags(t *testing.T) {
+	outDir := t.TempDir()
+	app := newLabelsTestApp(t, outDir)
+
+	resp := postDebate(t, app, `{"problem":"label test","project":"alpha","tags":["infra"," infra ","q3"]}`)
+	if resp.Result.Project != "alpha" {
+		t.Fatalf("expected project alpha on result, got %q", resp.Result.Project)
+	}
+	if len(resp.Result.Tags) != 2 || resp.Result.Tags[0] != "infra" || resp.Result.Tags[1] != "q3" {
+		t.Fatalf("expected deduplicated tags [infra q3], got %v", resp.Result.Tags)
+	}
+	if filepath.Dir(resp.SavedJSONPath) != filepath.Join(outDir, "alpha") {
+		t.Fatalf("expected result in project subdir, got %s", resp.SavedJSONPath)
+	}
+}
+
+func TestDebateEndpointRejectsUnsafeProject(t *testing.T) {
+	app := newLabelsTestApp(t, t.TempDir())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(`{"problem":"label test","project":"../escape"}`))
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRunsEndpointFiltersByProject(t *testing.T) {
+	outDir := t.TempDir()
+	app := newLabelsTestApp(t, outDir)
+
+	postDebate(t, app, `{"problem":"label test","project":"alpha"}`)
+	postDebate(t, app, `{"problem":"label test","project":"beta"}`)
+	postDebate(t, app, `{"problem":"label test"}`)
+
+	listRuns := func(query string) runsResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/runs"+query, nil)
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+		}
+		var resp runsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return resp
+	}
+
+	all := listRuns("")
+	if len(all.Runs) != 3 {
+		t.Fatalf("expected 3 runs, got %d", len(all.Runs))
+	}
+	alpha := listRuns("?project=alpha")
+	if len(alpha.Runs) != 1 || alpha.Runs[0].Project != "alpha" {
+		t.Fatalf("expected only alpha run, got %+v", alpha.Runs)
+	}
+}
+
+func getResult(app *App, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/debate/result?path="+url.QueryEscape(path), nil))
+	return rec
+}
+
+func TestResultEndpointReturnsSavedResult(t *testing.T) {
+	baseDir := t.TempDir()
+	savePath := filepath.Join(baseDir, "outputs", "20260301-010203-debate.json")
+	saved := orchestrator.Result{
+		Problem: "reload me",
+		Status:  orchestrator.StatusConsensusReached,
+		Turns:   []orchestrator.Turn{{Index: 1, Type: orchestrator.TurnTypePersona, SpeakerID: "p1", Content: "hello"}},
+	}
+	if err := output.SaveResult(savePath, saved); err != nil {
+		t.Fatalf("save result: %v", err)
+	}
+	app := NewApp(Config{BaseDir: baseDir, OutputDir: filepath.Join(baseDir, "outputs"), Runner: &stubRunner{}, Now: time.Now})
+
+	rec := getResult(app, "outputs/20260301-010203-debate.json")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp debateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Result.Problem != "reload me" || len(resp.Result.Turns) != 1 {
+		t.Fatalf("unexpected result: %+v", resp.Result)
+	}
+	if resp.SavedJSONPath != "./outputs/20260301-010203-debate.json" || resp.SavedMarkdownPath != "./outputs/20260301-010203-debate.md" {
+		t.Fatalf("unexpected saved paths: %q %q", resp.SavedJSONPath, resp.SavedMarkdownPath)
+	}
+}
+
+func TestResultEndpointRejectsMissingAndEscapingPaths(t *testing.T) {
+	baseDir := t.TempDir()
+	outsideDir := t.TempDir()
+	outsideResult := filepath.Join(outsideDir, "outside.json")
+	if err := output.SaveResult(outsideResult, orchestrator.Result{Problem: "secret"}); err != nil {
+		t.Fatalf("save outside result: %v", err)
+	}
+	app := NewApp(Config{BaseDir: baseDir, OutputDir: filepath.Join(baseDir, "outputs"), Runner: &stubRunner{}, Now: time.Now})
+
+	if rec := getResult(app, "outputs/missing.json"); rec.Code != http.StatusNotFound {
+		t.Fatalf("missing file: unexpected status %d body=%s", rec.Code, rec.Body.String())
+	}
+	rec := getResult(app, outsideResult)
+	if rec.Code != http.StatusBadRequest || decodeAPIError(t, rec.Body.Bytes()).Code != errCodePathTraversal {
+		t.Fatalf("outside path: unexpected response %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := getResult(app, "../outside.json"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("traversal: unexpected status %d body=%s", rec.Code, rec.Body.String())
+	}
+	if err := os.Symlink(outsideResult, filepath.Join(baseDir, "link.json")); err == nil {
+		if rec := getResult(app, "./link.json"); rec.Code != http.StatusBadRequest {
+			t.Fatalf("symlink escape: unexpected status %d body=%s", rec.Code, rec.Body.String())
+		}
+	}
+	if rec := getResult(app, "outputs/result.md"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("non-json path: unexpected status %d body=%s", rec.Code, rec.Body.String())
+	}
+	if err := output.SaveResult(filepath.Join(baseDir, "personas.json"), orchestrator.Result{Problem: "not a result"}); err != nil {
+		t.Fatalf("save base dir json: %v", err)
+	}
+	rec = getResult(app, "personas.json")
+	if rec.Code != http.StatusBadRequest || decodeAPIError(t, rec.Body.Bytes()).Code != errCodePathTraversal {
+		t.Fatalf("json outside output dir: unexpected response %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestResultEndpointServesFallbackSaves(t *testing.T) {
+	fallbackDir := t.TempDir()
+	resultPath := filepath.Join(fallbackDir, "20260301-010203-debate.json")
+	if err := output.SaveResult(resultPath, orchestrator.Result{Problem: "fallback"}); err != nil {
+		t.Fatalf("save fallback result: %v", err)
+	}
+	app := NewApp(Config{
+		BaseDir:         t.TempDir(),
+		OutputDir:       t.TempDir(),
+		SaveFallbackDir: fallbackDir,
+		Runner:          &stubRunner{},
+		Now:             time.Now,
+	})
+
+	rec := getResult(app, resultPath)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var got debateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Result.Problem != "fallback" || got.SavedJSONPath != resultPath {
+		t.Fatalf("unexpected response: %+v", got)
+	}
+}
+
+func TestMarkdownOnlyOutputIsPrunedAndListed(t *testing.T) {
+	outDir := t.TempDir()
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Planner", Role: "plan"},
+		{ID: "p2", Name: "Builder", Role: "build"},
+	}
+	seq := 0
+	app := NewApp(Config{
+		PersonaPath:   "./personas.json",
+		OutputDir:     outDir,
+		OutputFormats: []output.Format{output.FormatMarkdown},
+		Retention:     output.RetentionOptions{MaxCount: 2},
+		Runner: &stubRunner{result: orchestrator.Result{
+			Problem: "md only", Personas: personas, Status: orchestrator.StatusMaxTurnsReached,
+		}},
+		Loader: func(string) ([]persona.Persona, error) { return personas, nil },
+		Now: func() time.Time {
+			seq++
+			return time.Date(2026, 3, 1, 1, 2, seq, 0, time.UTC)
+		},
+	})
+	for i := 0; i < 3; i++ {
+		postDebate(t, app, `{"problem":"md only"}`)
+	}
+
+	removed := app.pruneOutputs()
+	if len(removed) != 1 || filepath.Ext(removed[0]) != ".md" {
+		t.Fatalf("expected the oldest markdown set to be pruned, got %v", removed)
+	}
+	remaining, err := filepath.Glob(filepath.Join(outDir, "*.md"))
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Fatalf("expected 2 markdown files to remain, got %v", remaining)
+	}
+
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/runs", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp runsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(resp.Runs) != 2 || resp.Runs[0].MarkdownPath != remaining[1] || resp.Runs[0].Path != "" || resp.Runs[0].StartedAt.IsZero() {
+		t.Fatalf("expected markdown-only runs newest first, got %+v", resp.Runs)
+	}
+}
+
+func TestPromptArchiveFailureKeepsSavedResult(t *testing.T) {
+	outDir := t.TempDir()
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Planner", Role: "plan"},
+		{ID: "p2", Name: "Builder", Role: "build"},
+	}
+	now := time.Date(2026, 3, 1, 1, 2, 3, 0, time.UTC)
+	app := NewApp(Config{
+		PersonaPath:   "./personas.json",
+		OutputDir:     outDir,
+		SystemPrompts: map[string]string{"turn": "system"},
+		Runner:        &stubRunner{result: orchestrator.Result{Problem: "p", Personas: personas, Status: orchestrator.StatusMaxTurnsReached}},
+		Loader:        func(string) ([]persona.Persona, error) { return personas, nil },
+		Now:           func() time.Time { return now },
+	})
+	// A non-empty directory where the archive goes makes only that write fail.
+	blocker := output.PromptsPath(output.NewTimestampPath(outDir, now))
+	if err := os.MkdirAll(filepath.Join(blocker, "x"), 0o755); err != nil {
+		t.Fatalf("create blocker: %v", err)
+	}
+
+	resp := postDebate(t, app, `{"problem":"p"}`)
+	if resp.SavedJSONPath == "" || resp.SavedPromptsPath != "" {
+		t.Fatalf("expected the saved result without an archive path, got json=%q prompts=%q", resp.SavedJSONPath, resp.SavedPromptsPath)
+	}
+	if _, err := os.Stat(resp.SavedJSONPath); err != nil {
+		t.Fatalf("expected saved json file: %v", err)
+	}
+}
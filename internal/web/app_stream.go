@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -35,19 +36,23 @@ func (a *App) handleDebateStreamStart(w http.ResponseWriter, r *http.Request) {
 
 	req, err := decodeDebateRequest(body)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	personas, resolvedPath, err := a.resolvePersonas(req.PersonaPath, req.Personas)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, fmt.Sprintf("load personas: %v", err))
+		writeErrorCode(w, http.StatusBadRequest, personaErrorCode(err), fmt.Sprintf("load personas: %v", err))
+		return
+	}
+	if err := req.validateOpeningSpeaker(personas); err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	runCfg, err := a.resolveRunnerConfig(req)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
@@ -59,17 +64,19 @@ func (a *App) handleDebateStreamStart(w http.ResponseWriter, r *http.Request) {
 	runID := a.nextRunID()
 	runCtx, cancel := context.WithTimeout(context.Background(), timeout)
 	run := newDebateRun(runID, streamStartEvent{
-		Problem:      req.Problem,
-		PersonaPath:  resolvedPath,
-		PersonaCount: len(personas),
+		Problem:       req.Problem,
+		PersonaPath:   resolvedPath,
+		PersonaCount:  len(personas),
+		SpeakerColors: orchestrator.AssignSpeakerColors(personas),
 	}, cancel, a.turnBuffer)
+	runCfg = a.attachRunHooks(run, personas, runCfg)
 	a.storeRun(run)
 	time.AfterFunc(timeoutWithRetention(timeout), func() {
 		run.stop()
 		a.deleteRun(runID)
 	})
 
-	go a.executeDebateRun(runCtx, runID, run, req.Problem, personas, runCfg)
+	go a.executeDebateRun(runCtx, runID, run, req.Problem, personas, runCfg, req.labels())
 
 	writeJSON(w, http.StatusAccepted, streamStartResponse{
 		RunID:        runID,
@@ -87,19 +94,25 @@ func (a *App) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 
 	flusher, ok := w.(http.Flusher)
 	if !ok {
-		writeError(w, http.StatusInternalServerError, "streaming is not supported by this server")
+		writeErrorCode(w, http.StatusInternalServerError, errCodeInternalError, "streaming is not supported by this server")
 		return
 	}
 
 	runID := strings.TrimSpace(r.URL.Query().Get("run_id"))
 	if runID == "" {
-		writeError(w, http.StatusBadRequest, "run_id is required")
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, "run_id is required")
+		return
+	}
+
+	summaryOnly, err := parseStreamMode(r.URL.Query().Get("mode"))
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	run, ok := a.loadRun(runID)
 	if !ok {
-		writeError(w, http.StatusNotFound, "run not found")
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "run not found")
 		return
 	}
 
@@ -113,14 +126,81 @@ func (a *App) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cursor := 0
+	deltaCursor := 0
+	turnDeltaAt, turnDeltaCursor := 0, 0
+	slowTurnSeq := 0
 	for {
 		newTurns, adjustedCursor, done, stopped, resp, runErr := run.snapshot(cursor)
 		cursor = adjustedCursor
-		for _, turn := range newTurns {
-			if err := writeSSE(w, flusher, "turn", turn); err != nil {
+		deltas, deltasAt := run.finalDeltasSince(deltaCursor)
+		// writeDeltas sends pending wrap-up fragments once every turn before
+		// the wrap-up has been written. Summary subscribers get no content,
+		// so they skip them like turn events.
+		writeDeltas := func(turnCursor int) error {
+			if len(deltas) == 0 || turnCursor < deltasAt {
+				return nil
+			}
+			for _, delta := range deltas {
+				if err := writeSSE(w, flusher, "final_moderator", streamFinalModeratorEvent{
+					RunID: runID,
+					Delta: delta,
+				}); err != nil {
+					return err
+				}
+				deltaCursor++
+			}
+			deltas = nil
+			return nil
+		}
+		if summaryOnly {
+			if len(newTurns) > 0 {
+				cursor += len(newTurns)
+				last := newTurns[len(newTurns)-1]
+				if err := writeSSE(w, flusher, "progress", streamProgressEvent{
+					RunID:       runID,
+					Turns:       cursor,
+					LastSpeaker: last.SpeakerName,
+				}); err != nil {
+					return
+				}
+			}
+		} else {
+			for _, turn := range newTurns {
+				if err := writeDeltas(cursor); err != nil {
+					return
+				}
+				if err := writeSSE(w, flusher, "turn", turn); err != nil {
+					return
+				}
+				cursor++
+			}
+			if err := writeDeltas(cursor); err != nil {
 				return
 			}
-			cursor++
+			// Fragments of the turn in progress are sent only once every
+			// earlier turn has been, so they never precede their context.
+			turnDeltas, at := run.turnDeltasSince(turnDeltaAt, turnDeltaCursor)
+			if at != turnDeltaAt {
+				turnDeltaAt, turnDeltaCursor = at, 0
+			}
+			if !done && at == cursor {
+				for _, delta := range turnDeltas {
+					if err := writeSSE(w, flusher, "delta", delta); err != nil {
+						return
+					}
+					turnDeltaCursor++
+				}
+			}
+		}
+		// A slow-turn notice is only news while its turn is still pending;
+		// summary subscribers get it too since it carries no turn content.
+		if slowTurn, at, seq := run.slowTurnNotice(); seq != slowTurnSeq {
+			slowTurnSeq = seq
+			if !done && at == cursor {
+				if err := writeSSE(w, flusher, "slow_turn", slowTurn); err != nil {
+					return
+				}
+			}
 		}
 
 		if done {
@@ -147,6 +227,19 @@ func (a *App) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// parseStreamMode reports whether the subscriber asked for mode=summary,
+// which replaces per-turn events with one progress event per update.
+func parseStreamMode(raw string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "full":
+		return false, nil
+	case "summary":
+		return true, nil
+	default:
+		return false, fmt.Errorf("mode must be full or summary, got %q", raw)
+	}
+}
+
 func (a *App) handleDebateStreamStop(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		methodNotAllowed(w, http.MethodPost)
@@ -158,13 +251,13 @@ func (a *App) handleDebateStreamStop(w http.ResponseWriter, r *http.Request) {
 
 	req, err := decodeStreamStopRequest(body)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, err.Error())
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
 		return
 	}
 
 	run, ok := a.loadRun(req.RunID)
 	if !ok {
-		writeError(w, http.StatusNotFound, "run not found")
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "run not found")
 		return
 	}
 
@@ -175,8 +268,131 @@ func (a *App) handleDebateStreamStop(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func (a *App) executeDebateRun(ctx context.Context, runID string, run *debateRun, problem string, personas []persona.Persona, runCfg *orchestrator.Config) {
-	resp, err := a.runAndSaveDebate(ctx, problem, personas, runCfg, run.appendTurn)
+func (a *App) handleDebateStreamMute(w http.ResponseWriter, r *http.Request) {
+	a.handleStreamMuteChange(w, r, true)
+}
+
+func (a *App) handleDebateStreamUnmute(w http.ResponseWriter, r *http.Request) {
+	a.handleStreamMuteChange(w, r, false)
+}
+
+func (a *App) handleStreamMuteChange(w http.ResponseWriter, r *http.Request, mute bool) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxRequestBytes)
+	defer body.Close()
+
+	req, err := decodeStreamMuteRequest(body)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+
+	run, ok := a.loadRun(req.RunID)
+	if !ok {
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "run not found")
+		return
+	}
+
+	switch err := run.setMuted(req.PersonaID, mute); {
+	case errors.Is(err, errRunNotActive):
+		writeErrorCode(w, http.StatusConflict, errCodeRunNotActive, err.Error())
+		return
+	case errors.Is(err, errControlQueueFull):
+		writeErrorCode(w, http.StatusTooManyRequests, errCodeRateLimited, err.Error())
+		return
+	case err != nil:
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+	status := "unmuted"
+	if mute {
+		status = "muted"
+	}
+	writeJSON(w, http.StatusAccepted, streamMuteResponse{
+		RunID:     req.RunID,
+		PersonaID: req.PersonaID,
+		Status:    status,
+	})
+}
+
+func (a *App) handleDebateStreamAsk(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxRequestBytes)
+	defer body.Close()
+
+	req, err := decodeStreamAskRequest(body)
+	if err != nil {
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+
+	run, ok := a.loadRun(req.RunID)
+	if !ok {
+		writeErrorCode(w, http.StatusNotFound, errCodeNotFound, "run not found")
+		return
+	}
+
+	switch err := run.ask(req.Question); {
+	case errors.Is(err, errRunNotActive):
+		writeErrorCode(w, http.StatusConflict, errCodeRunNotActive, err.Error())
+		return
+	case errors.Is(err, errAskQueueFull):
+		writeErrorCode(w, http.StatusTooManyRequests, errCodeRateLimited, err.Error())
+		return
+	case err != nil:
+		writeErrorCode(w, http.StatusBadRequest, errCodeInvalidRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusAccepted, streamAskResponse{
+		RunID:  req.RunID,
+		Status: "queued",
+	})
+}
+
+// attachRunHooks wires run's question and mute queues and the turn and final
+// wrap-up streams into the orchestrator config. Runners without RunWithConfig cannot
+// take any of them, so run.asks and run.controls stay nil and runCfg is
+// returned unchanged.
+func (a *App) attachRunHooks(run *debateRun, personas []persona.Persona, runCfg *orchestrator.Config) *orchestrator.Config {
+	if _, ok := a.runner.(ConfigurableRunner); !ok {
+		return runCfg
+	}
+	cfg, _ := a.runnerDefaults()
+	if runCfg != nil {
+		cfg = *runCfg
+	}
+	run.asks = make(chan string, maxPendingAsks)
+	cfg.Interjections = run.asks
+	run.controls = make(chan orchestrator.SpeakerControl, maxPendingAsks)
+	run.speakers = make(map[string]bool)
+	run.muted = make(map[string]bool)
+	for _, p := range persona.Speakers(personas) {
+		run.speakers[strings.ToLower(strings.TrimSpace(p.ID))] = true
+	}
+	cfg.SpeakerControls = run.controls
+	cfg.OnFinalModeratorDelta = run.appendFinalDelta
+	cfg.OnTurnDelta = run.appendTurnDelta
+	cfg.OnEvent = run.recordEvent
+	return &cfg
+}
+
+func (a *App) executeDebateRun(ctx context.Context, runID string, run *debateRun, problem string, personas []persona.Persona, runCfg *orchestrator.Config, labels runLabels) {
+	resp, err := a.runAndSaveDebate(ctx, debateJob{
+		problem:      problem,
+		personas:     personas,
+		runCfg:       runCfg,
+		labels:       labels,
+		onTurn:       run.appendTurn,
+		liveMarkdown: true,
+	})
 	run.finish(resp, err)
 	time.AfterFunc(runRetention, func() {
 		a.deleteRun(runID)
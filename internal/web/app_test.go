@@ -5,8 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -260,7 +263,7 @@ func TestNextOutputPathIsUniqueUnderConcurrency(t *testing.T) {
 	for i := 0; i < n; i++ {
 		go func() {
 			defer wg.Done()
-			path, err := app.nextOutputPath()
+			path, err := app.nextOutputPath("")
 			out <- result{path: path, err: err}
 		}()
 	}
@@ -449,6 +452,99 @@ func TestDebateEndpointRejectsPersonaPathAndInlinePersonasTogether(t *testing.T)
 	}
 }
 
+func TestDebateEndpointPersonaSourcePrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantLoad   string
+		wantIDs    string
+	}{
+		{
+			name:       "non-empty inline wins",
+			body:       `{"problem":"p","personas":[{"id":"i1","name":"I1","role":"r"},{"id":"i2","name":"I2","role":"r"}]}`,
+			wantStatus: http.StatusOK,
+			wantIDs:    "i1,i2",
+		},
+		{
+			name:       "empty inline with path loads path",
+			body:       `{"problem":"p","personas":[],"persona_path":"./custom.json"}`,
+			wantStatus: http.StatusOK,
+			wantLoad:   "custom.json",
+			wantIDs:    "a,b",
+		},
+		{
+			name:       "no personas and no path loads default",
+			body:       `{"problem":"p"}`,
+			wantStatus: http.StatusOK,
+			wantLoad:   "default-personas.json",
+			wantIDs:    "a,b",
+		},
+		{
+			name:       "null personas loads default",
+			body:       `{"problem":"p","personas":null}`,
+			wantStatus: http.StatusOK,
+			wantLoad:   "default-personas.json",
+			wantIDs:    "a,b",
+		},
+		{
+			name:       "explicit empty inline without path is rejected",
+			body:       `{"problem":"p","personas":[]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := &stubRunner{result: orchestrator.Result{Status: orchestrator.StatusConsensusReached}}
+			loadedPath := ""
+			app := NewApp(Config{
+				PersonaPath: "./default-personas.json",
+				OutputDir:   t.TempDir(),
+				Runner:      runner,
+				Loader: func(path string) ([]persona.Persona, error) {
+					loadedPath = path
+					return []persona.Persona{
+						{ID: "a", Name: "A", Role: "one"},
+						{ID: "b", Name: "B", Role: "two"},
+					}, nil
+				},
+				Now: time.Now,
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(tc.body))
+			rec := httptest.NewRecorder()
+			app.Handler().ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+			}
+			if tc.wantStatus != http.StatusOK {
+				if runner.callCount != 0 || loadedPath != "" {
+					t.Fatalf("expected no load or run, got load=%q calls=%d", loadedPath, runner.callCount)
+				}
+				if !strings.Contains(rec.Body.String(), errCodeInvalidRequest) {
+					t.Fatalf("expected %s error code, body=%s", errCodeInvalidRequest, rec.Body.String())
+				}
+				return
+			}
+			if got := filepath.Base(loadedPath); tc.wantLoad != "" && got != tc.wantLoad {
+				t.Fatalf("expected to load %s, got %q", tc.wantLoad, loadedPath)
+			}
+			if tc.wantLoad == "" && loadedPath != "" {
+				t.Fatalf("expected inline personas without loading, got %q", loadedPath)
+			}
+			ids := make([]string, 0, len(runner.personas))
+			for _, p := range runner.personas {
+				ids = append(ids, p.ID)
+			}
+			if got := strings.Join(ids, ","); got != tc.wantIDs {
+				t.Fatalf("expected personas %s, got %s", tc.wantIDs, got)
+			}
+		})
+	}
+}
+
 func TestDebateStreamStartAndSubscribeStreamsTurnsAndComplete(t *testing.T) {
 	loadedPath := ""
 	loadedPersonas := []persona.Persona{
@@ -533,6 +629,74 @@ func TestDebateStreamStartAndSubscribeStreamsTurnsAndComplete(t *testing.T) {
 	}
 }
 
+func TestDebateStreamSummaryModeSkipsTurnEvents(t *testing.T) {
+	runner := &stubRunner{
+		streamTurns: []orchestrator.Turn{
+			{Index: 1, SpeakerID: "p1", SpeakerName: "Planner", Type: orchestrator.TurnTypePersona, Content: "first"},
+			{Index: 2, SpeakerID: "p2", SpeakerName: "Builder", Type: orchestrator.TurnTypePersona, Content: "second"},
+		},
+		result: orchestrator.Result{Problem: "summary test", Status: orchestrator.StatusMaxTurnsReached},
+	}
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      runner,
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startReq := httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"summary test"}`))
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, startReq)
+	if startRec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected start status: %d body=%s", startRec.Code, startRec.Body.String())
+	}
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/debate/stream?mode=summary&run_id="+started.RunID, nil)
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+
+	body := rec.Body.String()
+	if strings.Contains(body, "event: turn") {
+		t.Fatalf("expected no turn events in summary mode: %s", body)
+	}
+	if !strings.Contains(body, "event: start") || !strings.Contains(body, "event: complete") {
+		t.Fatalf("expected start and complete events: %s", body)
+	}
+	if !strings.Contains(body, "event: progress") || !strings.Contains(body, `"turns":2`) {
+		t.Fatalf("expected progress event covering both turns: %s", body)
+	}
+}
+
+func TestDebateStreamRejectsUnknownMode(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      &stubRunner{},
+		Now:         time.Now,
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/debate/stream?mode=verbose&run_id=missing", nil)
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodeInvalidRequest {
+		t.Fatalf("expected code %q, got %+v", errCodeInvalidRequest, got)
+	}
+}
+
 func TestDebateStreamStartEndpointValidatesProblem(t *testing.T) {
 	app := NewApp(Config{
 		PersonaPath: "./personas.json",
@@ -747,6 +911,9 @@ func TestDebateStreamStartRejectsInvalidRuntimeTuning(t *testing.T) {
 	if !strings.Contains(rec.Body.String(), "consensus_threshold") {
 		t.Fatalf("unexpected error body: %s", rec.Body.String())
 	}
+	if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodeInvalidRequest {
+		t.Fatalf("expected code %q, got %+v", errCodeInvalidRequest, got)
+	}
 }
 
 func TestDebateStreamSubscribeRequiresRunID(t *testing.T) {
@@ -1014,6 +1181,130 @@ func TestPersonasEndpointRejectsPathTraversal(t *testing.T) {
 	if loaderCalled {
 		t.Fatal("loader must not be called for invalid path")
 	}
+	if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodePathTraversal {
+		t.Fatalf("expected code %q, got %+v", errCodePathTraversal, got)
+	}
+}
+
+func TestPersonasEndpointChecksEachPathInList(t *testing.T) {
+	baseDir := t.TempDir()
+	var loaderPaths []string
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		BaseDir:     baseDir,
+		OutputDir:   t.TempDir(),
+		Runner:      &stubRunner{},
+		Loader: func(path string) ([]persona.Persona, error) {
+			loaderPaths = append(loaderPaths, path)
+			return []persona.Persona{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}}, nil
+		},
+		Now: time.Now,
+	})
+
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/personas?path="+url.QueryEscape("team-a.json, ../secrets.json"), nil))
+	if rec.Code != http.StatusBadRequest || decodeAPIError(t, rec.Body.Bytes()).Code != errCodePathTraversal {
+		t.Fatalf("expected traversal rejection for any listed path, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if len(loaderPaths) != 0 {
+		t.Fatalf("loader must not be called, got %v", loaderPaths)
+	}
+
+	rec = httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/personas?path="+url.QueryEscape("team-a.json,teams/b.json"), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp personasResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Path != "./team-a.json,./teams/b.json" {
+		t.Fatalf("unexpected display path: %q", resp.Path)
+	}
+	want := filepath.Join(baseDir, "team-a.json") + "," + filepath.Join(baseDir, "teams", "b.json")
+	if len(loaderPaths) != 1 || loaderPaths[0] != want {
+		t.Fatalf("expected one loader call with resolved list %q, got %v", want, loaderPaths)
+	}
+}
+
+func decodeAPIError(t *testing.T, body []byte) apiError {
+	t.Helper()
+	var resp errorResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("decode error body: %v body=%s", err, body)
+	}
+	if resp.Error.Message == "" {
+		t.Fatalf("expected error message, body=%s", body)
+	}
+	return resp.Error
+}
+
+func TestPersonasEndpointWarnsAboveSoftLimit(t *testing.T) {
+	personas := []persona.Persona{
+		{ID: "p1", Name: "Planner", Role: "plan"},
+		{ID: "p2", Name: "Builder", Role: "build"},
+		{ID: "p3", Name: "Tester", Role: "test"},
+	}
+	for _, tc := range []struct {
+		warnAt      int
+		wantWarning string
+	}{
+		{warnAt: 2, wantWarning: "3 personas may be slow/expensive"},
+		{warnAt: 3, wantWarning: ""},
+	} {
+		app := NewApp(Config{
+			PersonaPath:   "./personas.json",
+			OutputDir:     t.TempDir(),
+			Runner:        &stubRunner{},
+			Loader:        func(string) ([]persona.Persona, error) { return personas, nil },
+			Now:           time.Now,
+			PersonaWarnAt: tc.warnAt,
+		})
+
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/personas", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+		}
+		var resp personasResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Warning != tc.wantWarning {
+			t.Fatalf("warnAt=%d: expected warning %q, got %q", tc.warnAt, tc.wantWarning, resp.Warning)
+		}
+	}
+}
+
+func TestDebateEndpointsRejectPersonasAboveHardLimit(t *testing.T) {
+	runner := &stubRunner{}
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      runner,
+		Now:         time.Now,
+		MaxPersonas: 2,
+	})
+
+	body := `{"problem":"too many","personas":[
+		{"id":"p1","name":"Planner","role":"plan"},
+		{"id":"p2","name":"Builder","role":"build"},
+		{"id":"p3","name":"Tester","role":"test"}
+	]}`
+	for _, path := range []string{"/api/debate", "/api/debate/stream/start"} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body)))
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: unexpected status: %d body=%s", path, rec.Code, rec.Body.String())
+		}
+		if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodeInvalidRequest || !strings.Contains(got.Message, "at most 2") {
+			t.Fatalf("%s: unexpected error: %+v", path, got)
+		}
+	}
+	if runner.callCount != 0 {
+		t.Fatalf("runner must not be called, got %d calls", runner.callCount)
+	}
 }
 
 func TestPersonasEndpointRejectsSymlinkEscape(t *testing.T) {
@@ -1078,3 +1369,810 @@ func TestPersonasEndpointRejectsNonJSONPath(t *testing.T) {
 		t.Fatal("loader must not be called for invalid extension")
 	}
 }
+
+func TestPersonasEndpointAcceptsYAMLPath(t *testing.T) {
+	baseDir := t.TempDir()
+	var loaderPaths []string
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		BaseDir:     baseDir,
+		OutputDir:   t.TempDir(),
+		Runner:      &stubRunner{},
+		Loader: func(path string) ([]persona.Persona, error) {
+			loaderPaths = append(loaderPaths, path)
+			return []persona.Persona{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}}, nil
+		},
+		Now: time.Now,
+	})
+
+	for _, path := range []string{"./team.yaml", "./team.YML"} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/personas?path="+url.QueryEscape(path), nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: unexpected status: %d body=%s", path, rec.Code, rec.Body.String())
+		}
+	}
+	if len(loaderPaths) != 2 {
+		t.Fatalf("expected loader calls for both YAML paths, got %v", loaderPaths)
+	}
+}
+
+func TestRecommendEndpointReturnsPanel(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		BaseDir:     t.TempDir(),
+		OutputDir:   t.TempDir(),
+		Runner:      &stubRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "pm", Name: "PM", Role: "product"},
+				{ID: "db", Name: "DB", Role: "database", Expertise: []string{"postgres", "index"}},
+				{ID: "sec", Name: "Sec", Role: "security"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	rec := httptest.NewRecorder()
+	target := "/api/recommend?size=2&problem=" + url.QueryEscape("postgres index bloat")
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp recommendResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Size != 2 || len(resp.Personas) != 2 || resp.Personas[0].ID != "db" {
+		t.Fatalf("unexpected panel: %+v", resp)
+	}
+
+	for _, target := range []string{
+		"/api/recommend?size=2",
+		"/api/recommend?size=x&problem=p",
+		"/api/recommend?size=9&problem=p",
+	} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), errCodeInvalidRequest) {
+			t.Fatalf("%s: expected invalid_request, got %d body=%s", target, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestDebateEndpointPinsOpeningSpeaker(t *testing.T) {
+	runner := &configurableRunner{result: orchestrator.Result{Status: orchestrator.StatusConsensusReached}}
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      runner,
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+				{ID: "watch", Name: "Watcher", Role: "audit", Observer: true},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(`{"problem":"pin test","opening_speaker_id":" P2 "}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	if runner.runWithConfigCall != 1 || runner.lastConfig.OpeningSpeakerID != "P2" {
+		t.Fatalf("expected pinned opener in run config, calls=%d cfg=%+v", runner.runWithConfigCall, runner.lastConfig)
+	}
+
+	for _, id := range []string{"ghost", "watch"} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"pin test","opening_speaker_id":"`+id+`"}`)))
+		if rec.Code != http.StatusBadRequest || decodeAPIError(t, rec.Body.Bytes()).Code != errCodeInvalidRequest {
+			t.Fatalf("%s: expected invalid_request, got %d body=%s", id, rec.Code, rec.Body.String())
+		}
+	}
+	if runner.runWithConfigCall != 1 {
+		t.Fatalf("expected rejected pins not to start a run, calls=%d", runner.runWithConfigCall)
+	}
+}
+
+// askingRunner waits for one injected question and echoes it as a moderator
+// turn, standing in for the orchestrator's interjection handling.
+type askingRunner struct{}
+
+func (askingRunner) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	return askingRunner{}.RunWithConfig(ctx, problem, personas, orchestrator.Config{}, onTurn)
+}
+
+func (askingRunner) RunWithConfig(ctx context.Context, problem string, _ []persona.Persona, cfg orchestrator.Config, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	select {
+	case <-ctx.Done():
+		return orchestrator.Result{}, ctx.Err()
+	case question := <-cfg.Interjections:
+		onTurn(orchestrator.Turn{Index: 1, SpeakerID: orchestrator.ModeratorSpeakerID, Type: orchestrator.TurnTypeModerator, Content: question, Injected: true})
+	}
+	return orchestrator.Result{Problem: problem, Status: orchestrator.StatusMaxTurnsReached}, nil
+}
+
+func TestDebateStreamAskInjectsModeratorQuestion(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      askingRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startReq := httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"ask test"}`))
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, startReq)
+	if startRec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected start status: %d body=%s", startRec.Code, startRec.Body.String())
+	}
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+
+	ask := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/debate/stream/ask", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := ask(`{"run_id":"` + started.RunID + `","question":"  "}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected blank question to be rejected, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := ask(`{"run_id":"missing","question":"why?"}`); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected unknown run to be 404, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := ask(`{"run_id":"` + started.RunID + `","question":"What does rollback cost?"}`); rec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected ask status: %d body=%s", rec.Code, rec.Body.String())
+	}
+
+	streamReq := httptest.NewRequest(http.MethodGet, "/api/debate/stream?run_id="+started.RunID, nil)
+	streamRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(streamRec, streamReq)
+	body := streamRec.Body.String()
+	if !strings.Contains(body, "event: turn") || !strings.Contains(body, "What does rollback cost?") || !strings.Contains(body, `"injected":true`) {
+		t.Fatalf("expected injected moderator turn in stream: %s", body)
+	}
+	if !strings.Contains(body, "event: complete") {
+		t.Fatalf("expected run to complete: %s", body)
+	}
+
+	rec := ask(`{"run_id":"` + started.RunID + `","question":"One more?"}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("expected finished run to reject asks, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodeRunNotActive {
+		t.Fatalf("expected code %q, got %+v", errCodeRunNotActive, got)
+	}
+}
+
+func TestDebateStreamAskRequiresConfigurableRunner(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      &stoppableRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startReq := httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"ask test"}`))
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, startReq)
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+	defer func() {
+		run, ok := app.loadRun(started.RunID)
+		if !ok {
+			return
+		}
+		run.stop()
+		// Wait for the run to discard its live transcript before TempDir
+		// cleanup removes the output dir.
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			if _, _, done, _, _, _ := run.snapshot(0); done {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/debate/stream/ask", bytes.NewBufferString(`{"run_id":"`+started.RunID+`","question":"why?"}`))
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	if got := decodeAPIError(t, rec.Body.Bytes()); got.Code != errCodeInvalidRequest {
+		t.Fatalf("expected code %q, got %+v", errCodeInvalidRequest, got)
+	}
+}
+
+// streamingFinalRunner emits one persona turn, streams a two-part final
+// wrap-up through cfg.OnFinalModeratorDelta, then records the full wrap-up.
+type streamingFinalRunner struct{}
+
+func (streamingFinalRunner) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	return streamingFinalRunner{}.RunWithConfig(ctx, problem, personas, orchestrator.Config{}, onTurn)
+}
+
+func (streamingFinalRunner) RunWithConfig(_ context.Context, problem string, _ []persona.Persona, cfg orchestrator.Config, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	onTurn(orchestrator.Turn{Index: 1, SpeakerID: "p1", SpeakerName: "Planner", Type: orchestrator.TurnTypePersona, Content: "opening"})
+	if cfg.OnFinalModeratorDelta == nil {
+		return orchestrator.Result{}, errors.New("missing final moderator delta callback")
+	}
+	cfg.OnFinalModeratorDelta("wrap-")
+	cfg.OnFinalModeratorDelta("up")
+	onTurn(orchestrator.Turn{Index: 2, SpeakerID: orchestrator.ModeratorSpeakerID, Type: orchestrator.TurnTypeModerator, Content: "wrap-up"})
+	return orchestrator.Result{Problem: problem, Status: orchestrator.StatusMaxTurnsReached}, nil
+}
+
+func TestDebateStreamSendsFinalModeratorDeltasBeforeComplete(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      streamingFinalRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startReq := httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"wrap-up test"}`))
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, startReq)
+	if startRec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected start status: %d body=%s", startRec.Code, startRec.Body.String())
+	}
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+
+	streamReq := httptest.NewRequest(http.MethodGet, "/api/debate/stream?run_id="+started.RunID, nil)
+	streamRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(streamRec, streamReq)
+	body := streamRec.Body.String()
+
+	opening := strings.Index(body, `"content":"opening"`)
+	first := strings.Index(body, `"delta":"wrap-"`)
+	second := strings.Index(body, `"delta":"up"`)
+	finalTurn := strings.Index(body, `"content":"wrap-up"`)
+	complete := strings.Index(body, "event: complete")
+	if opening < 0 || first < 0 || second < 0 || finalTurn < 0 || complete < 0 {
+		t.Fatalf("missing events in stream: %s", body)
+	}
+	if !(opening < first && first < second && second < finalTurn && finalTurn < complete) {
+		t.Fatalf("expected opening turn, deltas, final turn, then complete: %s", body)
+	}
+	if strings.Count(body, "event: final_moderator") != 2 {
+		t.Fatalf("expected two final_moderator events: %s", body)
+	}
+}
+
+// streamingTurnRunner streams the second persona turn through
+// cfg.OnTurnDelta and holds it back until released, so a subscriber can
+// see the fragments before the completed turn replaces them.
+type streamingTurnRunner struct {
+	release <-chan struct{}
+}
+
+func (r streamingTurnRunner) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	return r.RunWithConfig(ctx, problem, personas, orchestrator.Config{}, onTurn)
+}
+
+func (r streamingTurnRunner) RunWithConfig(_ context.Context, problem string, _ []persona.Persona, cfg orchestrator.Config, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	onTurn(orchestrator.Turn{Index: 1, SpeakerID: "p1", SpeakerName: "Planner", Type: orchestrator.TurnTypePersona, Content: "opening"})
+	if cfg.OnTurnDelta == nil {
+		return orchestrator.Result{}, errors.New("missing turn delta callback")
+	}
+	cfg.OnTurnDelta("p2", "par")
+	cfg.OnTurnDelta("p2", "tial")
+	select {
+	case <-r.release:
+	case <-time.After(2 * time.Second):
+	}
+	onTurn(orchestrator.Turn{Index: 2, SpeakerID: "p2", SpeakerName: "Builder", Type: orchestrator.TurnTypePersona, Content: "partial"})
+	return orchestrator.Result{Problem: problem, Status: orchestrator.StatusMaxTurnsReached}, nil
+}
+
+// releasingRecorder closes release once the stream has flushed want.
+type releasingRecorder struct {
+	*httptest.ResponseRecorder
+	want    string
+	release chan struct{}
+	once    sync.Once
+}
+
+func (r *releasingRecorder) Flush() {
+	r.ResponseRecorder.Flush()
+	if strings.Contains(r.Body.String(), r.want) {
+		r.once.Do(func() { close(r.release) })
+	}
+}
+
+func TestDebateStreamSendsTurnDeltasBeforeTurn(t *testing.T) {
+	release := make(chan struct{})
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      streamingTurnRunner{release: release},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"delta test"}`)))
+	if startRec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected start status: %d body=%s", startRec.Code, startRec.Body.String())
+	}
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+
+	streamRec := &releasingRecorder{ResponseRecorder: httptest.NewRecorder(), want: `"delta":"tial"`, release: release}
+	app.Handler().ServeHTTP(streamRec, httptest.NewRequest(http.MethodGet, "/api/debate/stream?run_id="+started.RunID, nil))
+	body := streamRec.Body.String()
+
+	opening := strings.Index(body, `"content":"opening"`)
+	first := strings.Index(body, `{"run_id":"`+started.RunID+`","speaker_id":"p2","delta":"par"}`)
+	second := strings.Index(body, `"delta":"tial"`)
+	turn := strings.Index(body, `"content":"partial"`)
+	complete := strings.Index(body, "event: complete")
+	if opening < 0 || first < 0 || second < 0 || turn < 0 || complete < 0 {
+		t.Fatalf("missing events in stream: %s", body)
+	}
+	if !(opening < first && first < second && second < turn && turn < complete) {
+		t.Fatalf("expected opening turn, deltas, completed turn, then complete: %s", body)
+	}
+	if strings.Count(body, "event: delta") != 2 {
+		t.Fatalf("expected two delta events: %s", body)
+	}
+}
+
+// slowTurnRunner reports a slow second turn through cfg.OnEvent and holds
+// the turn back until released.
+type slowTurnRunner struct {
+	release <-chan struct{}
+}
+
+func (r slowTurnRunner) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	return r.RunWithConfig(ctx, problem, personas, orchestrator.Config{}, onTurn)
+}
+
+func (r slowTurnRunner) RunWithConfig(_ context.Context, problem string, _ []persona.Persona, cfg orchestrator.Config, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	onTurn(orchestrator.Turn{Index: 1, SpeakerID: "p1", SpeakerName: "Planner", Type: orchestrator.TurnTypePersona, Content: "opening"})
+	if cfg.OnEvent == nil {
+		return orchestrator.Result{}, errors.New("missing event callback")
+	}
+	cfg.OnEvent(orchestrator.Event{Type: orchestrator.EventOpeningSpeakerSelected, SpeakerID: "p1"})
+	cfg.OnEvent(orchestrator.Event{Type: orchestrator.EventSlowTurn, SpeakerID: "p2", Detail: "Builder is taking longer than usual"})
+	select {
+	case <-r.release:
+	case <-time.After(2 * time.Second):
+	}
+	onTurn(orchestrator.Turn{Index: 2, SpeakerID: "p2", SpeakerName: "Builder", Type: orchestrator.TurnTypePersona, Content: "late"})
+	return orchestrator.Result{Problem: problem, Status: orchestrator.StatusMaxTurnsReached}, nil
+}
+
+func TestDebateStreamSendsSlowTurnBeforeTurn(t *testing.T) {
+	release := make(chan struct{})
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      slowTurnRunner{release: release},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"slow test"}`)))
+	if startRec.Code != http.StatusAccepted {
+		t.Fatalf("unexpected start status: %d body=%s", startRec.Code, startRec.Body.String())
+	}
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+
+	streamRec := &releasingRecorder{ResponseRecorder: httptest.NewRecorder(), want: "event: slow_turn", release: release}
+	app.Handler().ServeHTTP(streamRec, httptest.NewRequest(http.MethodGet, "/api/debate/stream?run_id="+started.RunID, nil))
+	body := streamRec.Body.String()
+
+	slow := strings.Index(body, `{"run_id":"`+started.RunID+`","speaker_id":"p2","detail":"Builder is taking longer than usual"}`)
+	turn := strings.Index(body, `"content":"late"`)
+	if slow < 0 || turn < 0 || slow > turn {
+		t.Fatalf("expected slow_turn before the late turn: %s", body)
+	}
+	if strings.Count(body, "event: slow_turn") != 1 || strings.Contains(body, "opening_speaker_selected") {
+		t.Fatalf("expected exactly one slow_turn event and no other orchestrator events: %s", body)
+	}
+}
+
+func TestDebateEndpointSavesToFallbackWhenOutputDirUnwritable(t *testing.T) {
+	// A regular file in the output dir's path makes every write fail, even
+	// when the tests run as root.
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	if err := os.WriteFile(blocker, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write blocker: %v", err)
+	}
+	fallbackDir := t.TempDir()
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	newApp := func(fallback string) *App {
+		return NewApp(Config{
+			PersonaPath:     "./personas.json",
+			OutputDir:       filepath.Join(blocker, "outputs"),
+			SaveFallbackDir: fallback,
+			Runner:          &stubRunner{result: orchestrator.Result{Status: orchestrator.StatusConsensusReached}},
+			Loader: func(string) ([]persona.Persona, error) {
+				return []persona.Persona{
+					{ID: "p1", Name: "Planner", Role: "plan"},
+					{ID: "p2", Name: "Builder", Role: "build"},
+				}, nil
+			},
+			Now: time.Now,
+		})
+	}
+	post := func(app *App) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(`{"problem":"fallback test"}`))
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post(newApp(fallbackDir))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp debateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !strings.HasPrefix(resp.SavedJSONPath, fallbackDir) || !strings.Contains(resp.SaveWarning, resp.SavedJSONPath) {
+		t.Fatalf("expected a fallback save with a warning, got path=%q warning=%q", resp.SavedJSONPath, resp.SaveWarning)
+	}
+	if _, err := os.Stat(resp.SavedJSONPath); err != nil {
+		t.Fatalf("expected fallback result file: %v", err)
+	}
+	if !strings.Contains(logs.String(), "output save fallback") {
+		t.Fatalf("expected a logged warning, got %q", logs.String())
+	}
+
+	if rec := post(newApp("")); rec.Code != http.StatusInternalServerError || !strings.Contains(rec.Body.String(), errCodeSaveFailed) {
+		t.Fatalf("expected save_failed without a fallback dir, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestConfigEndpointUpdatesDefaultsForNewRuns(t *testing.T) {
+	runner := &configurableRunner{result: orchestrator.Result{Status: orchestrator.StatusMaxTurnsReached}}
+	app := NewApp(Config{
+		PersonaPath:    "./personas.json",
+		OutputDir:      t.TempDir(),
+		Runner:         runner,
+		RunnerDefaults: orchestrator.Config{MaxTurns: 6, ConsensusThreshold: 0.8},
+		AdminToken:     "s3cret",
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+	put := func(token string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/config", bytes.NewBufferString(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := put("", `{"max_turns":3}`); rec.Code != http.StatusUnauthorized || !strings.Contains(rec.Body.String(), errCodeUnauthorized) {
+		t.Fatalf("expected 401 without a token, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := put("wrong", `{"max_turns":3}`); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with a wrong token, got %d", rec.Code)
+	}
+	if rec := put("s3cret", `{"max_turns":-1}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid max_turns, got %d", rec.Code)
+	}
+	if got, _ := app.runnerDefaults(); got.MaxTurns != 6 {
+		t.Fatalf("rejected updates must not change defaults, got max turns %d", got.MaxTurns)
+	}
+
+	rec := put("s3cret", `{"max_turns":3}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp configResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Config.MaxTurns != 3 || resp.Config.ConsensusThreshold != 0.8 {
+		t.Fatalf("expected max turns 3 with the threshold kept, got %+v", resp.Config)
+	}
+	if resp.Config.MaxNoProgressJudges == 0 {
+		t.Fatalf("expected normalized defaults in the effective config, got %+v", resp.Config)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(`{"problem":"config test"}`))
+	debateRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(debateRec, req)
+	if debateRec.Code != http.StatusOK {
+		t.Fatalf("unexpected debate status: %d body=%s", debateRec.Code, debateRec.Body.String())
+	}
+	if runner.runWithConfigCall != 1 || runner.callCount != 0 {
+		t.Fatalf("expected the run to use RunWithConfig, got run=%d runWithConfig=%d", runner.callCount, runner.runWithConfigCall)
+	}
+	if runner.lastConfig.MaxTurns != 3 {
+		t.Fatalf("expected the new max turns, got %d", runner.lastConfig.MaxTurns)
+	}
+}
+
+func TestConfigEndpointDisabledWithoutAdminToken(t *testing.T) {
+	app := NewApp(Config{Runner: &configurableRunner{}})
+
+	req := httptest.NewRequest(http.MethodPut, "/api/config", bytes.NewBufferString(`{"max_turns":3}`))
+	req.Header.Set("Authorization", "Bearer ")
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 when no admin token is configured, got %d", rec.Code)
+	}
+}
+
+// mutingRunner records the first speaker control as a moderator note and
+// then runs until stopped, so later mute requests still find it active.
+type mutingRunner struct{}
+
+func (mutingRunner) Run(ctx context.Context, problem string, personas []persona.Persona, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	return mutingRunner{}.RunWithConfig(ctx, problem, personas, orchestrator.Config{}, onTurn)
+}
+
+func (mutingRunner) RunWithConfig(ctx context.Context, _ string, _ []persona.Persona, cfg orchestrator.Config, onTurn func(orchestrator.Turn)) (orchestrator.Result, error) {
+	select {
+	case <-ctx.Done():
+		return orchestrator.Result{}, ctx.Err()
+	case control := <-cfg.SpeakerControls:
+		onTurn(orchestrator.Turn{Index: 1, SpeakerID: orchestrator.ModeratorSpeakerID, Type: orchestrator.TurnTypeModerator, Content: fmt.Sprintf("%s muted=%t", control.PersonaID, control.Mute), Phase: orchestrator.TurnPhaseControl})
+	}
+	<-ctx.Done()
+	return orchestrator.Result{}, ctx.Err()
+}
+
+func TestDebateStreamMuteQueuesSpeakerControl(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      mutingRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+				{ID: "p3", Name: "Tester", Role: "test"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	startReq := httptest.NewRequest(http.MethodPost, "/api/debate/stream/start", bytes.NewBufferString(`{"problem":"mute test"}`))
+	startRec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(startRec, startReq)
+	var started streamStartResponse
+	if err := json.Unmarshal(startRec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode start response: %v", err)
+	}
+	run, ok := app.loadRun(started.RunID)
+	if !ok {
+		t.Fatalf("run %q not found", started.RunID)
+	}
+	defer func() {
+		run.stop()
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			if _, _, done, _, _, _ := run.snapshot(0); done {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}()
+
+	post := func(path string, personaID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(`{"run_id":"`+started.RunID+`","persona_id":"`+personaID+`"}`))
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := post("/api/debate/stream/mute", "nobody"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected unknown persona to be rejected, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := post("/api/debate/stream/mute", "P3"); rec.Code != http.StatusAccepted || !strings.Contains(rec.Body.String(), `"status":"muted"`) {
+		t.Fatalf("unexpected mute response: %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := post("/api/debate/stream/mute", "p2"); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "at least two personas") {
+		t.Fatalf("expected muting below two active personas to be rejected, got %d body=%s", rec.Code, rec.Body.String())
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		turns, _, _, _, _, _ := run.snapshot(0)
+		if len(turns) == 1 {
+			if turns[0].Content != "p3 muted=true" {
+				t.Fatalf("unexpected control turn: %+v", turns[0])
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the runner to receive the mute control")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if rec := post("/api/debate/stream/unmute", "p3"); rec.Code != http.StatusAccepted || !strings.Contains(rec.Body.String(), `"status":"unmuted"`) {
+		t.Fatalf("unexpected unmute response: %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestDebateEndpointAppliesModeratorName(t *testing.T) {
+	runner := &configurableRunner{result: orchestrator.Result{Status: orchestrator.StatusConsensusReached}}
+	app := NewApp(Config{
+		PersonaPath:    "./personas.json",
+		OutputDir:      t.TempDir(),
+		Runner:         runner,
+		RunnerDefaults: orchestrator.Config{ModeratorName: "Host"},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "p1", Name: "Planner", Role: "plan"},
+				{ID: "p2", Name: "Builder", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	for _, tc := range []struct {
+		body string
+		want string
+	}{
+		{body: `{"problem":"name test","moderator_name":" Moderator "}`, want: "Moderator"},
+		{body: `{"problem":"name test","moderator_name":""}`, want: orchestrator.ModeratorSpeakerName},
+	} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(tc.body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+		}
+		if runner.lastConfig.ModeratorName != tc.want {
+			t.Fatalf("%s: expected moderator name %q, got %q", tc.body, tc.want, runner.lastConfig.ModeratorName)
+		}
+	}
+}
+
+func TestPersonasEndpointReturnsRosterWarnings(t *testing.T) {
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		OutputDir:   t.TempDir(),
+		Runner:      &stubRunner{},
+		Loader: func(string) ([]persona.Persona, error) {
+			return []persona.Persona{
+				{ID: "a", Name: "Same", Role: "plan"},
+				{ID: "b", Name: "Same", Role: "build"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	rec := httptest.NewRecorder()
+	app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/personas", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d body=%s", rec.Code, rec.Body.String())
+	}
+	var resp personasResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(resp.Warnings) != 1 || resp.Warnings[0].Code != persona.WarningDuplicateName || resp.Warnings[0].PersonaID != "b" {
+		t.Fatalf("unexpected warnings: %+v", resp.Warnings)
+	}
+}
+
+func TestServerPersonasServeDefaultAndExplicitPaths(t *testing.T) {
+	runner := &stubRunner{
+		result: orchestrator.Result{Status: orchestrator.StatusConsensusReached},
+	}
+	var loadedPaths []string
+	app := NewApp(Config{
+		PersonaPath: "./personas.json",
+		Personas: []persona.Persona{
+			{ID: "i1", Name: "Inline One", Role: "one"},
+			{ID: "i2", Name: "Inline Two", Role: "two"},
+		},
+		OutputDir: t.TempDir(),
+		Runner:    runner,
+		Loader: func(path string) ([]persona.Persona, error) {
+			loadedPaths = append(loadedPaths, path)
+			return []persona.Persona{
+				{ID: "f1", Name: "File One", Role: "one"},
+				{ID: "f2", Name: "File Two", Role: "two"},
+			}, nil
+		},
+		Now: time.Now,
+	})
+
+	getPersonas := func(target string) personasResponse {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: unexpected status: %d body=%s", target, rec.Code, rec.Body.String())
+		}
+		var resp personasResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return resp
+	}
+
+	if resp := getPersonas("/api/personas"); resp.Path != "inline" || resp.Personas[0].ID != "i1" {
+		t.Fatalf("expected the inline roster by default, got %+v", resp)
+	}
+	if len(loadedPaths) != 0 {
+		t.Fatalf("expected no file loads for the inline roster, got %v", loadedPaths)
+	}
+	if resp := getPersonas("/api/personas?path=other.json"); resp.Path != "./other.json" || resp.Personas[0].ID != "f1" {
+		t.Fatalf("expected the explicit path to load from disk, got %+v", resp)
+	}
+
+	for body, wantID := range map[string]string{
+		`{"problem":"p","persona_path":"inline"}`:     "i1",
+		`{"problem":"p"}`:                             "i1",
+		`{"problem":"p","persona_path":"other.json"}`: "f1",
+	} {
+		rec := httptest.NewRecorder()
+		app.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/debate", bytes.NewBufferString(body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: unexpected status: %d body=%s", body, rec.Code, rec.Body.String())
+		}
+		if len(runner.personas) == 0 || runner.personas[0].ID != wantID {
+			t.Fatalf("%s: expected roster starting with %s, got %+v", body, wantID, runner.personas)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package web
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+const (
+	maxProjectRunes = 64
+	maxTags         = 16
+	maxTagRunes     = 48
+)
+
+// runLabels groups saved debates; the project also selects the output subdir.
+type runLabels struct {
+	project string
+	tags    []string
+}
+
+func (r *debateRequest) normalizeLabels() error {
+	r.Project = strings.TrimSpace(r.Project)
+	if err := validateProjectName(r.Project); err != nil {
+		return err
+	}
+
+	if len(r.Tags) > maxTags {
+		return errors.New("tags must contain at most 16 entries")
+	}
+	tags := make([]string, 0, len(r.Tags))
+	seen := make(map[string]struct{}, len(r.Tags))
+	for _, tag := range r.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if len([]rune(tag)) > maxTagRunes {
+			return errors.New("tags must be at most 48 characters each")
+		}
+		key := strings.ToLower(tag)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		tags = append(tags, tag)
+	}
+	if len(tags) == 0 {
+		tags = nil
+	}
+	r.Tags = tags
+	return nil
+}
+
+func (r debateRequest) labels() runLabels {
+	return runLabels{project: r.Project, tags: r.Tags}
+}
+
+// validateProjectName keeps project names safe to use as a single path segment.
+func validateProjectName(project string) error {
+	if project == "" {
+		return nil
+	}
+	if len([]rune(project)) > maxProjectRunes {
+		return errors.New("project must be at most 64 characters")
+	}
+	if project == "." || project == ".." || strings.HasPrefix(project, ".") {
+		return errors.New("project must not start with '.'")
+	}
+	for _, r := range project {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' {
+			continue
+		}
+		return errors.New("project may contain only letters, digits, '-', '_' and '.'")
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"debate/internal/orchestrator"
+	"debate/internal/persona"
 )
 
 const maxDurationSeconds = int64(1<<63-1) / int64(time.Second)
@@ -36,6 +37,12 @@ func (r *debateRequest) validateRuntimeTuning() error {
 	if err := validateMinFloat("no_progress_epsilon", r.NoProgressEpsilon, 0); err != nil {
 		return err
 	}
+	if err := validateRangeFloat("focus_bias", r.FocusBias, 0, 1); err != nil {
+		return err
+	}
+	if err := validateMinInt("irreconcilable_after_judges", r.IrreconcilableAfterJudges, 0); err != nil {
+		return err
+	}
 	if err := validateMinInt("unlimited_hard_max_turns", r.UnlimitedHardMaxTurns, 1); err != nil {
 		return err
 	}
@@ -57,12 +64,35 @@ func (r *debateRequest) validateRuntimeTuning() error {
 	return nil
 }
 
+// validateOpeningSpeaker checks opening_speaker_id against the speaking
+// personas loaded for the request. An empty ID leaves the opener unpinned.
+func (r debateRequest) validateOpeningSpeaker(personas []persona.Persona) error {
+	if r.OpeningSpeakerID == nil {
+		return nil
+	}
+	id := strings.TrimSpace(*r.OpeningSpeakerID)
+	if id == "" {
+		return nil
+	}
+	for _, p := range persona.Speakers(personas) {
+		if strings.EqualFold(strings.TrimSpace(p.ID), id) {
+			return nil
+		}
+	}
+	return fmt.Errorf("opening_speaker_id %q does not match a speaking persona", id)
+}
+
 func (r debateRequest) hasRunnerTuning() bool {
 	return r.AudienceMode != nil ||
 		r.MaxTurns != nil ||
 		r.ConsensusThreshold != nil ||
 		r.MaxNoProgressJudges != nil ||
 		r.NoProgressEpsilon != nil ||
+		r.FocusPersonaID != nil ||
+		r.FocusBias != nil ||
+		r.OpeningSpeakerID != nil ||
+		r.ModeratorName != nil ||
+		r.IrreconcilableAfterJudges != nil ||
 		r.UnlimitedHardMaxTurns != nil ||
 		r.DirectHandoffJudgeEvery != nil ||
 		r.LLMHistoryTurnWindow != nil ||
@@ -98,6 +128,25 @@ func (r debateRequest) applyRunnerTuning(base orchestrator.Config) orchestrator.
 	if r.NoProgressEpsilon != nil {
 		cfg.NoProgressEpsilon = *r.NoProgressEpsilon
 	}
+	if r.FocusPersonaID != nil {
+		cfg.FocusPersonaID = strings.TrimSpace(*r.FocusPersonaID)
+	}
+	if r.FocusBias != nil {
+		cfg.FocusBias = *r.FocusBias
+	}
+	if r.OpeningSpeakerID != nil {
+		cfg.OpeningSpeakerID = strings.TrimSpace(*r.OpeningSpeakerID)
+	}
+	if r.ModeratorName != nil {
+		// Empty falls back to the built-in name rather than the server's.
+		cfg.ModeratorName = strings.TrimSpace(*r.ModeratorName)
+		if cfg.ModeratorName == "" {
+			cfg.ModeratorName = orchestrator.ModeratorSpeakerName
+		}
+	}
+	if r.IrreconcilableAfterJudges != nil {
+		cfg.IrreconcilableAfterJudges = *r.IrreconcilableAfterJudges
+	}
 	if r.UnlimitedHardMaxTurns != nil {
 		cfg.UnlimitedHardMaxTurns = *r.UnlimitedHardMaxTurns
 	}
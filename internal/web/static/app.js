@@ -45,12 +45,17 @@
     let personaTurnCount = 0;
     let nonPersonaTurnCount = 0;
     let debatePersonaCount = 0;
+    // speakerColors maps speaker ids to the colors assigned at debate start.
+    let speakerColors = {};
     let activeSpeakerLabel = "-";
     let runStartedAtMs = 0;
     let elapsedTimerID = null;
     let stopRequested = false;
     let latestPersonaLoadSeq = 0;
     const maxRenderedTurnCards = 320;
+    // lastResultStorageKey keeps the last saved result path so a reload can
+    // show the finished debate again via /api/debate/result.
+    const lastResultStorageKey = "debate:lastResultPath";
     const turnVisibility = {
       persona: true,
       moderator: true,
@@ -133,6 +138,7 @@
       personaTurnCount = 0;
       nonPersonaTurnCount = 0;
       debatePersonaCount = 0;
+      speakerColors = {};
       activeSpeakerLabel = "-";
       runStartedAtMs = 0;
       stopElapsedTimer();
@@ -747,11 +753,24 @@
       });
     }
 
+    function apiErrorMessage(payload, fallback) {
+      const err = payload && payload.error;
+      if (err && typeof err === "object") return err.message || fallback;
+      return err || fallback;
+    }
+
     async function fetchPersonas(path) {
       const url = path ? "/api/personas?path=" + encodeURIComponent(path) : "/api/personas";
       const res = await fetch(url);
       const payload = await res.json();
-      if (!res.ok) throw new Error(payload.error || "persona 로딩 실패");
+      if (!res.ok) throw new Error(apiErrorMessage(payload, "persona 로딩 실패"));
+      return payload;
+    }
+
+    async function fetchSavedResult(path) {
+      const res = await fetch("/api/debate/result?path=" + encodeURIComponent(path));
+      const payload = await res.json();
+      if (!res.ok) throw new Error(apiErrorMessage(payload, "저장된 결과 로딩 실패"));
       return payload;
     }
 
@@ -770,7 +789,7 @@
       });
       const payload = await res.json();
       if (!res.ok) {
-        throw new Error(payload.error || "토론 시작 실패");
+        throw new Error(apiErrorMessage(payload, "토론 시작 실패"));
       }
       if (!payload.run_id) {
         throw new Error("토론 실행 식별자(run_id)를 받지 못했습니다.");
@@ -786,7 +805,7 @@
       });
       const payload = await res.json();
       if (!res.ok) {
-        throw new Error(payload.error || "토론 중지 실패");
+        throw new Error(apiErrorMessage(payload, "토론 중지 실패"));
       }
       return payload;
     }
@@ -866,7 +885,7 @@
       });
     }
 
-    function createTurnCard(type, badge, name, content) {
+    function createTurnCard(type, badge, name, content, color) {
       const card = document.createElement("article");
       card.className = "turn-card " + type;
       card.dataset.turnKind = normalizeTurnKind(type);
@@ -914,6 +933,10 @@
         avatarEl.textContent = "R";
         avatarEl.style.backgroundColor = "#e0f2fe";
         avatarEl.style.color = "#0369a1";
+      } else if (color) {
+        avatarEl.textContent = initialsFromText(name);
+        avatarEl.style.backgroundColor = color;
+        avatarEl.style.color = "#fff";
       } else {
         avatarEl.textContent = initialsFromText(name);
         avatarEl.style.backgroundColor = `hsl(${hue}, ${sat}%, ${light}%)`;
@@ -956,8 +979,8 @@
       debateWindowEl.scrollTop = debateWindowEl.scrollHeight;
     }
 
-    function appendTurnCard(type, badge, name, content) {
-      const card = createTurnCard(type, badge, name, content);
+    function appendTurnCard(type, badge, name, content, color) {
+      const card = createTurnCard(type, badge, name, content, color);
       appendCardElement(card);
     }
 
@@ -1025,6 +1048,7 @@
       const scoreText = Number.isFinite(scoreValue) ? scoreValue.toFixed(2) : "-";
       const summaryText = String(consensus.summary || "-");
       const statusTextValue = String((result && result.status) || "-");
+      const stopReason = String((result && result.stop_reason) || "");
       const nextOwner = String(consensus.next_action_owner || "-");
       const nextTrigger = String(consensus.next_action_trigger_or_deadline || "-");
       const nextMetric = String(consensus.next_action_success_metric || "-");
@@ -1045,6 +1069,7 @@
         "    <p class=\"summary-main\">" + renderInlineMarkdown(summaryText) + "</p>",
         "    <ul class=\"summary-kv\">",
         "      <li><span>Status</span><strong>" + escapeHTML(statusTextValue) + "</strong></li>",
+        stopReason ? "      <li><span>Stop Reason</span><strong>" + escapeHTML(stopReason) + "</strong></li>" : "",
         "      <li><span>Consensus Score</span><strong>" + escapeHTML(scoreText) + "</strong></li>",
         "    </ul>",
         "  </section>",
@@ -1096,6 +1121,75 @@
       return card;
     }
 
+    function appendResultTurn(turn) {
+      const turnType = String(turn.type || "").toLowerCase();
+      let cardType = "turn-persona";
+      let badgePrefix = "TURN ";
+      if (turnType === "moderator") {
+        cardType = "turn-moderator";
+        badgePrefix = "MOD ";
+      } else if (turnType === "system") {
+        cardType = "turn-system";
+        badgePrefix = "SYS ";
+      }
+      appendTurnCard(
+        cardType,
+        badgePrefix + String(turn.index || "?"),
+        turn.speaker_name || turn.speaker_id || "Unknown",
+        sanitizeTurnContent(turn.content || "", turnType),
+        turnType === "persona" ? speakerColors[turn.speaker_id] : ""
+      );
+    }
+
+    function rememberLastResult(path) {
+      try {
+        // A run without a saved JSON result cannot be fetched back, so drop
+        // the previous entry instead of restoring an older debate.
+        if (path) {
+          window.sessionStorage.setItem(lastResultStorageKey, path);
+        } else {
+          window.sessionStorage.removeItem(lastResultStorageKey);
+        }
+      } catch (_) {
+        // Storage can be unavailable (private mode); restoring is best effort.
+      }
+    }
+
+    async function restoreLastResult() {
+      let path = "";
+      try {
+        path = window.sessionStorage.getItem(lastResultStorageKey) || "";
+      } catch (_) {
+        return;
+      }
+      if (!path || currentRunID) {
+        return;
+      }
+      let payload;
+      try {
+        payload = await fetchSavedResult(path);
+      } catch (_) {
+        window.sessionStorage.removeItem(lastResultStorageKey);
+        return;
+      }
+      if (currentRunID) {
+        return;
+      }
+      const result = payload.result || {};
+      const turns = Array.isArray(result.turns) ? result.turns : [];
+      clearDebateWindow();
+      speakerColors = result.speaker_colors || {};
+      turns.forEach((turn) => appendResultTurn(turn));
+      turnCount = turns.length;
+      personaTurnCount = turns.filter((turn) => String(turn.type || "").toLowerCase() === "persona").length;
+      nonPersonaTurnCount = turnCount - personaTurnCount;
+      activeSpeakerLabel = "토론 결과";
+      appendCardElement(createSummaryCard(result, payload));
+      statusText.textContent = "완료";
+      setTurnMeta(turnCount, "완료");
+      updateRunMeta();
+    }
+
     function clearDebateWindow() {
       debateWindowEl.innerHTML = "";
       resetRunMeta();
@@ -1127,6 +1221,15 @@
     function applyPersonaPayload(payload, path) {
       selectedPersonaPath = payload.path || path || "";
       personaMetaEl.textContent = getSelectedGroupLabel(path) + " · " + String(payload.personas.length) + "명";
+      if (payload.warning) {
+        personaMetaEl.textContent += " · ⚠ " + payload.warning;
+      }
+      if (Array.isArray(payload.warnings) && payload.warnings.length > 0) {
+        personaMetaEl.textContent += " · ⚠ 경고 " + String(payload.warnings.length) + "건";
+        personaMetaEl.title = payload.warnings.map((w) => (w.persona_id || "") + ": " + w.message).join("\n");
+      } else {
+        personaMetaEl.title = "";
+      }
       renderPersonaList(payload.personas);
     }
 
@@ -1177,6 +1280,21 @@
         currentStream = stream;
         const streamRunID = currentRunID;
         let finished = false;
+        // liveFinalCard shows the final wrap-up while it streams; the
+        // completed moderator turn replaces it.
+        let liveFinalCard = null;
+        let liveFinalText = "";
+        // liveTurnCard shows a persona turn while it streams as delta events;
+        // the completed turn event replaces it.
+        let liveTurnCard = null;
+        let liveTurnText = "";
+        function clearLiveTurn() {
+          if (liveTurnCard) {
+            liveTurnCard.remove();
+          }
+          liveTurnCard = null;
+          liveTurnText = "";
+        }
         function isStaleStream() {
           return currentStream !== stream || currentRunID !== streamRunID;
         }
@@ -1188,6 +1306,7 @@
           const payload = parseJSON(ev.data) || {};
           clearDebateWindow();
           debatePersonaCount = Number.isFinite(Number(payload.persona_count)) ? Number(payload.persona_count) : 0;
+          speakerColors = payload.speaker_colors || {};
           runStartedAtMs = Date.now();
           startElapsedTimer();
           activeSpeakerLabel = "토론 시작";
@@ -1212,7 +1331,12 @@
           }
           const turnType = String(turn.type || "").toLowerCase();
           const isModerator = turnType === "moderator";
-          const isSystem = turnType === "system";
+          clearLiveTurn();
+          if (isModerator && liveFinalCard) {
+            liveFinalCard.remove();
+            liveFinalCard = null;
+            liveFinalText = "";
+          }
           if (turnType !== "persona") {
             clearActivePersona();
           } else {
@@ -1228,21 +1352,59 @@
           updateRunMeta();
           setTurnMeta(turnCount, "진행 중");
           showProgress("토론 진행 중... (" + String(turnCount) + "턴)");
-          let cardType = "turn-persona";
-          let badgePrefix = "TURN ";
-          if (isModerator) {
-            cardType = "turn-moderator";
-            badgePrefix = "MOD ";
-          } else if (isSystem) {
-            cardType = "turn-system";
-            badgePrefix = "SYS ";
+          appendResultTurn(turn);
+        });
+
+        stream.addEventListener("delta", function (ev) {
+          if (finished || isStaleStream()) {
+            return;
           }
-          appendTurnCard(
-            cardType,
-            badgePrefix + String(turn.index || "?"),
-            turn.speaker_name || turn.speaker_id || "Unknown",
-            sanitizeTurnContent(turn.content || "", turnType)
-          );
+          const payload = parseJSON(ev.data) || {};
+          const speakerID = String(payload.speaker_id || "");
+          liveTurnText += String(payload.delta || "");
+          if (!liveTurnCard) {
+            highlightSpeakerPersona(speakerID, "");
+            activeSpeakerLabel = speakerID || "Unknown";
+            updateRunMeta();
+            liveTurnCard = createTurnCard("turn-persona", "TURN …", speakerID || "Unknown", "", speakerColors[speakerID]);
+            appendCardElement(liveTurnCard);
+          }
+          const contentEl = liveTurnCard.querySelector(".turn-content");
+          if (contentEl) {
+            contentEl.textContent = liveTurnText;
+          }
+          debateWindowEl.scrollTop = debateWindowEl.scrollHeight;
+        });
+
+        stream.addEventListener("slow_turn", function (ev) {
+          if (finished || isStaleStream()) {
+            return;
+          }
+          // The next turn event replaces this label.
+          const payload = parseJSON(ev.data) || {};
+          activeSpeakerLabel = String(payload.speaker_id || "Unknown") + " (응답 지연 중)";
+          updateRunMeta();
+        });
+
+        stream.addEventListener("final_moderator", function (ev) {
+          if (finished || isStaleStream()) {
+            return;
+          }
+          const payload = parseJSON(ev.data) || {};
+          liveFinalText += String(payload.delta || "");
+          if (!liveFinalCard) {
+            clearActivePersona();
+            activeSpeakerLabel = "최종 정리";
+            updateRunMeta();
+            showProgress("최종 정리 작성 중...");
+            liveFinalCard = createTurnCard("turn-moderator", "MOD …", "최종 정리", "");
+            appendCardElement(liveFinalCard);
+          }
+          const contentEl = liveFinalCard.querySelector(".turn-content");
+          if (contentEl) {
+            contentEl.textContent = liveFinalText;
+          }
+          debateWindowEl.scrollTop = debateWindowEl.scrollHeight;
         });
 
         stream.addEventListener("complete", function (ev) {
@@ -1255,6 +1417,7 @@
           activeSpeakerLabel = "토론 결과";
           updateRunMeta();
           appendCardElement(createSummaryCard(result, payload));
+          rememberLastResult(payload.saved_json_path);
           finalizeRunState("완료", "완료", "", false);
         });
 
@@ -1263,6 +1426,7 @@
             return;
           }
           finished = true;
+          clearLiveTurn();
           const payload = parseJSON(ev.data) || {};
           finalizeRunState("실패", "실패", payload.error || "토론 실행 실패", false);
         });
@@ -1272,6 +1436,7 @@
             return;
           }
           finished = true;
+          clearLiveTurn();
           finalizeRunState("중지됨", "중지", "", true);
         });
 
@@ -1383,6 +1548,7 @@
       personaMetaEl.textContent = "";
       errorText.textContent = String(err.message || err);
     });
+    restoreLastResult();
     syncFilterChips();
     refreshTimelineVisibility();
     setCompactView(Boolean(compactToggleEl && compactToggleEl.checked));
@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,8 +26,46 @@ type debateRun struct {
 	runErr     error
 
 	updates chan struct{}
+	// asks feeds questions into the running orchestrator; nil when the
+	// runner cannot accept them.
+	asks chan string
+	// controls feeds mute/unmute requests into the running orchestrator;
+	// nil when the runner cannot accept them. speakers and muted track the
+	// lowercased speaking persona IDs so requests can be checked up front.
+	controls chan orchestrator.SpeakerControl
+	speakers map[string]bool
+	muted    map[string]bool
+	// finalDeltas holds the streamed final wrap-up so far. finalAt is the
+	// turn cursor the wrap-up follows, so subscribers can emit it in order.
+	finalDeltas []string
+	finalAt     int
+	// turnDeltas holds the persona turn being streamed. turnDeltaAt is the
+	// turn cursor that turn will take; appendTurn clears the buffer once
+	// the completed turn replaces it.
+	turnDeltas  []streamDeltaEvent
+	turnDeltaAt int
+	// slowTurn is the latest slow-call notice and slowTurnAt the turn
+	// cursor it precedes, so subscribers only show it while that turn is
+	// still pending. slowTurnSeq counts notices so each is sent once.
+	slowTurn    *streamSlowTurnEvent
+	slowTurnAt  int
+	slowTurnSeq int
 }
 
+// maxPendingAsks bounds questions queued before the next persona turn.
+const maxPendingAsks = 4
+
+var (
+	errRunNotActive    = errors.New("run is not running")
+	errAsksUnsupported = errors.New("questions are not supported by the current runner")
+	errAskQueueFull    = errors.New("too many pending questions; wait for the next turn")
+
+	errMuteUnsupported  = errors.New("muting is not supported by the current runner")
+	errUnknownSpeaker   = errors.New("persona_id is not a speaking persona in this run")
+	errTooFewActive     = errors.New("at least two personas must stay unmuted")
+	errControlQueueFull = errors.New("too many pending mute changes; wait for the next turn")
+)
+
 func newDebateRun(id string, start streamStartEvent, cancel context.CancelFunc, maxTurns int) *debateRun {
 	return &debateRun{
 		id:       id,
@@ -44,6 +83,7 @@ func (r *debateRun) appendTurn(turn orchestrator.Turn) {
 		return
 	}
 	r.turns = append(r.turns, turn)
+	r.turnDeltas = nil
 	if r.maxTurns > 0 && len(r.turns) > r.maxTurns {
 		drop := len(r.turns) - r.maxTurns
 		r.turns = append([]orchestrator.Turn(nil), r.turns[drop:]...)
@@ -53,6 +93,157 @@ func (r *debateRun) appendTurn(turn orchestrator.Turn) {
 	r.notify()
 }
 
+// appendFinalDelta records one streamed fragment of the final wrap-up.
+func (r *debateRun) appendFinalDelta(delta string) {
+	r.mu.Lock()
+	if r.done {
+		r.mu.Unlock()
+		return
+	}
+	if len(r.finalDeltas) == 0 {
+		r.finalAt = r.baseCursor + len(r.turns)
+	}
+	r.finalDeltas = append(r.finalDeltas, delta)
+	r.mu.Unlock()
+	r.notify()
+}
+
+// finalDeltasSince returns the wrap-up fragments after index from and the
+// turn cursor they follow.
+func (r *debateRun) finalDeltasSince(from int) ([]string, int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if from < 0 {
+		from = 0
+	}
+	if from >= len(r.finalDeltas) {
+		return nil, r.finalAt
+	}
+	return append([]string(nil), r.finalDeltas[from:]...), r.finalAt
+}
+
+// appendTurnDelta records one streamed fragment of the persona turn in
+// progress.
+func (r *debateRun) appendTurnDelta(speakerID string, delta string) {
+	r.mu.Lock()
+	if r.done {
+		r.mu.Unlock()
+		return
+	}
+	at := r.baseCursor + len(r.turns)
+	if len(r.turnDeltas) == 0 || r.turnDeltaAt != at {
+		r.turnDeltas = nil
+		r.turnDeltaAt = at
+	}
+	r.turnDeltas = append(r.turnDeltas, streamDeltaEvent{
+		RunID:     r.id,
+		SpeakerID: speakerID,
+		Delta:     delta,
+	})
+	r.mu.Unlock()
+	r.notify()
+}
+
+// turnDeltasSince returns the fragments of the in-progress turn after index
+// from and the turn cursor they precede. When that cursor differs from at,
+// a new turn has started and its fragments are returned from the beginning.
+func (r *debateRun) turnDeltasSince(at int, from int) ([]streamDeltaEvent, int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.turnDeltaAt != at || from < 0 {
+		from = 0
+	}
+	if from >= len(r.turnDeltas) {
+		return nil, r.turnDeltaAt
+	}
+	return append([]streamDeltaEvent(nil), r.turnDeltas[from:]...), r.turnDeltaAt
+}
+
+// recordEvent keeps orchestrator slow-turn events for subscribers; other
+// events are not streamed.
+func (r *debateRun) recordEvent(event orchestrator.Event) {
+	if event.Type != orchestrator.EventSlowTurn {
+		return
+	}
+	r.mu.Lock()
+	if r.done {
+		r.mu.Unlock()
+		return
+	}
+	r.slowTurn = &streamSlowTurnEvent{
+		RunID:     r.id,
+		SpeakerID: event.SpeakerID,
+		Detail:    event.Detail,
+	}
+	r.slowTurnAt = r.baseCursor + len(r.turns)
+	r.slowTurnSeq++
+	r.mu.Unlock()
+	r.notify()
+}
+
+// slowTurnNotice returns the latest slow-turn notice, the turn cursor it
+// precedes and its sequence number; seq is 0 when there is none.
+func (r *debateRun) slowTurnNotice() (event streamSlowTurnEvent, at int, seq int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.slowTurn == nil {
+		return streamSlowTurnEvent{}, 0, 0
+	}
+	return *r.slowTurn, r.slowTurnAt, r.slowTurnSeq
+}
+
+// ask queues question for the moderator to put to the next persona.
+func (r *debateRun) ask(question string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.done || r.stopped {
+		return errRunNotActive
+	}
+	if r.asks == nil {
+		return errAsksUnsupported
+	}
+	select {
+	case r.asks <- question:
+		return nil
+	default:
+		return errAskQueueFull
+	}
+}
+
+// setMuted queues a mute or unmute for personaID. The change takes effect
+// before the next persona turn; repeating the current state is a no-op.
+func (r *debateRun) setMuted(personaID string, mute bool) error {
+	key := strings.ToLower(strings.TrimSpace(personaID))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.done || r.stopped {
+		return errRunNotActive
+	}
+	if r.controls == nil {
+		return errMuteUnsupported
+	}
+	if !r.speakers[key] {
+		return errUnknownSpeaker
+	}
+	if r.muted[key] == mute {
+		return nil
+	}
+	if mute && len(r.speakers)-len(r.muted) <= 2 {
+		return errTooFewActive
+	}
+	select {
+	case r.controls <- orchestrator.SpeakerControl{PersonaID: key, Mute: mute}:
+	default:
+		return errControlQueueFull
+	}
+	if mute {
+		r.muted[key] = true
+	} else {
+		delete(r.muted, key)
+	}
+	return nil
+}
+
 func (r *debateRun) stop() {
 	r.mu.Lock()
 	if r.done {